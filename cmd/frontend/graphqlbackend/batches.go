@@ -154,6 +154,7 @@ type CreateBatchChangesCredentialArgs struct {
 	ExternalServiceKind string
 	ExternalServiceURL  string
 	User                *graphql.ID
+	Username            *string
 	Credential          string
 }
 
@@ -458,6 +459,9 @@ type ChangesetSpecDeltaResolver interface {
 	CommitMessageChanged() bool
 	AuthorNameChanged() bool
 	AuthorEmailChanged() bool
+	LabelsChanged() bool
+	ReviewersChanged() bool
+	AssigneesChanged() bool
 }
 
 type ChangesetDescription interface {
@@ -785,6 +789,7 @@ type BatchSpecWorkspaceResolver interface {
 	ChangesetSpecs(ctx context.Context) (*[]ChangesetSpecResolver, error)
 	DiffStat(ctx context.Context) (*DiffStat, error)
 	PlaceInQueue() *int32
+	Artifacts(ctx context.Context) ([]ExecutorJobArtifactResolver, error)
 }
 
 type BatchSpecWorkspaceStagesResolver interface {
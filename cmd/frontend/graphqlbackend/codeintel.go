@@ -97,6 +97,7 @@ type LSIFIndexResolver interface {
 	PlaceInQueue() *int32
 	AssociatedUpload(ctx context.Context) (LSIFUploadResolver, error)
 	ProjectRoot(ctx context.Context) (*GitTreeEntryResolver, error)
+	Artifacts(ctx context.Context) ([]ExecutorJobArtifactResolver, error)
 }
 
 type IndexStepsResolver interface {
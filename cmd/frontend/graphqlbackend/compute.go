@@ -23,7 +23,7 @@ type ComputeResolver interface {
 // A dummy type to express the union of compute results. This how its done by the GQL library we use.
 // https://github.com/graph-gophers/graphql-go/blob/af5bb93e114f0cd4cc095dd8eae0b67070ae8f20/example/starwars/starwars.go#L485-L487
 //
-// union ComputeResult = ComputeMatchContext | ComputeText
+// union ComputeResult = ComputeMatchContext | ComputeText | ComputeAggregate
 type computeResultResolver struct {
 	result interface{}
 }
@@ -132,6 +132,32 @@ func (c *computeTextResolver) Kind() *string {
 }
 func (c *computeTextResolver) Value() string { return c.t.Value }
 
+// ComputeAggregate GQL result resolver definitions.
+
+type computeAggregateResolver struct {
+	t *compute.Table
+}
+
+func (c *computeAggregateResolver) Kind() *string {
+	value := c.t.Kind
+	return &value
+}
+
+func (c *computeAggregateResolver) Rows() []*computeAggregateRowResolver {
+	rows := make([]*computeAggregateRowResolver, 0, len(c.t.Rows))
+	for _, row := range c.t.Rows {
+		rows = append(rows, &computeAggregateRowResolver{row: row})
+	}
+	return rows
+}
+
+type computeAggregateRowResolver struct {
+	row compute.TableRow
+}
+
+func (r *computeAggregateRowResolver) Value() string { return r.row.Value }
+func (r *computeAggregateRowResolver) Count() int32  { return int32(r.row.Count) }
+
 // Definitions required by https://github.com/graph-gophers/graphql-go to resolve
 // a union type in GraphQL.
 
@@ -145,6 +171,11 @@ func (r *computeResultResolver) ToComputeText() (*computeTextResolver, bool) {
 	return res, ok
 }
 
+func (r *computeResultResolver) ToComputeAggregate() (*computeAggregateResolver, bool) {
+	res, ok := r.result.(*computeAggregateResolver)
+	return res, ok
+}
+
 func toComputeMatchContextResolver(mc *compute.MatchContext, repository *RepositoryResolver, path, commit string) *computeMatchContextResolver {
 	var computeMatches []*computeMatchResolver
 	for _, m := range mc.Matches {
@@ -174,6 +205,8 @@ func toComputeResultResolver(result compute.Result, repoResolver *RepositoryReso
 		return &computeResultResolver{result: toComputeMatchContextResolver(r, repoResolver, path, commit)}
 	case *compute.Text:
 		return &computeResultResolver{result: toComputeTextResolver(r, repoResolver, path, commit)}
+	case *compute.Table:
+		return &computeResultResolver{result: &computeAggregateResolver{t: r}}
 	default:
 		panic(fmt.Sprintf("unsupported compute result %T", r))
 	}
@@ -207,6 +240,22 @@ func toResultResolverList(ctx context.Context, cmd compute.Command, matches []re
 		return resolver
 	}
 
+	// An aggregate command combines the results of all matches into a single
+	// table.
+	if _, ok := cmd.(*compute.Aggregate); ok {
+		aggregator := compute.NewAggregator()
+		for _, m := range matches {
+			computeResult, err := cmd.Run(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			if table, ok := computeResult.(*compute.Table); ok {
+				aggregator.Add(table)
+			}
+		}
+		return []*computeResultResolver{toComputeResultResolver(aggregator.Table(), nil, "", "")}, nil
+	}
+
 	results := make([]*computeResultResolver, 0, len(matches))
 	for _, m := range matches {
 		computeResult, err := cmd.Run(ctx, m)
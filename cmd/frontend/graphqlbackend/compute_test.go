@@ -3,12 +3,15 @@ package graphqlbackend
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/hexops/autogold"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/compute"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmock"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 )
 
 func TestToResultResolverList(t *testing.T) {
@@ -40,3 +43,40 @@ func TestToResultResolverList(t *testing.T) {
 
 	autogold.Want("resolver copies all match results", `["a","b"]`).Equal(t, test("a|b"))
 }
+
+func TestToResultResolverList_Aggregate(t *testing.T) {
+	git.Mocks.ReadFile = func(_ api.CommitID, _ string) ([]byte, error) {
+		return []byte("a b a"), nil
+	}
+	t.Cleanup(git.ResetMocks)
+
+	matches := []result.Match{
+		&result.FileMatch{File: result.File{Path: "x"}},
+		&result.FileMatch{File: result.File{Path: "y"}},
+	}
+	computeQuery, err := compute.Parse("content:aggregate((a|b) -> $1)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolvers, err := toResultResolverList(context.Background(), computeQuery.Command, matches, dbmock.NewMockDB())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resolvers) != 1 {
+		t.Fatalf("expected a single aggregate result, got %d", len(resolvers))
+	}
+	aggregate, ok := resolvers[0].ToComputeAggregate()
+	if !ok {
+		t.Fatalf("expected aggregate result, got %T", resolvers[0].result)
+	}
+	var rows []string
+	for _, row := range aggregate.Rows() {
+		rows = append(rows, fmt.Sprintf("%s:%d", row.Value(), row.Count()))
+	}
+	autogold.Want("aggregate counts all matches", `["a:4","b:2"]`).Equal(t, mustJSON(rows))
+}
+
+func mustJSON(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
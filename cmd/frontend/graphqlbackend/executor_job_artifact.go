@@ -0,0 +1,53 @@
+package graphqlbackend
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type ExecutorJobArtifactResolver interface {
+	Name() string
+	Size() BigInt
+	CreatedAt() DateTime
+	URL() string
+}
+
+// NewExecutorJobArtifactResolvers returns resolvers for the artifacts uploaded by
+// executors for the job with the given ID in the given queue. Callers must ensure that
+// the current user can view the job's record.
+func NewExecutorJobArtifactResolvers(ctx context.Context, db database.DB, queueName string, jobID int) ([]ExecutorJobArtifactResolver, error) {
+	artifacts, err := db.Executors().ListArtifacts(ctx, queueName, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]ExecutorJobArtifactResolver, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		resolvers = append(resolvers, &executorJobArtifactResolver{artifact: artifact})
+	}
+
+	return resolvers, nil
+}
+
+type executorJobArtifactResolver struct {
+	artifact types.ExecutorArtifact
+}
+
+var _ ExecutorJobArtifactResolver = &executorJobArtifactResolver{}
+
+func (r *executorJobArtifactResolver) Name() string { return r.artifact.Name }
+func (r *executorJobArtifactResolver) Size() BigInt { return BigInt{Int: r.artifact.Size} }
+
+func (r *executorJobArtifactResolver) CreatedAt() DateTime {
+	return DateTime{Time: r.artifact.CreatedAt}
+}
+
+// URL returns the path of the route that serves the content of the artifact after
+// checking that the current user can view the job's record.
+func (r *executorJobArtifactResolver) URL() string {
+	return fmt.Sprintf("/.api/executors/artifacts/%s/%d/%s", url.PathEscape(r.artifact.QueueName), r.artifact.JobID, url.PathEscape(r.artifact.Name))
+}
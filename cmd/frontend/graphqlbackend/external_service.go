@@ -118,6 +118,10 @@ func (r *externalServiceResolver) WebhookURL() (*string, error) {
 			if c.Plugin != nil && c.Plugin.Webhooks != nil {
 				r.webhookURL = u
 			}
+		case *schema.BitbucketCloudConnection:
+			if c.WebhookSecret != "" {
+				r.webhookURL = u
+			}
 		case *schema.GitHubConnection:
 			if len(c.Webhooks) > 0 {
 				r.webhookURL = u
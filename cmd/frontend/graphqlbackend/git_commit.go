@@ -13,7 +13,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/externallink"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
@@ -210,6 +212,13 @@ func (r *GitCommitResolver) Tree(ctx context.Context, args *struct {
 		}
 		return nil, err
 	}
+	// 🚨 SECURITY: Paths the user is not allowed to read are treated as if they
+	// did not exist.
+	if ok, err := authz.FilterActorPath(ctx, authz.DefaultSubRepoPermsChecker, actor.FromContext(ctx), r.gitRepo, args.Path); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, nil
+	}
 	if !stat.Mode().IsDir() {
 		return nil, errors.Errorf("not a directory: %q", args.Path)
 	}
@@ -229,6 +238,13 @@ func (r *GitCommitResolver) Blob(ctx context.Context, args *struct {
 		}
 		return nil, err
 	}
+	// 🚨 SECURITY: Paths the user is not allowed to read are treated as if they
+	// did not exist.
+	if ok, err := authz.FilterActorPath(ctx, authz.DefaultSubRepoPermsChecker, actor.FromContext(ctx), r.gitRepo, args.Path); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, nil
+	}
 	if !stat.Mode().IsRegular() {
 		return nil, errors.Errorf("not a blob: %q", args.Path)
 	}
@@ -242,7 +258,11 @@ func (r *GitCommitResolver) File(ctx context.Context, args *struct {
 }
 
 func (r *GitCommitResolver) FileNames(ctx context.Context) ([]string, error) {
-	return git.LsFiles(ctx, r.gitRepo, api.CommitID(r.oid))
+	names, err := git.LsFiles(ctx, r.gitRepo, api.CommitID(r.oid))
+	if err != nil {
+		return nil, err
+	}
+	return authz.FilterActorPaths(ctx, authz.DefaultSubRepoPermsChecker, actor.FromContext(ctx), r.gitRepo, names)
 }
 
 func (r *GitCommitResolver) Languages(ctx context.Context) ([]string, error) {
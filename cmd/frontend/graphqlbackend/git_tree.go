@@ -8,7 +8,9 @@ import (
 	"strings"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 )
@@ -57,6 +59,12 @@ func (r *GitTreeEntryResolver) entries(ctx context.Context, args *gitTreeEntryCo
 		}
 	}
 
+	// 🚨 SECURITY: Only list entries the user is allowed to read.
+	entries, err = filterReadableEntries(ctx, r.commit.repoResolver.RepoName(), entries)
+	if err != nil {
+		return nil, err
+	}
+
 	sort.Sort(byDirectory(entries))
 
 	if args.First != nil && len(entries) > int(*args.First) {
@@ -88,6 +96,27 @@ func (r *GitTreeEntryResolver) entries(ctx context.Context, args *gitTreeEntryCo
 	return l, nil
 }
 
+// filterReadableEntries returns the entries the user in ctx is allowed to read
+// according to sub-repo permissions.
+func filterReadableEntries(ctx context.Context, repo api.RepoName, entries []fs.FileInfo) ([]fs.FileInfo, error) {
+	if !authz.DefaultSubRepoPermsChecker.Enabled() {
+		return entries, nil
+	}
+
+	a := actor.FromContext(ctx)
+	filtered := make([]fs.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		ok, err := authz.FilterActorFileInfo(ctx, authz.DefaultSubRepoPermsChecker, a, repo, entry)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered, nil
+}
+
 type byDirectory []fs.FileInfo
 
 func (s byDirectory) Len() int {
@@ -9,12 +9,16 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmock"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/util"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 func TestGitTree(t *testing.T) {
@@ -121,3 +125,78 @@ func testGitTree(t *testing.T, db *dbmock.MockDB, tests []*Test) {
 
 	RunTests(t, tests)
 }
+
+func TestFilterReadableEntries(t *testing.T) {
+	conf.Mock(&conf.Unified{
+		SiteConfiguration: schema.SiteConfiguration{
+			ExperimentalFeatures: &schema.ExperimentalFeatures{
+				SubRepoPermissions: &schema.SubRepoPermissions{Enabled: true},
+			},
+		},
+	})
+	defer conf.Mock(nil)
+
+	// Include rules as synced from a Perforce protections table, which only
+	// grant access to paths deep within the depot.
+	getter := authz.NewMockSubRepoPermissionsGetter()
+	getter.GetByUserFunc.SetDefaultReturn(map[api.RepoName]authz.SubRepoPermissions{
+		"perforce": {
+			PathIncludes: []string{"perforce/depot/main/sub/**"},
+			PathExcludes: []string{"perforce/depot/main/sub/secret/**"},
+		},
+	}, nil)
+	checker, err := authz.NewSubRepoPermsClient(getter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := authz.DefaultSubRepoPermsChecker
+	authz.DefaultSubRepoPermsChecker = checker
+	defer func() { authz.DefaultSubRepoPermsChecker = orig }()
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	for _, tc := range []struct {
+		entries []fs.FileInfo
+		want    []string
+	}{
+		{
+			entries: []fs.FileInfo{
+				&util.FileInfo{Name_: "depot", Mode_: os.ModeDir},
+				&util.FileInfo{Name_: "README", Mode_: 0},
+			},
+			want: []string{"depot"},
+		},
+		{
+			entries: []fs.FileInfo{
+				&util.FileInfo{Name_: "depot/main", Mode_: os.ModeDir},
+				&util.FileInfo{Name_: "depot/release", Mode_: os.ModeDir},
+			},
+			want: []string{"depot/main"},
+		},
+		{
+			entries: []fs.FileInfo{
+				&util.FileInfo{Name_: "depot/main/sub", Mode_: os.ModeDir},
+				&util.FileInfo{Name_: "depot/main/other", Mode_: os.ModeDir},
+				&util.FileInfo{Name_: "depot/main/file.go", Mode_: 0},
+			},
+			want: []string{"depot/main/sub"},
+		},
+		{
+			entries: []fs.FileInfo{
+				&util.FileInfo{Name_: "depot/main/sub/dir", Mode_: os.ModeDir},
+				&util.FileInfo{Name_: "depot/main/sub/secret", Mode_: os.ModeDir},
+				&util.FileInfo{Name_: "depot/main/sub/file.go", Mode_: 0},
+			},
+			want: []string{"depot/main/sub/dir", "depot/main/sub/file.go"},
+		},
+	} {
+		entries, err := filterReadableEntries(ctx, "perforce", tc.entries)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var have []string
+		for _, entry := range entries {
+			have = append(have, entry.Name())
+		}
+		assert.Equal(t, tc.want, have)
+	}
+}
@@ -32,12 +32,21 @@ type InsightsResolver interface {
 	UpdateLineChartSearchInsight(ctx context.Context, args *UpdateLineChartSearchInsightArgs) (InsightViewPayloadResolver, error)
 	CreatePieChartSearchInsight(ctx context.Context, args *CreatePieChartSearchInsightArgs) (InsightViewPayloadResolver, error)
 	UpdatePieChartSearchInsight(ctx context.Context, args *UpdatePieChartSearchInsightArgs) (InsightViewPayloadResolver, error)
+	CreateBarChartSearchInsight(ctx context.Context, args *CreateCategoricalSearchInsightArgs) (InsightViewPayloadResolver, error)
+	UpdateBarChartSearchInsight(ctx context.Context, args *UpdateCategoricalSearchInsightArgs) (InsightViewPayloadResolver, error)
+	CreateTableSearchInsight(ctx context.Context, args *CreateCategoricalSearchInsightArgs) (InsightViewPayloadResolver, error)
+	UpdateTableSearchInsight(ctx context.Context, args *UpdateCategoricalSearchInsightArgs) (InsightViewPayloadResolver, error)
 
 	DeleteInsightView(ctx context.Context, args *DeleteInsightViewArgs) (*EmptyResponse, error)
 
 	// Admin Management
 	UpdateInsightSeries(ctx context.Context, args *UpdateInsightSeriesArgs) (InsightSeriesMetadataPayloadResolver, error)
 	InsightSeriesQueryStatus(ctx context.Context) ([]InsightSeriesQueryStatusResolver, error)
+
+	// Alerts
+	InsightSeriesAlerts(ctx context.Context, args *InsightSeriesAlertsArgs) ([]InsightSeriesAlertResolver, error)
+	CreateInsightSeriesAlert(ctx context.Context, args *CreateInsightSeriesAlertArgs) (InsightSeriesAlertResolver, error)
+	DeleteInsightSeriesAlert(ctx context.Context, args *DeleteInsightSeriesAlertArgs) (*EmptyResponse, error)
 }
 
 type SearchInsightLivePreviewArgs struct {
@@ -76,10 +85,24 @@ type InsightsPointsArgs struct {
 	ExcludeRepoRegex *string
 }
 
+type InsightsBreakdownArgs struct {
+	GroupBy          string
+	From             *DateTime
+	To               *DateTime
+	IncludeRepoRegex *string
+	ExcludeRepoRegex *string
+}
+
+type InsightSeriesBreakdownResolver interface {
+	Group() string
+	Points() []InsightsDataPointResolver
+}
+
 type InsightSeriesResolver interface {
 	SeriesId() string
 	Label() string
 	Points(ctx context.Context, args *InsightsPointsArgs) ([]InsightsDataPointResolver, error)
+	Breakdown(ctx context.Context, args *InsightsBreakdownArgs) ([]InsightSeriesBreakdownResolver, error)
 	Status(ctx context.Context) (InsightStatusResolver, error)
 	DirtyMetadata(ctx context.Context) ([]InsightDirtyQueryResolver, error)
 }
@@ -173,6 +196,14 @@ type InsightViewResolver interface {
 	DataSeries(ctx context.Context) ([]InsightSeriesResolver, error)
 	Presentation(ctx context.Context) (InsightPresentation, error)
 	DataSeriesDefinitions(ctx context.Context) ([]InsightDataSeriesDefinition, error)
+	Export(ctx context.Context, args *InsightViewExportArgs) (string, error)
+}
+
+type InsightViewExportArgs struct {
+	Format    string
+	Breakdown *string
+	From      *DateTime
+	To        *DateTime
 }
 
 type InsightDataSeriesDefinition interface {
@@ -189,6 +220,31 @@ type PieChartInsightViewPresentation interface {
 	OtherThreshold(ctx context.Context) (float64, error)
 }
 
+type BarChartInsightViewPresentation interface {
+	Title(ctx context.Context) (string, error)
+	OtherThreshold(ctx context.Context) (float64, error)
+	Categories(ctx context.Context) ([]InsightCategoryResolver, error)
+}
+
+type TableInsightViewPresentation interface {
+	Title(ctx context.Context) (string, error)
+	OtherThreshold(ctx context.Context) (float64, error)
+	Rows(ctx context.Context, args *InsightTableRowsArgs) ([]InsightCategoryResolver, error)
+}
+
+type InsightTableRowsArgs struct {
+	SortBy    string
+	Direction string
+}
+
+type InsightCategoryResolver interface {
+	Label() string
+	SeriesId() *string
+	Value() float64
+	Share() float64
+	Other() bool
+}
+
 type LineChartDataSeriesPresentationResolver interface {
 	SeriesId(ctx context.Context) (string, error)
 	Label(ctx context.Context) (string, error)
@@ -206,6 +262,8 @@ type SearchInsightDataSeriesDefinitionResolver interface {
 type InsightPresentation interface {
 	ToLineChartInsightViewPresentation() (LineChartInsightViewPresentation, bool)
 	ToPieChartInsightViewPresentation() (PieChartInsightViewPresentation, bool)
+	ToBarChartInsightViewPresentation() (BarChartInsightViewPresentation, bool)
+	ToTableInsightViewPresentation() (TableInsightViewPresentation, bool)
 }
 
 type InsightTimeScope interface {
@@ -321,6 +379,32 @@ type UpdatePieChartSearchInsightInput struct {
 	PresentationOptions PieChartOptionsInput
 }
 
+type CreateCategoricalSearchInsightArgs struct {
+	Input CreateCategoricalSearchInsightInput
+}
+
+type CreateCategoricalSearchInsightInput struct {
+	DataSeries          []LineChartSearchInsightDataSeriesInput
+	PresentationOptions CategoricalInsightOptionsInput
+	Dashboards          *[]graphql.ID
+}
+
+type UpdateCategoricalSearchInsightArgs struct {
+	Id    graphql.ID
+	Input UpdateCategoricalSearchInsightInput
+}
+
+type UpdateCategoricalSearchInsightInput struct {
+	DataSeries          []LineChartSearchInsightDataSeriesInput
+	PresentationOptions CategoricalInsightOptionsInput
+	ViewControls        InsightViewControlsInput
+}
+
+type CategoricalInsightOptionsInput struct {
+	Title          string
+	OtherThreshold float64
+}
+
 type PieChartOptionsInput struct {
 	Title          string
 	OtherThreshold float64
@@ -385,3 +469,33 @@ type SearchInsightLivePreviewSeriesResolver interface {
 	Points(ctx context.Context) ([]InsightsDataPointResolver, error)
 	Label(ctx context.Context) (string, error)
 }
+
+type InsightSeriesAlertsArgs struct {
+	SeriesId *string
+}
+
+type CreateInsightSeriesAlertArgs struct {
+	Input CreateInsightSeriesAlertInput
+}
+
+type CreateInsightSeriesAlertInput struct {
+	InsightViewId graphql.ID
+	SeriesId      string
+	Condition     string
+	Threshold     float64
+	Intervals     *int32
+}
+
+type DeleteInsightSeriesAlertArgs struct {
+	Id graphql.ID
+}
+
+type InsightSeriesAlertResolver interface {
+	ID() graphql.ID
+	SeriesId() string
+	Condition() string
+	Threshold() float64
+	Intervals() int32
+	Triggered() bool
+	LastTriggeredAt() *DateTime
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/google/zoekt"
 
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/endpoint"
@@ -113,6 +114,7 @@ func NewSearchImplementer(ctx context.Context, db database.DB, args *SearchArgs)
 
 		zoekt:        search.Indexed(),
 		searcherURLs: search.SearcherURLs(),
+		subRepoPerms: authz.DefaultSubRepoPermsChecker,
 		reposMu:      &sync.Mutex{},
 		resolved:     &searchrepos.Resolved{},
 	}, nil
@@ -199,6 +201,9 @@ type searchResolver struct {
 
 	zoekt        zoekt.Streamer
 	searcherURLs *endpoint.Map
+
+	// subRepoPerms is used to remove results the user may not see.
+	subRepoPerms authz.SubRepoPermissionChecker
 }
 
 func (r *searchResolver) Inputs() run.SearchInputs {
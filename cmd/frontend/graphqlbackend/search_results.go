@@ -32,6 +32,7 @@ import (
 	searchhoney "github.com/sourcegraph/sourcegraph/internal/honey/search"
 	"github.com/sourcegraph/sourcegraph/internal/rcache"
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/codeownership"
 	"github.com/sourcegraph/sourcegraph/internal/search/commit"
 	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
@@ -501,10 +502,12 @@ func (r *searchResolver) toRepoOptions(q query.Q, opts resolveRepositoriesOpts)
 	visibility := query.ParseVisibility(visibilityStr)
 
 	commitAfter, _ := q.StringValue(query.FieldRepoHasCommitAfter)
+	hasTopics, _ := q.StringValues(query.FieldRepoHasTopic)
+	hasDescriptions, _ := q.RegexpPatterns(query.FieldRepoHasDescription)
 	searchContextSpec, _ := q.StringValue(query.FieldContext)
 
 	var CacheLookup bool
-	if len(opts.effectiveRepoFieldValues) == 0 && opts.limit == 0 {
+	if len(opts.effectiveRepoFieldValues) == 0 && opts.limit == 0 && len(hasTopics) == 0 && len(hasDescriptions) == 0 {
 		// indicates resolving repositories should cache DB lookups
 		CacheLookup = true
 	}
@@ -520,6 +523,8 @@ func (r *searchResolver) toRepoOptions(q query.Q, opts resolveRepositoriesOpts)
 		NoArchived:        archived == query.No,
 		Visibility:        visibility,
 		CommitAfter:       commitAfter,
+		HasTopics:         hasTopics,
+		HasDescriptions:   hasDescriptions,
 		Query:             q,
 		Limit:             opts.limit,
 		CacheLookup:       CacheLookup,
@@ -544,7 +549,9 @@ func withMode(args search.TextParameters, st query.SearchType) search.TextParame
 				// We allow -repo: in global search.
 				return n.Negated
 			case
-				query.FieldRepoHasFile:
+				query.FieldRepoHasFile,
+				query.FieldRepoHasTopic,
+				query.FieldRepoHasDescription:
 				return false
 			default:
 				return true
@@ -759,6 +766,8 @@ func (r *searchResolver) toSearchInputs(q query.Q) (*search.TextParameters, []ru
 				Diff:          diff,
 				HasTimeFilter: commit.HasTimeFilter(args.Query),
 				Limit:         int(args.PatternInfo.FileMatchLimit),
+				Select:        args.PatternInfo.Select,
+				SubRepoPerms:  r.subRepoPerms,
 				Db:            r.db,
 			})
 		}
@@ -1140,6 +1149,11 @@ func (r *searchResolver) resultsStreaming(ctx context.Context) (*SearchResultsRe
 		// Ensure downstream events sent on the stream are processed by `select:`.
 		selectPath, _ := filter.SelectPathFromString(sp) // Invariant: error already checked
 		r.stream = streaming.WithSelect(r.stream, selectPath)
+		if codeownership.IsSelectOwners(selectPath) {
+			// Owners are resolved before selection, since select:file.owners
+			// needs the matched files.
+			r.stream = codeownership.WithSelectOwners(ctx, r.stream, codeownership.NewResolver())
+		}
 	}
 	sr, err := r.resultsRecursive(ctx, r.Plan)
 	srr := r.resultsToResolver(sr)
@@ -1234,6 +1248,10 @@ func (r *searchResolver) resultsRecursive(ctx context.Context, plan query.Plan)
 		}
 
 		if newResult != nil {
+			newResult.Matches, err = selectOwnership(ctx, newResult.Matches, q)
+			if err != nil {
+				return nil, err
+			}
 			newResult.Matches = result.Select(newResult.Matches, q)
 			sr = union(sr, newResult)
 			if len(sr.Matches) > wantCount {
@@ -1249,6 +1267,37 @@ func (r *searchResolver) resultsRecursive(ctx context.Context, plan query.Plan)
 	return sr, err
 }
 
+// selectOwnership applies the CODEOWNERS based parts of a query to matches:
+// the filehasowner parameter generated by the file:has.owner() predicate, and
+// select:file.owners.
+func selectOwnership(ctx context.Context, matches []result.Match, q query.Basic) ([]result.Match, error) {
+	var owners []string
+	q.FindParameter(query.FieldFileHasOwner, func(value string, _ bool, _ query.Annotation) {
+		owners = append(owners, value)
+	})
+	selectOwners := false
+	if sp, _ := q.ToParseTree().StringValue(query.FieldSelect); sp != "" {
+		selectPath, _ := filter.SelectPathFromString(sp) // Invariant: error already checked
+		selectOwners = codeownership.IsSelectOwners(selectPath)
+	}
+	if len(owners) == 0 && !selectOwners {
+		return matches, nil
+	}
+
+	resolver := codeownership.NewResolver()
+	var err error
+	if len(owners) > 0 {
+		matches, err = codeownership.FilterMatches(ctx, resolver, matches, owners)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if selectOwners {
+		matches, err = codeownership.SelectOwners(ctx, resolver, matches)
+	}
+	return matches, err
+}
+
 // searchResultsToRepoNodes converts a set of search results into repository nodes
 // such that they can be used to replace a repository predicate
 func searchResultsToRepoNodes(matches []result.Match) ([]query.Node, error) {
@@ -1621,7 +1670,7 @@ func (r *searchResolver) doResults(ctx context.Context, args *search.TextParamet
 		ctx, stream, cancelOnLimit = streaming.WithLimit(ctx, stream, limit)
 		defer cancelOnLimit()
 	}
-	agg := run.NewAggregator(r.db, stream)
+	agg := run.NewAggregator(ctx, r.db, stream, r.subRepoPerms)
 
 	// This ensures we properly cleanup in the case of an early return. In
 	// particular we want to cancel global searches before returning early.
@@ -1850,6 +1899,8 @@ func compareSearchResults(left, right result.Match, exactFilePatterns map[string
 			// or path names. We use ~ as the key for repo and
 			// paths,lexicographically last in ASCII.
 			return "~", "~", &r.Commit.Author.Date
+		case *result.OwnerMatch:
+			return "", r.Handle, nil
 		}
 		// Unreachable.
 		panic("unreachable: compareSearchResults expects RepositoryResolver, FileMatchResolver, or CommitSearchResultResolver")
@@ -445,9 +445,10 @@ func TestSearchResultsHydration(t *testing.T) {
 			Query:        p.ToParseTree(),
 			UserSettings: &schema.Settings{},
 		},
-		zoekt:    z,
-		reposMu:  &sync.Mutex{},
-		resolved: &searchrepos.Resolved{},
+		zoekt:        z,
+		reposMu:      &sync.Mutex{},
+		resolved:     &searchrepos.Resolved{},
+		subRepoPerms: authz.DefaultSubRepoPermsChecker,
 	}
 	results, err := resolver.Results(ctx)
 	if err != nil {
@@ -802,9 +803,10 @@ func TestEvaluateAnd(t *testing.T) {
 					Query:        p.ToParseTree(),
 					UserSettings: &schema.Settings{},
 				},
-				zoekt:    z,
-				reposMu:  &sync.Mutex{},
-				resolved: &searchrepos.Resolved{},
+				zoekt:        z,
+				reposMu:      &sync.Mutex{},
+				resolved:     &searchrepos.Resolved{},
+				subRepoPerms: srp,
 			}
 			results, err := resolver.Results(ctx)
 			if err != nil {
@@ -875,10 +877,11 @@ func TestSearchContext(t *testing.T) {
 					Query:        p.ToParseTree(),
 					UserSettings: &schema.Settings{},
 				},
-				reposMu:  &sync.Mutex{},
-				resolved: &searchrepos.Resolved{},
-				zoekt:    mockZoekt,
-				db:       db,
+				reposMu:      &sync.Mutex{},
+				resolved:     &searchrepos.Resolved{},
+				zoekt:        mockZoekt,
+				db:           db,
+				subRepoPerms: srp,
 			}
 
 			_, err = resolver.Results(context.Background())
@@ -20,6 +20,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/globals"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
@@ -144,8 +146,43 @@ func serveRaw(db database.DB) handlerFunc {
 			metricRawDuration.WithLabelValues(contentType, requestType, errorS).Observe(duration.Seconds())
 		}()
 
+		// 🚨 SECURITY: Paths the user is not allowed to read are treated as if they
+		// did not exist. Directories above readable paths can be listed, so
+		// whether a path is readable is only known once we know if it is a
+		// directory.
+		checker := authz.DefaultSubRepoPermsChecker
+		a := actor.FromContext(r.Context())
+		notFound := func() error {
+			requestType = "404"
+			http.Error(w, html.EscapeString(fmt.Sprintf("%s: %s", requestedPath, os.ErrNotExist)), http.StatusNotFound)
+			return nil // request handled
+		}
+		relativePath := strings.Trim(requestedPath, "/")
+		fileReadable, err := authz.FilterActorPath(r.Context(), checker, a, common.Repo.Name, relativePath)
+		if err != nil {
+			return err
+		}
+		dirReadable := fileReadable
+		if !fileReadable && relativePath != "" {
+			if dirReadable, err = authz.FilterActorPath(r.Context(), checker, a, common.Repo.Name, relativePath+"/"); err != nil {
+				return err
+			}
+		}
+		if !fileReadable && !dirReadable {
+			return notFound()
+		}
+
 		switch contentType {
 		case applicationZip, applicationXTar:
+			// 🚨 SECURITY: Archives cannot exclude the paths the user is not
+			// allowed to read, so they are unavailable while sub-repo permissions
+			// are enabled.
+			if checker.Enabled() {
+				requestType = "403"
+				http.Error(w, "Archives are not available when sub-repository permissions are enabled.", http.StatusForbidden)
+				return nil // request handled
+			}
+
 			// Set the proper filename field, so that downloading "/github.com/gorilla/mux/-/raw" gives us a
 			// "mux.zip" file (e.g. when downloading via a browser) or a .tar file depending on the contentType.
 			ext := ".zip"
@@ -242,12 +279,28 @@ func serveRaw(db database.DB) handlerFunc {
 				return err
 			}
 
+			if fi.IsDir() && !dirReadable || !fi.IsDir() && !fileReadable {
+				return notFound()
+			}
+
 			if fi.IsDir() {
 				requestType = "dir"
 				infos, err := git.ReadDir(r.Context(), common.Repo.Name, common.CommitID, requestedPath, false)
 				if err != nil {
 					return err
 				}
+				// 🚨 SECURITY: Only list entries the user is allowed to read.
+				readable := infos[:0]
+				for _, info := range infos {
+					ok, err := authz.FilterActorFileInfo(r.Context(), checker, a, common.Repo.Name, info)
+					if err != nil {
+						return err
+					}
+					if ok {
+						readable = append(readable, info)
+					}
+				}
+				infos = readable
 				size = int64(len(infos))
 				var names []string
 				for _, info := range infos {
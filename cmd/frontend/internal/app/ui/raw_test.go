@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"context"
 	"io"
 	"io/fs"
 	"mime"
@@ -10,7 +11,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gorilla/mux"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmock"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -368,3 +373,139 @@ c.go`
 		}
 	})
 }
+
+func Test_serveRawWithSubRepoPermissions(t *testing.T) {
+	mockNewCommon = func(w http.ResponseWriter, r *http.Request, title string, serveError serveErrorHandler) (*Common, error) {
+		return &Common{
+			Repo: &types.Repo{
+				Name: "test",
+			},
+			CommitID: api.CommitID("12345"),
+		}, nil
+	}
+	defer func() {
+		mockNewCommon = nil
+	}()
+
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.PermissionsFunc.SetDefaultHook(func(ctx context.Context, userID int32, content authz.RepoContent) (authz.Perms, error) {
+		if strings.HasPrefix(content.Path, "secrets") {
+			return authz.None, nil
+		}
+		// Only the directory above the readable paths in "depot/sub".
+		if strings.HasPrefix(content.Path, "depot") && content.Path != "depot/" && !strings.HasPrefix(content.Path, "depot/sub/") {
+			return authz.None, nil
+		}
+		return authz.Read, nil
+	})
+	orig := authz.DefaultSubRepoPermsChecker
+	authz.DefaultSubRepoPermsChecker = checker
+	defer func() { authz.DefaultSubRepoPermsChecker = orig }()
+
+	newRequest := func(target, path string) *http.Request {
+		req := httptest.NewRequest("GET", target, nil)
+		req = req.WithContext(actor.WithActor(req.Context(), actor.FromUser(1)))
+		return mux.SetURLVars(req, map[string]string{"Path": path})
+	}
+
+	t.Run("404 Not Found for restricted file", func(t *testing.T) {
+		initHTTPTestGitServer(t, http.StatusOK, "{}")
+
+		git.Mocks.Stat = func(commit api.CommitID, name string) (fs.FileInfo, error) {
+			t.Fatalf("unexpected stat of %q", name)
+			return nil, nil
+		}
+		defer git.ResetMocks()
+
+		w := httptest.NewRecorder()
+		if err := serveRaw(dbmock.NewMockDB())(w, newRequest("/github.com/sourcegraph/sourcegraph/-/raw/secrets/key", "secrets/key")); err != nil {
+			t.Fatalf("Failed to invoke serveRaw: %v", err)
+		}
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("Want %d but got %d", http.StatusNotFound, w.Code)
+		}
+	})
+
+	t.Run("restricted entries are not listed", func(t *testing.T) {
+		initHTTPTestGitServer(t, http.StatusOK, "{}")
+
+		git.Mocks.Stat = func(commit api.CommitID, name string) (fs.FileInfo, error) {
+			return &util.FileInfo{Mode_: os.ModeDir}, nil
+		}
+		git.Mocks.ReadDir = func(commit api.CommitID, name string, recurse bool) ([]fs.FileInfo, error) {
+			return []fs.FileInfo{
+				&util.FileInfo{Name_: "docs", Mode_: os.ModeDir},
+				&util.FileInfo{Name_: "secrets", Mode_: os.ModeDir},
+				&util.FileInfo{Name_: "main.go", Mode_: 0},
+			}, nil
+		}
+		defer git.ResetMocks()
+
+		w := httptest.NewRecorder()
+		if err := serveRaw(dbmock.NewMockDB())(w, newRequest("/github.com/sourcegraph/sourcegraph/-/raw", "")); err != nil {
+			t.Fatalf("Failed to invoke serveRaw: %v", err)
+		}
+		if w.Code != http.StatusOK {
+			t.Fatalf("Want %d but got %d", http.StatusOK, w.Code)
+		}
+		if want, body := "docs/\nmain.go", w.Body.String(); body != want {
+			t.Errorf("Want %q in body, but got %q", want, body)
+		}
+	})
+
+	t.Run("403 Forbidden for archives", func(t *testing.T) {
+		initHTTPTestGitServer(t, http.StatusOK, "{}")
+
+		w := httptest.NewRecorder()
+		if err := serveRaw(dbmock.NewMockDB())(w, newRequest("/github.com/sourcegraph/sourcegraph/-/raw?format=zip", "")); err != nil {
+			t.Fatalf("Failed to invoke serveRaw: %v", err)
+		}
+		if w.Code != http.StatusForbidden {
+			t.Fatalf("Want %d but got %d", http.StatusForbidden, w.Code)
+		}
+	})
+	t.Run("directories above readable paths are listed", func(t *testing.T) {
+		initHTTPTestGitServer(t, http.StatusOK, "{}")
+
+		git.Mocks.Stat = func(commit api.CommitID, name string) (fs.FileInfo, error) {
+			return &util.FileInfo{Name_: name, Mode_: os.ModeDir}, nil
+		}
+		git.Mocks.ReadDir = func(commit api.CommitID, name string, recurse bool) ([]fs.FileInfo, error) {
+			return []fs.FileInfo{
+				&util.FileInfo{Name_: "depot/other", Mode_: os.ModeDir},
+				&util.FileInfo{Name_: "depot/sub", Mode_: os.ModeDir},
+				&util.FileInfo{Name_: "depot/README", Mode_: 0},
+			}, nil
+		}
+		defer git.ResetMocks()
+
+		w := httptest.NewRecorder()
+		if err := serveRaw(dbmock.NewMockDB())(w, newRequest("/github.com/sourcegraph/sourcegraph/-/raw/depot", "depot")); err != nil {
+			t.Fatalf("Failed to invoke serveRaw: %v", err)
+		}
+		if w.Code != http.StatusOK {
+			t.Fatalf("Want %d but got %d", http.StatusOK, w.Code)
+		}
+		if want, body := "sub/", w.Body.String(); body != want {
+			t.Errorf("Want %q in body, but got %q", want, body)
+		}
+	})
+
+	t.Run("404 Not Found for file only readable as a directory", func(t *testing.T) {
+		initHTTPTestGitServer(t, http.StatusOK, "{}")
+
+		git.Mocks.Stat = func(commit api.CommitID, name string) (fs.FileInfo, error) {
+			return &util.FileInfo{Name_: name, Mode_: 0}, nil
+		}
+		defer git.ResetMocks()
+
+		w := httptest.NewRecorder()
+		if err := serveRaw(dbmock.NewMockDB())(w, newRequest("/github.com/sourcegraph/sourcegraph/-/raw/depot", "depot")); err != nil {
+			t.Fatalf("Failed to invoke serveRaw: %v", err)
+		}
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("Want %d but got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
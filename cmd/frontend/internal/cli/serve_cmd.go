@@ -308,8 +308,11 @@ func makeExternalAPI(db database.DB, schema *graphql.Schema, enterprise enterpri
 		enterprise.GitHubWebhook,
 		enterprise.GitLabWebhook,
 		enterprise.BitbucketServerWebhook,
+		enterprise.BitbucketCloudWebhook,
 		enterprise.NewCodeIntelUploadHandler,
 		enterprise.NewExecutorProxyHandler,
+		enterprise.InsightsExportHandler,
+		enterprise.ExecutorArtifactHandler,
 		rateLimiter,
 	)
 	if err != nil {
@@ -27,7 +27,10 @@ func newTest() *httptestutil.Client {
 		enterpriseServices.GitHubWebhook,
 		enterpriseServices.GitLabWebhook,
 		enterpriseServices.BitbucketServerWebhook,
+		enterpriseServices.BitbucketCloudWebhook,
 		enterpriseServices.NewCodeIntelUploadHandler,
+		enterpriseServices.InsightsExportHandler,
+		enterpriseServices.ExecutorArtifactHandler,
 		rateLimiter,
 	))
 }
@@ -0,0 +1,205 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/compute"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+)
+
+// ComputeStreamHandler is an http handler which streams back the results of a
+// compute query. Aggregate commands stream snapshots of the aggregate table as
+// "aggregate" events, all other commands stream their results as "results"
+// events.
+func ComputeStreamHandler(db database.DB) http.Handler {
+	return &computeStreamHandler{
+		db:                  db,
+		newSearchResolver:   defaultNewSearchResolver,
+		flushTickerInternal: 500 * time.Millisecond,
+	}
+}
+
+type computeStreamHandler struct {
+	db                  database.DB
+	newSearchResolver   func(context.Context, database.DB, *graphqlbackend.SearchArgs) (searchResolver, error)
+	flushTickerInternal time.Duration
+}
+
+// EventComputeResult is the result of running a compute command on a single
+// search match. Type is "context" for match contexts and "text" for text
+// results.
+type EventComputeResult struct {
+	Type       string         `json:"type"`
+	Repository string         `json:"repository"`
+	Commit     string         `json:"commit,omitempty"`
+	Path       string         `json:"path,omitempty"`
+	Result     compute.Result `json:"result"`
+}
+
+func (h *computeStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	q := r.URL.Query().Get("q")
+	computeQuery, err := compute.Parse(q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	searchQuery, err := computeQuery.ToSearchQuery()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tr, ctx := trace.New(ctx, "compute.ServeStream", q)
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	eventWriter, err := streamhttp.NewWriter(w)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Always send a final done event so clients know the stream is shutting
+	// down.
+	defer eventWriter.Event("done", map[string]interface{}{})
+
+	events, results := h.startSearch(ctx, searchQuery)
+
+	_, isAggregate := computeQuery.Command.(*compute.Aggregate)
+	aggregator := compute.NewAggregator()
+	aggregateDirty := false
+
+	resultsBuf := streamhttp.NewJSONArrayBuf(32*1024, func(data []byte) error {
+		return eventWriter.EventBytes("results", data)
+	})
+	flush := func() {
+		if aggregateDirty {
+			aggregateDirty = false
+			_ = eventWriter.Event("aggregate", aggregator.Table())
+		}
+		_ = resultsBuf.Flush()
+	}
+
+	flushTicker := time.NewTicker(h.flushTickerInternal)
+	defer flushTicker.Stop()
+
+	handleEvent := func(event streaming.SearchEvent) {
+		for _, match := range event.Results {
+			computeResult, err := computeQuery.Command.Run(ctx, match)
+			if err != nil {
+				log15.Warn("compute: failed to run command", "repo", match.RepoName().Name, "error", err)
+				continue
+			}
+			if computeResult == nil {
+				continue
+			}
+			if isAggregate {
+				if table, ok := computeResult.(*compute.Table); ok && len(table.Rows) > 0 {
+					aggregator.Add(table)
+					aggregateDirty = true
+				}
+				continue
+			}
+			path, commit := computeMatchPathAndCommit(match)
+			_ = resultsBuf.Append(EventComputeResult{
+				Type:       computeResultType(computeResult),
+				Repository: string(match.RepoName().Name),
+				Commit:     commit,
+				Path:       path,
+				Result:     computeResult,
+			})
+		}
+	}
+
+LOOP:
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				break LOOP
+			}
+			handleEvent(event)
+		case <-flushTicker.C:
+			flush()
+		}
+	}
+
+	// Always send the final table exactly once, even if it is empty or
+	// unchanged since the last flush.
+	aggregateDirty = isAggregate
+	flush()
+
+	if err = results(); err != nil {
+		_ = eventWriter.Event("error", streamhttp.EventError{Message: err.Error()})
+	}
+}
+
+// startSearch starts the search of a compute query. It returns the events
+// channel which streams out search events. Once events is closed you can call
+// results which will return the search error, if any.
+func (h *computeStreamHandler) startSearch(ctx context.Context, searchQuery string) (events <-chan streaming.SearchEvent, results func() error) {
+	eventsC := make(chan streaming.SearchEvent)
+
+	patternType := "regexp"
+	search, err := h.newSearchResolver(ctx, h.db, &graphqlbackend.SearchArgs{
+		Query:       searchQuery,
+		PatternType: &patternType,
+
+		Stream: streaming.StreamFunc(func(event streaming.SearchEvent) {
+			eventsC <- event
+		}),
+	})
+	if err != nil {
+		close(eventsC)
+		return eventsC, func() error { return err }
+	}
+
+	final := make(chan error, 1)
+	go func() {
+		defer close(final)
+		defer close(eventsC)
+
+		_, err := search.Results(ctx)
+		final <- err
+	}()
+
+	return eventsC, func() error {
+		return <-final
+	}
+}
+
+func computeResultType(r compute.Result) string {
+	switch r.(type) {
+	case *compute.MatchContext:
+		return "context"
+	case *compute.Text:
+		return "text"
+	}
+	return ""
+}
+
+func computeMatchPathAndCommit(m result.Match) (string, string) {
+	switch v := m.(type) {
+	case *result.FileMatch:
+		return v.Path, string(v.CommitID)
+	case *result.CommitMatch:
+		return "", string(v.Commit.ID)
+	case *result.RepoMatch:
+		return "", v.Rev
+	}
+	return "", ""
+}
@@ -0,0 +1,142 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	api2 "github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+func TestServeComputeStream_aggregate(t *testing.T) {
+	git.Mocks.ReadFile = func(_ api2.CommitID, name string) ([]byte, error) {
+		if name == "a" {
+			return []byte("lodash@4 lodash@3"), nil
+		}
+		return []byte("lodash@4"), nil
+	}
+	t.Cleanup(git.ResetMocks)
+
+	fileMatch := func(path string) result.Match {
+		return &result.FileMatch{File: result.File{Repo: types.MinimalRepo{Name: "foo"}, Path: path}}
+	}
+
+	mock := &mockSearchResolver{
+		done: make(chan struct{}),
+	}
+	var searchQuery string
+	ts := httptest.NewServer(&computeStreamHandler{
+		flushTickerInternal: 1 * time.Millisecond,
+		newSearchResolver: func(_ context.Context, _ database.DB, args *graphqlbackend.SearchArgs) (searchResolver, error) {
+			searchQuery = args.Query
+			mock.c = args.Stream
+			go func() {
+				mock.c.Send(streaming.SearchEvent{Results: []result.Match{fileMatch("a")}})
+				mock.c.Send(streaming.SearchEvent{Results: []result.Match{fileMatch("b")}})
+				mock.Close()
+			}()
+			return mock, nil
+		},
+	})
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "?q=" + url.QueryEscape(`content:aggregate(lodash@(\d) -> $1) repo:foo`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var events []string
+	var lastAggregate string
+	dec := streamhttp.NewDecoder(resp.Body)
+	for dec.Scan() {
+		events = append(events, string(dec.Event()))
+		if string(dec.Event()) == "aggregate" {
+			lastAggregate = string(dec.Data())
+		}
+	}
+	if err := dec.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `repo:foo lodash@(\d)`; searchQuery != want {
+		t.Errorf("got search query %q, want %q", searchQuery, want)
+	}
+	if want := `{"rows":[{"value":"4","count":2},{"value":"3","count":1}],"kind":"aggregate"}`; lastAggregate != want {
+		t.Errorf("unexpected final aggregate (-want +got):\n%s", cmp.Diff(want, lastAggregate))
+	}
+	if len(events) == 0 || events[len(events)-1] != "done" {
+		t.Errorf("expected stream to end with done event, got %v", events)
+	}
+}
+
+func TestServeComputeStream_aggregateSentOnce(t *testing.T) {
+	git.Mocks.ReadFile = func(_ api2.CommitID, name string) ([]byte, error) {
+		return []byte("lodash@4"), nil
+	}
+	t.Cleanup(git.ResetMocks)
+
+	mock := &mockSearchResolver{
+		done: make(chan struct{}),
+	}
+	ts := httptest.NewServer(&computeStreamHandler{
+		// The ticker never fires, so the only flush is the final one.
+		flushTickerInternal: time.Hour,
+		newSearchResolver: func(_ context.Context, _ database.DB, args *graphqlbackend.SearchArgs) (searchResolver, error) {
+			mock.c = args.Stream
+			go func() {
+				mock.c.Send(streaming.SearchEvent{Results: []result.Match{
+					&result.FileMatch{File: result.File{Repo: types.MinimalRepo{Name: "foo"}, Path: "a"}},
+				}})
+				mock.Close()
+			}()
+			return mock, nil
+		},
+	})
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "?q=" + url.QueryEscape(`content:aggregate(lodash@(\d) -> $1) repo:foo`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var events []string
+	dec := streamhttp.NewDecoder(resp.Body)
+	for dec.Scan() {
+		events = append(events, string(dec.Event()))
+	}
+	if err := dec.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{"aggregate", "done"}, events); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+}
+
+func TestServeComputeStream_invalidQuery(t *testing.T) {
+	ts := httptest.NewServer(ComputeStreamHandler(nil))
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "?q=" + url.QueryEscape("repo:foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
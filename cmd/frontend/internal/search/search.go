@@ -423,11 +423,20 @@ func fromMatch(match result.Match, repoCache map[api.RepoID]*types.SearchedRepo)
 		return fromRepository(v, repoCache)
 	case *result.CommitMatch:
 		return fromCommit(v, repoCache)
+	case *result.OwnerMatch:
+		return fromOwner(v)
 	default:
 		panic(fmt.Sprintf("unknown match type %T", v))
 	}
 }
 
+func fromOwner(om *result.OwnerMatch) *streamhttp.EventOwnerMatch {
+	return &streamhttp.EventOwnerMatch{
+		Type:   streamhttp.OwnerMatchType,
+		Handle: om.Handle,
+	}
+}
+
 func fromFileMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.SearchedRepo) streamhttp.EventMatch {
 	if len(fm.Symbols) > 0 {
 		return fromSymbolMatch(fm, repoCache)
@@ -571,6 +580,10 @@ func fromCommit(commit *result.CommitMatch, repoCache map[api.RepoID]*types.Sear
 		Ranges:     ranges,
 	}
 
+	if commit.DiffFile != nil {
+		commitEvent.DiffFile = fromDiffFile(commit.DiffFile)
+	}
+
 	if r, ok := repoCache[commit.Repo.ID]; ok {
 		commitEvent.RepoStars = r.Stars
 		commitEvent.RepoLastFetched = r.LastFetched
@@ -579,6 +592,39 @@ func fromCommit(commit *result.CommitMatch, repoCache map[api.RepoID]*types.Sear
 	return commitEvent
 }
 
+func fromDiffFile(file *result.DiffFile) *streamhttp.EventDiffFile {
+	hunks := make([]streamhttp.EventDiffHunk, 0, len(file.Hunks))
+	for _, hunk := range file.Hunks {
+		lines := make([]streamhttp.EventDiffLine, 0, len(hunk.Lines))
+		for _, line := range hunk.Lines {
+			var offsetAndLengths [][2]int32
+			for _, r := range line.MatchedRanges {
+				offsetAndLengths = append(offsetAndLengths, [2]int32{int32(r.Start.Column), int32(r.End.Column - r.Start.Column)})
+			}
+			lines = append(lines, streamhttp.EventDiffLine{
+				Kind:             string(line.Kind),
+				OldLineNumber:    line.OldLine,
+				NewLineNumber:    line.NewLine,
+				Line:             line.Content,
+				OffsetAndLengths: offsetAndLengths,
+			})
+		}
+		hunks = append(hunks, streamhttp.EventDiffHunk{
+			OldStart: hunk.OldStart,
+			OldLines: hunk.OldLines,
+			NewStart: hunk.NewStart,
+			NewLines: hunk.NewLines,
+			Header:   hunk.Header,
+			Lines:    lines,
+		})
+	}
+	return &streamhttp.EventDiffFile{
+		OrigName: file.OrigName,
+		NewName:  file.NewName,
+		Hunks:    hunks,
+	}
+}
+
 // eventStreamOTHook returns a StatHook which logs to log.
 func eventStreamOTHook(log func(...otlog.Field)) func(streamhttp.WriterStat) {
 	return func(stat streamhttp.WriterStat) {
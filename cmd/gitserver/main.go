@@ -118,7 +118,7 @@ func main() {
 					return "", err
 				}
 
-				return repos.CloneURL(svc.Kind, svc.Config, r)
+				return repos.CloneURL(svc.ID, svc.Kind, svc.Config, r)
 			}
 			return "", errors.Errorf("no sources for %q", repo)
 		},
@@ -318,6 +318,18 @@ func getVCSSyncer(ctx context.Context, externalServiceStore database.ExternalSer
 			return nil, err
 		}
 		return &server.JVMPackagesSyncer{Config: &c, DBStore: codeintelDB}, nil
+	case extsvc.TypeNPMPackages:
+		var c schema.NPMPackagesConnection
+		if err := extractOptions(&c); err != nil {
+			return nil, err
+		}
+		return &server.NPMPackagesSyncer{Config: &c, DBStore: codeintelDB}, nil
+	case extsvc.TypeGoModules:
+		var c schema.GoModulesConnection
+		if err := extractOptions(&c); err != nil {
+			return nil, err
+		}
+		return &server.GoModulesSyncer{Config: &c, DBStore: codeintelDB}, nil
 	}
 	return &server.GitRepoSyncer{}, nil
 }
@@ -924,6 +924,7 @@ func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
 	tr.LogFields(
 		otlog.String("repo", string(args.Repo)),
 		otlog.Bool("include_diff", args.IncludeDiff),
+		otlog.Bool("include_diff_files", args.IncludeDiffFiles),
 		otlog.String("query", args.Query.String()),
 		otlog.Int("limit", args.Limit),
 	)
@@ -1055,10 +1056,11 @@ func (s *Server) search(ctx context.Context, args *protocol.SearchRequest, match
 		}
 
 		searcher := &search.CommitSearcher{
-			RepoDir:     dir.Path(),
-			Revisions:   args.Revisions,
-			Query:       mt,
-			IncludeDiff: args.IncludeDiff,
+			RepoDir:          dir.Path(),
+			Revisions:        args.Revisions,
+			Query:            mt,
+			IncludeDiff:      args.IncludeDiff,
+			IncludeDiffFiles: args.IncludeDiffFiles,
 		}
 
 		return searcher.Search(ctx, func(match *protocol.CommitMatch) {
@@ -0,0 +1,235 @@
+package server
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"golang.org/x/mod/semver"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodules/goproxy"
+	"github.com/sourcegraph/sourcegraph/internal/repos"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// placeholderGoModule is used to set GIT_AUTHOR_NAME for git commands that
+// don't create commits or tags. The name of this module should never be
+// publicly visible so it can have any random value.
+const placeholderGoModule = "github.com/sourcegraph/sourcegraph"
+
+type GoModulesSyncer struct {
+	Config  *schema.GoModulesConnection
+	DBStore repos.GoModulesRepoStore
+}
+
+var _ VCSSyncer = &GoModulesSyncer{}
+
+func (s *GoModulesSyncer) Type() string {
+	return "go_modules"
+}
+
+func (s *GoModulesSyncer) syncer() packagesSyncer {
+	return packagesSyncer{source: s, placeholder: placeholderGoModule, tempDirPrefix: "gomod"}
+}
+
+// IsCloneable checks to see if the VCS remote URL is cloneable. Any non-nil
+// error indicates there is a problem.
+func (s *GoModulesSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
+	return s.syncer().IsCloneable(ctx, remoteURL)
+}
+
+// CloneCommand returns the command to be executed for cloning from remote.
+func (s *GoModulesSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, bareGitDirectory string) (*exec.Cmd, error) {
+	return s.syncer().CloneCommand(ctx, remoteURL, bareGitDirectory)
+}
+
+// Fetch adds git tags for newly added dependency versions and removes git tags
+// for deleted versions.
+func (s *GoModulesSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir) error {
+	return s.syncer().Fetch(ctx, remoteURL, dir)
+}
+
+// RemoteShowCommand returns the command to be executed for showing remote.
+func (s *GoModulesSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
+	return s.syncer().RemoteShowCommand(ctx, remoteURL)
+}
+
+func (s *GoModulesSyncer) dependencies(ctx context.Context, repoURLPath string) ([]packageDependency, error) {
+	dependencies, err := s.moduleDependencies(ctx, repoURLPath)
+	if err != nil {
+		return nil, err
+	}
+	packageDependencies := make([]packageDependency, 0, len(dependencies))
+	for i := range dependencies {
+		packageDependencies = append(packageDependencies, &dependencies[i])
+	}
+	return packageDependencies, nil
+}
+
+func (s *GoModulesSyncer) exists(ctx context.Context, dependency packageDependency) error {
+	_, err := goproxy.Exists(ctx, s.Config, *dependency.(*reposource.GoDependency))
+	return err
+}
+
+// unpack extracts the files of the module zip archive of the given dependency
+// into workingDirectory.
+func (s *GoModulesSyncer) unpack(ctx context.Context, dependency packageDependency, workingDirectory string) error {
+	goDependency := *dependency.(*reposource.GoDependency)
+
+	// The zip archive is downloaded to a separate directory so that it isn't
+	// committed along with the module sources.
+	zipDirectory, err := os.MkdirTemp("", "gomod-zip")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(zipDirectory)
+
+	zipPath := path.Join(zipDirectory, "module.zip")
+	if err := s.downloadZip(ctx, goDependency, zipPath); err != nil {
+		return err
+	}
+
+	if err := unzipGoModule(zipPath, goDependency, workingDirectory); err != nil {
+		return errors.Wrapf(err, "failed to unzip module zip for %s", dependency.PackageManagerSyntax())
+	}
+	return nil
+}
+
+// moduleDependencies returns the list of Go dependencies that belong to the
+// given URL path. The returned module dependencies are sorted by semantic
+// versioning. A URL maps to a single Go module, which may contain multiple
+// versions (one git tag per version).
+func (s *GoModulesSyncer) moduleDependencies(ctx context.Context, repoUrlPath string) (dependencies []reposource.GoDependency, err error) {
+	mod, err := reposource.ParseGoModuleFromRepoURL(repoUrlPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalConfigMatched int
+	for _, dependency := range s.Config.Dependencies {
+		if !mod.MatchesDependencyString(dependency) {
+			continue
+		}
+		dependency, err := reposource.ParseGoDependency(dependency)
+		if err != nil {
+			return nil, err
+		}
+
+		// Silently ignore non-existent dependencies because they are
+		// already logged out when listing the repos of the external
+		// service in internal/repos/go_modules.go.
+		if exists, _ := goproxy.Exists(ctx, s.Config, dependency); exists {
+			totalConfigMatched++
+			dependencies = append(dependencies, dependency)
+		}
+	}
+
+	dbDeps, err := s.DBStore.GetGoDependencyRepos(ctx, dbstore.GetGoDependencyReposOpts{
+		ModulePath: mod.Path,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get Go dependency repos from database for %s", repoUrlPath)
+	}
+
+	isAdded := make(map[string]bool, len(dependencies))
+	for _, dependency := range dependencies {
+		isAdded[dependency.Version] = true
+	}
+	for _, dep := range dbDeps {
+		if isAdded[dep.Version] {
+			continue
+		}
+		isAdded[dep.Version] = true
+		// Skip versions that aren't valid module versions, such as the
+		// "go1.17" versions of the standard library.
+		if !semver.IsValid(dep.Version) {
+			continue
+		}
+		// we don't check whether the version exists here, as existence
+		// should be verified by repo-updater
+		dependencies = append(dependencies, reposource.GoDependency{
+			GoModule: mod,
+			Version:  dep.Version,
+		})
+	}
+
+	if len(dependencies) == 0 {
+		return nil, errors.Errorf("no Go dependencies for URL path %s", repoUrlPath)
+	}
+
+	log15.Info("fetched Go module for repo path", "repoPath", repoUrlPath, "totalDB", len(dbDeps), "totalConfig", totalConfigMatched)
+	reposource.SortGoDependencies(dependencies)
+	return dependencies, nil
+}
+
+func (s *GoModulesSyncer) downloadZip(ctx context.Context, dependency reposource.GoDependency, zipPath string) (err error) {
+	zipReader, err := goproxy.FetchZip(ctx, s.Config, dependency)
+	if err != nil {
+		return err
+	}
+	defer zipReader.Close()
+
+	zipFile, err := os.Create(zipPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err1 := zipFile.Close()
+		if err == nil {
+			err = err1
+		}
+	}()
+
+	_, err = io.Copy(zipFile, zipReader)
+	return err
+}
+
+// unzipGoModule extracts the files of the module zip archive at zipPath into
+// destination. All files in module zip archives are prefixed with
+// "<module path>@<version>/", which is stripped from the extracted paths.
+func unzipGoModule(zipPath string, dependency reposource.GoDependency, destination string) (err error) {
+	reader, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	destinationDirectory := strings.TrimSuffix(destination, string(os.PathSeparator)) + string(os.PathSeparator)
+	prefix := dependency.PackageManagerSyntax() + "/"
+
+	for _, file := range reader.File {
+		if !strings.HasPrefix(file.Name, prefix) {
+			// Skip files outside of the module directory.
+			continue
+		}
+		name := strings.TrimPrefix(file.Name, prefix)
+
+		if strings.HasSuffix(name, "/") {
+			// Skip directory entries.
+			continue
+		}
+		if isUnsafeArchivePath(path.Clean(name)) {
+			continue
+		}
+		cleanedOutputPath := path.Join(destination, name)
+		if !strings.HasPrefix(cleanedOutputPath, destinationDirectory) {
+			// For security reasons, skip file if it's not a child
+			// of the target directory. See "Zip Slip Vulnerability".
+			continue
+		}
+
+		if err := copyZipFileEntry(file, cleanedOutputPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
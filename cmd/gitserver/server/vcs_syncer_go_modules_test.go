@@ -0,0 +1,171 @@
+package server
+
+import (
+	"archive/zip"
+	"context"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	exampleGoFilePath      = "example.go"
+	exampleGoFileContents  = "package example\n\nconst X = 1\n"
+	exampleGoFileContents2 = "package example\n\nconst X = 2\n"
+	exampleGoModule        = "example.com/example"
+	exampleGoModuleURL     = "go/example.com/example"
+)
+
+// createGoModuleZip writes a module zip archive with the given files to
+// zipPath. The files are prefixed with "<module>@<version>/" like in the zip
+// archives served by module proxies, unless their name starts with "/".
+func createGoModuleZip(t *testing.T, zipPath, prefix string, files map[string]string) {
+	t.Helper()
+	assert.Nil(t, os.MkdirAll(path.Dir(zipPath), 0755))
+	zipFile, err := os.Create(zipPath)
+	assert.Nil(t, err)
+	defer zipFile.Close()
+	zipWriter := zip.NewWriter(zipFile)
+	for name, contents := range files {
+		if strings.HasPrefix(name, "/") {
+			name = strings.TrimPrefix(name, "/")
+		} else {
+			name = prefix + name
+		}
+		w, err := zipWriter.Create(name)
+		assert.Nil(t, err)
+		_, err = w.Write([]byte(contents))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, zipWriter.Close())
+}
+
+// goTestProxy creates a file-based module proxy serving the given versions of
+// the example.com/example module and returns its URL.
+func goTestProxy(t *testing.T, versions map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for version, contents := range versions {
+		versionPath := path.Join(dir, exampleGoModule, "@v", version)
+		createGoModuleZip(t, versionPath+".zip", exampleGoModule+"@"+version+"/", map[string]string{exampleGoFilePath: contents})
+		assert.Nil(t, os.WriteFile(versionPath+".info", []byte(`{"Version":"`+version+`"}`), 0644))
+	}
+	return "file://" + dir
+}
+
+func (s GoModulesSyncer) runCloneCommand(t *testing.T, bareGitDirectory string, dependencies []string) {
+	t.Helper()
+	url := vcs.URL{
+		URL: url.URL{Path: exampleGoModuleURL},
+	}
+	s.Config.Dependencies = dependencies
+	cmd, err := s.CloneCommand(context.Background(), &url, bareGitDirectory)
+	assert.Nil(t, err)
+	assert.Nil(t, cmd.Run())
+}
+
+func TestGoModulesCloneCommand(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	proxy := goTestProxy(t, map[string]string{
+		"v1.0.0": exampleGoFileContents,
+		"v2.0.0": exampleGoFileContents2,
+	})
+
+	s := GoModulesSyncer{
+		Config:  &schema.GoModulesConnection{Urls: []string{proxy}},
+		DBStore: &simpleGoModulesDBStoreMock{},
+	}
+	bareGitDirectory := path.Join(dir, "git")
+
+	s.runCloneCommand(t, bareGitDirectory, []string{exampleGoModule + "@v1.0.0"})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\n",
+	)
+	assertCommandOutput(t,
+		exec.Command("git", "show", "v1.0.0:"+exampleGoFilePath),
+		bareGitDirectory,
+		exampleGoFileContents,
+	)
+
+	s.runCloneCommand(t, bareGitDirectory, []string{exampleGoModule + "@v1.0.0", exampleGoModule + "@v2.0.0"})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\nv2.0.0\n", // verify that the v2.0.0 tag got added
+	)
+	assertCommandOutput(t,
+		exec.Command("git", "show", "v2.0.0:"+exampleGoFilePath),
+		bareGitDirectory,
+		exampleGoFileContents2,
+	)
+	assertCommandOutput(t,
+		exec.Command("git", "show", "latest:"+exampleGoFilePath),
+		bareGitDirectory,
+		exampleGoFileContents2,
+	)
+
+	s.runCloneCommand(t, bareGitDirectory, []string{exampleGoModule + "@v1.0.0"})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\n", // verify that the v2.0.0 tag has been removed.
+	)
+}
+
+func TestUnzipGoModuleNoMaliciousFiles(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	dependency, err := reposource.ParseGoDependency(exampleGoModule + "@v1.0.0")
+	assert.Nil(t, err)
+
+	files := map[string]string{harmlessPath: ""}
+	for _, maliciousPath := range maliciousPaths {
+		files[maliciousPath] = ""
+	}
+	// Paths which only point into .git once they are cleaned.
+	files["x/../.git/config"] = ""
+	files["./.git/hooks/pre-commit"] = ""
+	files["/outside-of-module.go"] = ""
+
+	zipPath := path.Join(dir, "module.zip")
+	createGoModuleZip(t, zipPath, dependency.PackageManagerSyntax()+"/", files)
+
+	destination := path.Join(dir, "src")
+	assert.Nil(t, os.Mkdir(destination, 0755))
+	assert.Nil(t, unzipGoModule(zipPath, dependency, destination))
+
+	dirEntries, err := os.ReadDir(destination)
+	assert.Nil(t, err)
+	baseline := map[string]int{strings.Split(harmlessPath, string(os.PathSeparator))[0]: 0}
+	paths := map[string]int{}
+	for _, dirEntry := range dirEntries {
+		paths[dirEntry.Name()] = 0
+	}
+	if !reflect.DeepEqual(baseline, paths) {
+		t.Errorf("expected paths: %v\n   found paths:%v", baseline, paths)
+	}
+}
+
+type simpleGoModulesDBStoreMock struct{}
+
+func (m *simpleGoModulesDBStoreMock) GetGoDependencyRepos(ctx context.Context, filter dbstore.GetGoDependencyReposOpts) ([]dbstore.GoDependencyRepo, error) {
+	return []dbstore.GoDependencyRepo{}, nil
+}
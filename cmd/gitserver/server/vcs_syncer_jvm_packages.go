@@ -26,10 +26,10 @@ import (
 )
 
 const (
-	// DO NOT CHANGE. This timestamp needs to be stable so that JVM package
+	// DO NOT CHANGE. This timestamp needs to be stable so that package
 	// repos consistently produce the same git revhash. Sourcegraph URLs
 	// can optionally include this hash, so changing the timestamp (and hence
-	// hashes) will cause existing links to JVM package repos to return 404s.
+	// hashes) will cause existing links to package repos to return 404s.
 	stableGitCommitDate = "Thu Apr 8 14:24:52 2021 +0200"
 
 	jvmMajorVersion0 = 44
@@ -468,7 +468,14 @@ func roundJVMVersionToNearestStableVersion(javaVersion int) int {
 }
 
 func runCommandInDirectory(ctx context.Context, cmd *exec.Cmd, workingDirectory string, dependency reposource.MavenDependency) (string, error) {
-	gitName := dependency.MavenModule.CoursierSyntax() + " authors"
+	return runCommandInDirectoryAs(ctx, cmd, workingDirectory, dependency.MavenModule.CoursierSyntax())
+}
+
+// runCommandInDirectoryAs runs the given git command in the working directory.
+// Commits and tags are attributed to the authors of the given package, with a
+// stable date so that the resulting revhashes are reproducible.
+func runCommandInDirectoryAs(ctx context.Context, cmd *exec.Cmd, workingDirectory, packageName string) (string, error) {
+	gitName := packageName + " authors"
 	gitEmail := "code-intel@sourcegraph.com"
 	cmd.Dir = workingDirectory
 	cmd.Env = append(cmd.Env, "EMAIL="+gitEmail)
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/npmpackages/npm"
+	"github.com/sourcegraph/sourcegraph/internal/repos"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// placeholderNPMPackage is used to set GIT_AUTHOR_NAME for git commands that
+// don't create commits or tags. The name of this package should never be
+// publicly visible so it can have any random value.
+const placeholderNPMPackage = "@sourcegraph/sourcegraph"
+
+type NPMPackagesSyncer struct {
+	Config  *schema.NPMPackagesConnection
+	DBStore repos.NPMPackagesRepoStore
+}
+
+var _ VCSSyncer = &NPMPackagesSyncer{}
+
+func (s *NPMPackagesSyncer) Type() string {
+	return "npm_packages"
+}
+
+func (s *NPMPackagesSyncer) syncer() packagesSyncer {
+	return packagesSyncer{source: s, placeholder: placeholderNPMPackage, tempDirPrefix: "npm"}
+}
+
+// IsCloneable checks to see if the VCS remote URL is cloneable. Any non-nil
+// error indicates there is a problem.
+func (s *NPMPackagesSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
+	return s.syncer().IsCloneable(ctx, remoteURL)
+}
+
+// CloneCommand returns the command to be executed for cloning from remote.
+func (s *NPMPackagesSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, bareGitDirectory string) (*exec.Cmd, error) {
+	return s.syncer().CloneCommand(ctx, remoteURL, bareGitDirectory)
+}
+
+// Fetch adds git tags for newly added dependency versions and removes git tags
+// for deleted versions.
+func (s *NPMPackagesSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir) error {
+	return s.syncer().Fetch(ctx, remoteURL, dir)
+}
+
+// RemoteShowCommand returns the command to be executed for showing remote.
+func (s *NPMPackagesSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
+	return s.syncer().RemoteShowCommand(ctx, remoteURL)
+}
+
+func (s *NPMPackagesSyncer) dependencies(ctx context.Context, repoURLPath string) ([]packageDependency, error) {
+	dependencies, err := s.packageDependencies(ctx, repoURLPath)
+	if err != nil {
+		return nil, err
+	}
+	packageDependencies := make([]packageDependency, 0, len(dependencies))
+	for i := range dependencies {
+		packageDependencies = append(packageDependencies, &dependencies[i])
+	}
+	return packageDependencies, nil
+}
+
+func (s *NPMPackagesSyncer) exists(ctx context.Context, dependency packageDependency) error {
+	_, err := npm.Exists(ctx, s.Config, *dependency.(*reposource.NPMDependency))
+	return err
+}
+
+// unpack extracts the file contents of the published tarball of the given
+// dependency into workingDirectory.
+func (s *NPMPackagesSyncer) unpack(ctx context.Context, dependency packageDependency, workingDirectory string) error {
+	tarball, err := npm.FetchTarball(ctx, s.Config, *dependency.(*reposource.NPMDependency))
+	if err != nil {
+		return err
+	}
+	defer tarball.Close()
+
+	if err := decompressTgz(tarball, workingDirectory); err != nil {
+		return errors.Wrapf(err, "failed to decompress tarball for %s", dependency.PackageManagerSyntax())
+	}
+	return nil
+}
+
+// packageDependencies returns the list of npm dependencies that belong to the
+// given URL path. The returned package dependencies are sorted by semantic
+// versioning. A URL maps to a single npm package, which may contain multiple
+// versions (one git tag per version).
+func (s *NPMPackagesSyncer) packageDependencies(ctx context.Context, repoUrlPath string) (dependencies []reposource.NPMDependency, err error) {
+	pkg, err := reposource.ParseNPMPackageFromRepoURL(repoUrlPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalConfigMatched int
+	for _, dependency := range s.Config.Dependencies {
+		if !pkg.MatchesDependencyString(dependency) {
+			continue
+		}
+		dependency, err := reposource.ParseNPMDependency(dependency)
+		if err != nil {
+			return nil, err
+		}
+
+		// Silently ignore non-existent dependencies because they are
+		// already logged out when listing the repos of the external
+		// service in internal/repos/npm_packages.go.
+		if exists, _ := npm.Exists(ctx, s.Config, dependency); exists {
+			totalConfigMatched++
+			dependencies = append(dependencies, dependency)
+		}
+	}
+
+	dbDeps, err := s.DBStore.GetNPMDependencyRepos(ctx, dbstore.GetNPMDependencyReposOpts{
+		PackageName: pkg.PackageSyntax(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get npm dependency repos from database for %s", repoUrlPath)
+	}
+
+	isAdded := make(map[string]bool, len(dependencies))
+	for _, dependency := range dependencies {
+		isAdded[dependency.Version] = true
+	}
+	for _, dep := range dbDeps {
+		if isAdded[dep.Version] {
+			continue
+		}
+		isAdded[dep.Version] = true
+		// we don't check whether the version exists here, as existence
+		// should be verified by repo-updater
+		dependencies = append(dependencies, reposource.NPMDependency{
+			NPMPackage: pkg,
+			Version:    dep.Version,
+		})
+	}
+
+	if len(dependencies) == 0 {
+		return nil, errors.Errorf("no npm dependencies for URL path %s", repoUrlPath)
+	}
+
+	log15.Info("fetched npm package for repo path", "repoPath", repoUrlPath, "totalDB", len(dbDeps), "totalConfig", totalConfigMatched)
+	reposource.SortNPMDependencies(dependencies)
+	return dependencies, nil
+}
+
+// decompressTgz extracts the regular files of the given gzip-compressed tar
+// archive into destination. npm tarballs contain a single top-level directory
+// (usually "package/"), which is stripped from the extracted paths.
+func decompressTgz(tgz io.Reader, destination string) error {
+	gzipReader, err := gzip.NewReader(tgz)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	destinationDirectory := strings.TrimSuffix(destination, string(os.PathSeparator)) + string(os.PathSeparator)
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			// Skip directories, symlinks and other special files.
+			// Symlinks in particular could point outside of the
+			// destination directory.
+			continue
+		}
+
+		name := strings.TrimPrefix(header.Name, "./")
+		i := strings.Index(name, "/")
+		if i < 0 {
+			// Skip files outside of the top-level directory.
+			continue
+		}
+		name = path.Clean(name[i+1:])
+
+		if isUnsafeArchivePath(name) {
+			continue
+		}
+		cleanedOutputPath := path.Join(destination, name)
+		if !strings.HasPrefix(cleanedOutputPath, destinationDirectory) {
+			// For security reasons, skip file if it's not a child
+			// of the target directory. See "Zip Slip Vulnerability".
+			continue
+		}
+
+		if err := copyTarFileEntry(tarReader, cleanedOutputPath); err != nil {
+			return err
+		}
+	}
+}
+
+// isUnsafeArchivePath reports whether the cleaned archive entry path name must
+// not be extracted because it is absolute, escapes the destination directory or
+// points into the `.git/` directory.
+func isUnsafeArchivePath(name string) bool {
+	if name == ".git" || strings.HasPrefix(name, ".git/") {
+		// For security reasons, don't extract files under the `.git/`
+		// directory. See https://github.com/sourcegraph/security-issues/issues/163
+		return true
+	}
+	if name == ".." || strings.HasPrefix(name, "../") {
+		// Skip paths that stray outside of the destination directory.
+		return true
+	}
+	// Skip absolute paths.
+	return strings.HasPrefix(name, "/")
+}
+
+func copyTarFileEntry(reader io.Reader, outputPath string) (err error) {
+	if err = os.MkdirAll(path.Dir(outputPath), 0700); err != nil {
+		return err
+	}
+	outputFile, err := os.OpenFile(outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err1 := outputFile.Close()
+		if err == nil {
+			err = err1
+		}
+	}()
+
+	_, err = io.Copy(outputFile, reader)
+	return err
+}
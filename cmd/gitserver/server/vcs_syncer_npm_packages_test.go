@@ -0,0 +1,173 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	exampleNPMFilePath      = "index.js"
+	exampleNPMFileContents  = "module.exports = 1\n"
+	exampleNPMFileContents2 = "module.exports = 2\n"
+	exampleNPMPackageURL    = "npm/example/example"
+)
+
+// createTgz returns a gzip-compressed tar archive with the given files, in the
+// layout used by npm.
+func createTgz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, contents := range files {
+		assert.Nil(t, tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(contents)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tarWriter.Write([]byte(contents))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, tarWriter.Close())
+	assert.Nil(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+// npmTestRegistry serves the metadata and tarballs of the given versions of the
+// @example/example package.
+func npmTestRegistry(t *testing.T, tarballs map[string][]byte) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for version, tarball := range tarballs {
+			switch r.URL.Path {
+			case "/@example/example/" + version:
+				fmt.Fprintf(w, `{"name":"@example/example","version":%q,"dist":{"tarball":"%s/@example/example/-/example-%s.tgz"}}`, version, srv.URL, version)
+				return
+			case "/@example/example/-/example-" + version + ".tgz":
+				_, _ = w.Write(tarball)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func (s NPMPackagesSyncer) runCloneCommand(t *testing.T, bareGitDirectory string, dependencies []string) {
+	t.Helper()
+	url := vcs.URL{
+		URL: url.URL{Path: exampleNPMPackageURL},
+	}
+	s.Config.Dependencies = dependencies
+	cmd, err := s.CloneCommand(context.Background(), &url, bareGitDirectory)
+	assert.Nil(t, err)
+	assert.Nil(t, cmd.Run())
+}
+
+func TestNPMCloneCommand(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	srv := npmTestRegistry(t, map[string][]byte{
+		"1.0.0": createTgz(t, map[string]string{"package/" + exampleNPMFilePath: exampleNPMFileContents}),
+		"2.0.0": createTgz(t, map[string]string{"package/" + exampleNPMFilePath: exampleNPMFileContents2}),
+	})
+
+	s := NPMPackagesSyncer{
+		Config:  &schema.NPMPackagesConnection{Registry: srv.URL},
+		DBStore: &simpleNPMPackageDBStoreMock{},
+	}
+	bareGitDirectory := path.Join(dir, "git")
+
+	s.runCloneCommand(t, bareGitDirectory, []string{"@example/example@1.0.0"})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\n",
+	)
+	assertCommandOutput(t,
+		exec.Command("git", "show", "v1.0.0:"+exampleNPMFilePath),
+		bareGitDirectory,
+		exampleNPMFileContents,
+	)
+
+	s.runCloneCommand(t, bareGitDirectory, []string{"@example/example@1.0.0", "@example/example@2.0.0"})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\nv2.0.0\n", // verify that the v2.0.0 tag got added
+	)
+	assertCommandOutput(t,
+		exec.Command("git", "show", "v2.0.0:"+exampleNPMFilePath),
+		bareGitDirectory,
+		exampleNPMFileContents2,
+	)
+	assertCommandOutput(t,
+		exec.Command("git", "show", "latest:"+exampleNPMFilePath),
+		bareGitDirectory,
+		exampleNPMFileContents2,
+	)
+
+	s.runCloneCommand(t, bareGitDirectory, []string{"@example/example@1.0.0"})
+	assertCommandOutput(t,
+		exec.Command("git", "tag", "--list"),
+		bareGitDirectory,
+		"v1.0.0\n", // verify that the v2.0.0 tag has been removed.
+	)
+}
+
+func TestDecompressTgzNoMaliciousFiles(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{"package/" + harmlessPath: ""}
+	for _, maliciousPath := range maliciousPaths {
+		files["package/"+maliciousPath] = ""
+	}
+	// Paths which only point into .git once they are cleaned.
+	files["package/x/../.git/config"] = ""
+	files["package/./.git/hooks/pre-commit"] = ""
+	files["outside-of-package.js"] = ""
+
+	assert.Nil(t, decompressTgz(bytes.NewReader(createTgz(t, files)), dir))
+
+	dirEntries, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	baseline := map[string]int{strings.Split(harmlessPath, string(os.PathSeparator))[0]: 0}
+	paths := map[string]int{}
+	for _, dirEntry := range dirEntries {
+		paths[dirEntry.Name()] = 0
+	}
+	if !reflect.DeepEqual(baseline, paths) {
+		t.Errorf("expected paths: %v\n   found paths:%v", baseline, paths)
+	}
+}
+
+type simpleNPMPackageDBStoreMock struct{}
+
+func (m *simpleNPMPackageDBStoreMock) GetNPMDependencyRepos(ctx context.Context, filter dbstore.GetNPMDependencyReposOpts) ([]dbstore.NPMDependencyRepo, error) {
+	return []dbstore.NPMDependencyRepo{}, nil
+}
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+// packageDependency is a version of a package that is synced as a git tag of
+// the repository of the package.
+type packageDependency interface {
+	// PackageSyntax returns the name of the package, which is used as the git
+	// author of the commits.
+	PackageSyntax() string
+	// PackageManagerSyntax returns the name and version of the package in the
+	// syntax of the package manager, which is used as the commit message.
+	PackageManagerSyntax() string
+	GitTagFromVersion() string
+}
+
+// packageSource is implemented by the syncers of package hosts, such as npm
+// registries and Go module proxies.
+type packageSource interface {
+	// dependencies returns the versions of the package that belongs to the
+	// given URL path, sorted by semantic versioning in descending order.
+	dependencies(ctx context.Context, repoURLPath string) ([]packageDependency, error)
+	// exists returns an error if the dependency does not exist on the package
+	// host.
+	exists(ctx context.Context, dependency packageDependency) error
+	// unpack writes the files of the dependency into workingDirectory.
+	unpack(ctx context.Context, dependency packageDependency, workingDirectory string) error
+}
+
+// packagesSyncer implements the VCSSyncer methods that are shared by the
+// syncers of package hosts. Each version of a package is committed to a
+// temporary repository and pushed as a git tag to the bare repository of the
+// package. The "latest" branch points to the latest version.
+type packagesSyncer struct {
+	source packageSource
+
+	// placeholder is used to set GIT_AUTHOR_NAME for git commands that don't
+	// create commits or tags. It should never be publicly visible so it can
+	// have any random value.
+	placeholder string
+
+	// tempDirPrefix is the prefix of the temporary repositories.
+	tempDirPrefix string
+}
+
+// IsCloneable checks to see if the VCS remote URL is cloneable. Any non-nil
+// error indicates there is a problem.
+func (s packagesSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
+	dependencies, err := s.source.dependencies(ctx, remoteURL.Path)
+	if err != nil {
+		return err
+	}
+
+	for _, dependency := range dependencies {
+		if err := s.source.exists(ctx, dependency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CloneCommand returns the command to be executed for cloning from remote.
+// Like for JVM packages, the actual cloning happens inside this method and the
+// returned command is a no-op.
+func (s packagesSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, bareGitDirectory string) (*exec.Cmd, error) {
+	err := os.MkdirAll(bareGitDirectory, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "--bare", "init")
+	if _, err := runCommandInDirectoryAs(ctx, cmd, bareGitDirectory, s.placeholder); err != nil {
+		return nil, err
+	}
+
+	// The Fetch method is responsible for cleaning up temporary directories.
+	if err := s.Fetch(ctx, remoteURL, GitDir(bareGitDirectory)); err != nil {
+		return nil, err
+	}
+
+	// no-op command to satisfy VCSSyncer interface, see docstring for more details.
+	return exec.CommandContext(ctx, "git", "--version"), nil
+}
+
+// Fetch adds git tags for newly added dependency versions and removes git tags
+// for deleted versions.
+func (s packagesSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir) error {
+	dependencies, err := s.source.dependencies(ctx, remoteURL.Path)
+	if err != nil {
+		return err
+	}
+
+	tags := map[string]bool{}
+
+	out, err := runCommandInDirectoryAs(ctx, exec.CommandContext(ctx, "git", "tag"), string(dir), s.placeholder)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range strings.Split(out, "\n") {
+		if len(line) == 0 {
+			continue
+		}
+		tags[line] = true
+	}
+
+	for i, dependency := range dependencies {
+		if tags[dependency.GitTagFromVersion()] {
+			continue
+		}
+		// the gitPushDependencyTag method is reponsible for cleaning up temporary directories.
+		if err := s.gitPushDependencyTag(ctx, string(dir), dependency, i == 0); err != nil {
+			return errors.Wrapf(err, "error pushing dependency %q", dependency.PackageManagerSyntax())
+		}
+	}
+
+	dependencyTags := make(map[string]struct{}, len(dependencies))
+	for _, dependency := range dependencies {
+		dependencyTags[dependency.GitTagFromVersion()] = struct{}{}
+	}
+
+	for tag := range tags {
+		if _, isDependencyTag := dependencyTags[tag]; !isDependencyTag {
+			cmd := exec.CommandContext(ctx, "git", "tag", "-d", tag)
+			if _, err := runCommandInDirectoryAs(ctx, cmd, string(dir), s.placeholder); err != nil {
+				log15.Error("Failed to delete git tag", "error", err, "tag", tag)
+				continue
+			}
+		}
+	}
+
+	return nil
+}
+
+// RemoteShowCommand returns the command to be executed for showing remote.
+func (s packagesSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
+	return exec.CommandContext(ctx, "git", "remote", "show", "./"), nil
+}
+
+// gitPushDependencyTag pushes a git tag to the given bareGitDirectory path. The
+// tag points to a commit that adds all sources of given dependency. When
+// isLatestVersion is true, the "latest" branch of the bare git directory will
+// also be updated to point to the same commit as the git tag.
+func (s packagesSyncer) gitPushDependencyTag(ctx context.Context, bareGitDirectory string, dependency packageDependency, isLatestVersion bool) error {
+	tmpDirectory, err := os.MkdirTemp("", s.tempDirPrefix)
+	if err != nil {
+		return err
+	}
+	// Always clean up created temporary directories.
+	defer os.RemoveAll(tmpDirectory)
+
+	packageName := dependency.PackageSyntax()
+
+	cmd := exec.CommandContext(ctx, "git", "init")
+	if _, err := runCommandInDirectoryAs(ctx, cmd, tmpDirectory, packageName); err != nil {
+		return err
+	}
+
+	if err := s.commitDependency(ctx, dependency, tmpDirectory); err != nil {
+		return err
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "remote", "add", "origin", bareGitDirectory)
+	if _, err := runCommandInDirectoryAs(ctx, cmd, tmpDirectory, packageName); err != nil {
+		return err
+	}
+
+	// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
+	cmd = exec.CommandContext(ctx, "git", "push", "--no-verify", "--force", "origin", "--tags")
+	if _, err := runCommandInDirectoryAs(ctx, cmd, tmpDirectory, packageName); err != nil {
+		return err
+	}
+
+	if isLatestVersion {
+		defaultBranch, err := runCommandInDirectoryAs(ctx, exec.CommandContext(ctx, "git", "rev-parse", "--abbrev-ref", "HEAD"), tmpDirectory, packageName)
+		if err != nil {
+			return err
+		}
+		// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
+		cmd = exec.CommandContext(ctx, "git", "push", "--no-verify", "--force", "origin", strings.TrimSpace(defaultBranch)+":latest", dependency.GitTagFromVersion())
+		if _, err := runCommandInDirectoryAs(ctx, cmd, tmpDirectory, packageName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commitDependency creates a git commit in the given working directory that
+// adds all the files of the given dependency.
+func (s packagesSyncer) commitDependency(ctx context.Context, dependency packageDependency, workingDirectory string) error {
+	if err := s.source.unpack(ctx, dependency, workingDirectory); err != nil {
+		return err
+	}
+
+	packageName := dependency.PackageSyntax()
+
+	cmd := exec.CommandContext(ctx, "git", "add", ".")
+	if _, err := runCommandInDirectoryAs(ctx, cmd, workingDirectory, packageName); err != nil {
+		return err
+	}
+
+	// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
+	cmd = exec.CommandContext(ctx, "git", "commit", "--no-verify", "--allow-empty", "-m", dependency.PackageManagerSyntax(), "--date", stableGitCommitDate)
+	if _, err := runCommandInDirectoryAs(ctx, cmd, workingDirectory, packageName); err != nil {
+		return err
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "tag", "-m", dependency.PackageManagerSyntax(), dependency.GitTagFromVersion())
+	if _, err := runCommandInDirectoryAs(ctx, cmd, workingDirectory, packageName); err != nil {
+		return err
+	}
+
+	return nil
+}
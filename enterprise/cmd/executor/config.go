@@ -2,6 +2,9 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,23 +20,30 @@ import (
 type Config struct {
 	env.BaseConfig
 
-	FrontendURL          string
-	FrontendPassword     string
-	QueueName            string
-	QueuePollInterval    time.Duration
-	MaximumNumJobs       int
-	FirecrackerImage     string
-	VMStartupScriptPath  string
-	VMPrefix             string
-	UseFirecracker       bool
-	FirecrackerNumCPUs   int
-	FirecrackerMemory    string
-	FirecrackerDiskSpace string
-	MaximumRuntimePerJob time.Duration
-	CleanupTaskInterval  time.Duration
-	NumTotalJobs         int
-	MaxActiveTime        time.Duration
-	WorkerHostname       string
+	FrontendURL              string
+	FrontendPassword         string
+	QueueName                string
+	QueuePollInterval        time.Duration
+	MaximumNumJobs           int
+	FirecrackerImage         string
+	VMStartupScriptPath      string
+	VMPrefix                 string
+	UseFirecracker           bool
+	FirecrackerNumCPUs       int
+	FirecrackerMemory        string
+	FirecrackerDiskSpace     string
+	UseKubernetes            bool
+	KubernetesNamespace      string
+	KubernetesNodeSelector   string
+	KubernetesWorkspaceClaim string
+	KubernetesWorkspaceMount string
+	MaximumRuntimePerJob     time.Duration
+	CleanupTaskInterval      time.Duration
+	CacheDir                 string
+	CacheMaxSizeMB           int
+	NumTotalJobs             int
+	MaxActiveTime            time.Duration
+	WorkerHostname           string
 }
 
 func (c *Config) Load() {
@@ -49,8 +59,15 @@ func (c *Config) Load() {
 	c.FirecrackerNumCPUs = c.GetInt("EXECUTOR_FIRECRACKER_NUM_CPUS", "4", "How many CPUs to allocate to each virtual machine or container.")
 	c.FirecrackerMemory = c.Get("EXECUTOR_FIRECRACKER_MEMORY", "12G", "How much memory to allocate to each virtual machine or container.")
 	c.FirecrackerDiskSpace = c.Get("EXECUTOR_FIRECRACKER_DISK_SPACE", "20G", "How much disk space to allocate to each virtual machine or container.")
+	c.UseKubernetes = c.GetBool("EXECUTOR_USE_KUBERNETES", "false", "Whether to run commands in pods of the Kubernetes cluster the executor runs in.")
+	c.KubernetesNamespace = c.Get("EXECUTOR_KUBERNETES_NAMESPACE", "default", "The namespace in which to create pods.")
+	c.KubernetesNodeSelector = c.GetOptional("EXECUTOR_KUBERNETES_NODE_SELECTOR", "A comma-separated list of key=value node labels constraining the nodes on which pods are scheduled.")
+	c.KubernetesWorkspaceClaim = c.GetOptional("EXECUTOR_KUBERNETES_WORKSPACE_VOLUME_CLAIM", "The name of the persistent volume claim shared by the executor and its pods that holds job workspaces.")
+	c.KubernetesWorkspaceMount = c.Get("EXECUTOR_KUBERNETES_WORKSPACE_MOUNT_PATH", "/workspaces", "The path at which the workspace volume claim is mounted into the executor.")
 	c.MaximumRuntimePerJob = c.GetInterval("EXECUTOR_MAXIMUM_RUNTIME_PER_JOB", "30m", "The maximum wall time that can be spent on a single job.")
 	c.CleanupTaskInterval = c.GetInterval("EXECUTOR_CLEANUP_TASK_INTERVAL", "1m", "The frequency with which to run periodic cleanup tasks.")
+	c.CacheDir = c.GetOptional("EXECUTOR_CACHE_DIR", "A directory on the host in which repository clones and job cache directories are cached between jobs. Caching is disabled if unset.")
+	c.CacheMaxSizeMB = c.GetInt("EXECUTOR_CACHE_MAX_SIZE_MB", "20000", "The size in megabytes above which the least recently used cache entries are evicted.")
 	c.NumTotalJobs = c.GetInt("EXECUTOR_NUM_TOTAL_JOBS", "0", "The maximum number of jobs that will be dequeued by the worker.")
 	c.MaxActiveTime = c.GetInterval("EXECUTOR_MAX_ACTIVE_TIME", "0", "The maximum time that can be spent by the worker dequeueing records to be handled.")
 
@@ -65,6 +82,23 @@ func (c *Config) Validate() error {
 		c.AddError(fmt.Errorf("EXECUTOR_FIRECRACKER_NUM_CPUS must be 1 or an even number"))
 	}
 
+	if c.UseKubernetes {
+		if c.UseFirecracker {
+			c.AddError(fmt.Errorf("EXECUTOR_USE_KUBERNETES and EXECUTOR_USE_FIRECRACKER cannot both be enabled"))
+		}
+		if c.KubernetesWorkspaceClaim == "" {
+			c.AddError(fmt.Errorf("EXECUTOR_KUBERNETES_WORKSPACE_VOLUME_CLAIM must be set when EXECUTOR_USE_KUBERNETES is enabled"))
+		}
+		// Workspaces are created in the temporary directory, which must be on the volume
+		// shared with the pods.
+		if rel, err := filepath.Rel(c.KubernetesWorkspaceMount, os.TempDir()); err != nil || strings.HasPrefix(rel, "..") {
+			c.AddError(fmt.Errorf("TMPDIR must be within EXECUTOR_KUBERNETES_WORKSPACE_MOUNT_PATH when EXECUTOR_USE_KUBERNETES is enabled"))
+		}
+		if _, err := c.KubernetesNodeSelectorLabels(); err != nil {
+			c.AddError(err)
+		}
+	}
+
 	return c.BaseConfig.Validate()
 }
 
@@ -74,6 +108,7 @@ func (c *Config) APIWorkerOptions(telemetryOptions apiclient.TelemetryOptions) a
 		QueueName:            c.QueueName,
 		WorkerOptions:        c.WorkerOptions(),
 		FirecrackerOptions:   c.FirecrackerOptions(),
+		KubernetesOptions:    c.KubernetesOptions(),
 		ResourceOptions:      c.ResourceOptions(),
 		MaximumRuntimePerJob: c.MaximumRuntimePerJob,
 		GitServicePath:       "/.executors/git",
@@ -107,6 +142,38 @@ func (c *Config) FirecrackerOptions() command.FirecrackerOptions {
 	}
 }
 
+// KubernetesOptions returns the options of the Kubernetes runner. The clientset is created
+// when the executor starts.
+func (c *Config) KubernetesOptions() command.KubernetesOptions {
+	nodeSelector, _ := c.KubernetesNodeSelectorLabels()
+
+	return command.KubernetesOptions{
+		Enabled:              c.UseKubernetes,
+		Namespace:            c.KubernetesNamespace,
+		NodeSelector:         nodeSelector,
+		WorkspaceVolumeClaim: c.KubernetesWorkspaceClaim,
+		WorkspaceMountPath:   c.KubernetesWorkspaceMount,
+	}
+}
+
+// KubernetesNodeSelectorLabels parses the node selector of pods.
+func (c *Config) KubernetesNodeSelectorLabels() (map[string]string, error) {
+	if c.KubernetesNodeSelector == "" {
+		return nil, nil
+	}
+
+	labels := map[string]string{}
+	for _, pair := range strings.Split(c.KubernetesNodeSelector, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("EXECUTOR_KUBERNETES_NODE_SELECTOR must be a comma-separated list of key=value pairs")
+		}
+		labels[parts[0]] = parts[1]
+	}
+
+	return labels, nil
+}
+
 func (c *Config) ResourceOptions() command.ResourceOptions {
 	return command.ResourceOptions{
 		NumCPUs:   c.FirecrackerNumCPUs,
@@ -61,7 +61,9 @@ func NewBaseClient(options BaseClientOptions) *BaseClient {
 // Do performs the given HTTP request and returns the body. If there is no content
 // to be read due to a 204 response, then a false-valued flag is returned.
 func (c *BaseClient) Do(ctx context.Context, req *http.Request) (hasContent bool, _ io.ReadCloser, err error) {
-	req.Header.Set("Content-Type", "application/json")
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	req.Header.Set("User-Agent", c.options.UserAgent)
 	req = req.WithContext(ctx)
 
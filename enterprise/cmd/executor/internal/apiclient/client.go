@@ -3,6 +3,7 @@ package apiclient
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path/filepath"
@@ -225,6 +226,40 @@ func (c *Client) Heartbeat(ctx context.Context, queueName string, jobIDs []int)
 	return knownIDs, nil
 }
 
+// UploadArtifact streams the content of the given reader to the job queue API, which links
+// the resulting artifact with the given name to the job.
+func (c *Client) UploadArtifact(ctx context.Context, queueName string, jobID int, name string, r io.Reader) (err error) {
+	ctx, endObservation := c.operations.uploadArtifact.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("queueName", queueName),
+		log.Int("jobID", jobID),
+		log.String("name", name),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	u, err := makeURL(
+		c.options.EndpointOptions.URL,
+		c.options.EndpointOptions.Password,
+		c.options.PathPrefix,
+		fmt.Sprintf("%s/uploadArtifact", queueName),
+	)
+	if err != nil {
+		return err
+	}
+	u.RawQuery = url.Values{
+		"executorName": []string{c.options.ExecutorName},
+		"jobId":        []string{strconv.Itoa(jobID)},
+		"name":         []string{name},
+	}.Encode()
+
+	req, err := http.NewRequest("POST", u.String(), r)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	return c.client.DoAndDrop(ctx, req)
+}
+
 func (c *Client) makeRequest(method, path string, payload interface{}) (*http.Request, error) {
 	u, err := makeURL(
 		c.options.EndpointOptions.URL,
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -405,6 +406,44 @@ func TestHeartbeatBadResponse(t *testing.T) {
 	})
 }
 
+func TestUploadArtifact(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if have, want := r.URL.Path, "/.executors/queue/test_queue/uploadArtifact"; have != want {
+			t.Errorf("unexpected path. want=%s have=%s", want, have)
+		}
+		if have, want := r.URL.Query().Encode(), "executorName=deadbeef&jobId=42&name=report.xml"; have != want {
+			t.Errorf("unexpected query. want=%s have=%s", want, have)
+		}
+		if have, want := r.Header.Get("Content-Type"), "application/octet-stream"; have != want {
+			t.Errorf("unexpected content type. want=%s have=%s", want, have)
+		}
+		if _, password, _ := r.BasicAuth(); password != "hunter2" {
+			t.Errorf("unexpected password. want=%s have=%s", "hunter2", password)
+		}
+
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("unexpected error reading payload: %s", err)
+		}
+		if have, want := string(content), "<testsuites/>"; have != want {
+			t.Errorf("unexpected payload. want=%s have=%s", want, have)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client := New(Options{
+		ExecutorName:    "deadbeef",
+		PathPrefix:      "/.executors/queue",
+		EndpointOptions: EndpointOptions{URL: ts.URL, Password: "hunter2"},
+	}, &observation.TestContext)
+
+	if err := client.UploadArtifact(context.Background(), "test_queue", 42, "report.xml", strings.NewReader("<testsuites/>")); err != nil {
+		t.Fatalf("unexpected error uploading artifact: %s", err)
+	}
+}
+
 type routeSpec struct {
 	expectedMethod   string
 	expectedPath     string
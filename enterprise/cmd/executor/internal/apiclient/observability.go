@@ -15,6 +15,7 @@ type operations struct {
 	markErrored             *observation.Operation
 	markFailed              *observation.Operation
 	heartbeat               *observation.Operation
+	uploadArtifact          *observation.Operation
 }
 
 func newOperations(observationContext *observation.Context) *operations {
@@ -41,5 +42,6 @@ func newOperations(observationContext *observation.Context) *operations {
 		markErrored:             op("MarkErrored"),
 		markFailed:              op("MarkFailed"),
 		heartbeat:               op("Heartbeat"),
+		uploadArtifact:          op("UploadArtifact"),
 	}
 }
@@ -0,0 +1,388 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	repositoriesDir = "repositories"
+	directoriesDir  = "directories"
+	tempDir         = "tmp"
+
+	// staleTempEntryAge is the age after which a temporary entry left behind by an
+	// interrupted Save is removed by Evict.
+	staleTempEntryAge = time.Hour
+)
+
+// Cache is a content-addressed cache on the executor host that holds the clones of
+// repositories and the directories declared by jobs (such as dependency directories)
+// between jobs. Entries are addressed by the SHA-256 hash of their key. Once the total
+// size of the cache exceeds its limit, Evict removes the least recently used entries.
+//
+// A cache directory must not be shared by multiple executor instances, as entries are
+// only locked within the current process.
+type Cache struct {
+	root    string
+	maxSize int64
+
+	mu    sync.Mutex
+	locks map[string]*entryLock
+}
+
+type entryLock struct {
+	ch   chan struct{}
+	refs int
+}
+
+// New creates a cache rooted at the given directory on the host. Evict removes entries
+// once the total size of the cache exceeds maxSize bytes.
+func New(root string, maxSize int64) *Cache {
+	return &Cache{
+		root:    root,
+		maxSize: maxSize,
+		locks:   map[string]*entryLock{},
+	}
+}
+
+// LockRepository returns the directory of the cached clone of the given repository,
+// creating an empty directory if the repository isn't cached yet. The directory is
+// locked until the returned release function is called so that concurrent jobs don't
+// fetch into the same clone at once.
+func (c *Cache) LockRepository(ctx context.Context, repositoryName string) (dir string, release func(), err error) {
+	dir = c.entryPath(repositoriesDir, repositoryName)
+
+	release, err = c.lock(ctx, dir)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		release()
+		return "", nil, err
+	}
+	touch(dir)
+
+	return dir, release, nil
+}
+
+// Restore replaces dst with a copy of the cached directory with the given key. The
+// returned boolean is false if there is no cached directory for the key. If dst is a
+// symlink, only the symlink is replaced; the caller must ensure that none of the
+// parents of dst are symlinks.
+func (c *Cache) Restore(ctx context.Context, key, dst string) (bool, error) {
+	entry := c.entryPath(directoriesDir, key)
+
+	release, err := c.lock(ctx, entry)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	if _, err := os.Stat(entry); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	touch(entry)
+
+	// Remove whatever is at dst, so that the copy never writes through symlinks.
+	if err := os.RemoveAll(dst); err != nil {
+		return false, err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return false, err
+	}
+
+	return true, copyDir(entry, dst)
+}
+
+// Save replaces the cached directory with the given key with a copy of src. Nothing is
+// cached if src doesn't exist. Symlinks within src are copied as symlinks, but src
+// itself must be a directory and not a symlink.
+func (c *Cache) Save(ctx context.Context, key, src string) (err error) {
+	info, err := os.Lstat(src)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if !info.IsDir() {
+		return errors.Errorf("refusing to cache %q, which is not a directory", src)
+	}
+
+	// Copy the directory outside of the entry first so that the entry isn't locked
+	// while copying, and so that jobs never restore a partially written entry.
+	if err := os.MkdirAll(filepath.Join(c.root, tempDir), os.ModePerm); err != nil {
+		return err
+	}
+	tmp, err := os.MkdirTemp(filepath.Join(c.root, tempDir), "")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	contents := filepath.Join(tmp, "contents")
+	if err := copyDir(src, contents); err != nil {
+		return err
+	}
+
+	entry := c.entryPath(directoriesDir, key)
+
+	release, err := c.lock(ctx, entry)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if err := os.RemoveAll(entry); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(entry), os.ModePerm); err != nil {
+		return err
+	}
+	if err := os.Rename(contents, entry); err != nil {
+		return err
+	}
+	touch(entry)
+
+	return nil
+}
+
+type cacheEntry struct {
+	path     string
+	size     int64
+	lastUsed time.Time
+}
+
+// Evict removes the least recently used entries of the cache until its total size no
+// longer exceeds the size limit. Entries that are currently in use by a job are
+// skipped. Evict returns the number of removed entries.
+func (c *Cache) Evict(ctx context.Context) (evicted int, err error) {
+	c.removeStaleTempEntries()
+
+	var entries []cacheEntry
+	var totalSize int64
+	for _, kind := range []string{repositoriesDir, directoriesDir} {
+		dirEntries, err := os.ReadDir(filepath.Join(c.root, kind))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return 0, err
+		}
+
+		for _, dirEntry := range dirEntries {
+			// Entries are not locked while their size is computed, so an entry that
+			// is concurrently replaced by Save may be missing and is skipped.
+			path := filepath.Join(c.root, kind, dirEntry.Name())
+			info, err := os.Stat(path)
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return 0, err
+			}
+			size, err := dirSize(path)
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return 0, err
+			}
+
+			entries = append(entries, cacheEntry{path: path, size: size, lastUsed: info.ModTime()})
+			totalSize += size
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].lastUsed.Before(entries[j].lastUsed)
+	})
+
+	for _, entry := range entries {
+		if totalSize <= c.maxSize {
+			break
+		}
+		if ctx.Err() != nil {
+			return evicted, ctx.Err()
+		}
+
+		release, ok := c.tryLock(entry.path)
+		if !ok {
+			continue
+		}
+		removeErr := os.RemoveAll(entry.path)
+		release()
+
+		if removeErr != nil {
+			err = multierror.Append(err, removeErr)
+			continue
+		}
+
+		evicted++
+		totalSize -= entry.size
+	}
+
+	return evicted, err
+}
+
+func (c *Cache) removeStaleTempEntries() {
+	dirEntries, err := os.ReadDir(filepath.Join(c.root, tempDir))
+	if err != nil {
+		return
+	}
+
+	for _, dirEntry := range dirEntries {
+		if info, err := dirEntry.Info(); err == nil && time.Since(info.ModTime()) > staleTempEntryAge {
+			_ = os.RemoveAll(filepath.Join(c.root, tempDir, dirEntry.Name()))
+		}
+	}
+}
+
+func (c *Cache) entryPath(kind, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.root, kind, hex.EncodeToString(sum[:]))
+}
+
+// lock blocks until the entry at the given path is locked by the caller or the given
+// context is canceled.
+func (c *Cache) lock(ctx context.Context, path string) (func(), error) {
+	l := c.ref(path)
+
+	select {
+	case l.ch <- struct{}{}:
+		return func() { c.unlock(path, l) }, nil
+	case <-ctx.Done():
+		c.unref(path, l)
+		return nil, ctx.Err()
+	}
+}
+
+// tryLock locks the entry at the given path if it isn't locked already.
+func (c *Cache) tryLock(path string) (func(), bool) {
+	l := c.ref(path)
+
+	select {
+	case l.ch <- struct{}{}:
+		return func() { c.unlock(path, l) }, true
+	default:
+		c.unref(path, l)
+		return nil, false
+	}
+}
+
+func (c *Cache) ref(path string) *entryLock {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	l, ok := c.locks[path]
+	if !ok {
+		l = &entryLock{ch: make(chan struct{}, 1)}
+		c.locks[path] = l
+	}
+	l.refs++
+
+	return l
+}
+
+func (c *Cache) unref(path string, l *entryLock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	l.refs--
+	if l.refs == 0 {
+		delete(c.locks, path)
+	}
+}
+
+func (c *Cache) unlock(path string, l *entryLock) {
+	<-l.ch
+	c.unref(path, l)
+}
+
+// touch marks the given entry as recently used.
+func touch(path string) {
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+}
+
+func dirSize(root string) (size int64, err error) {
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+
+	return size, err
+}
+
+// copyDir copies the directories, regular files and symlinks under src into dst, which
+// must not exist yet. Other types of files are skipped. Symlinks are never followed.
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch mode := info.Mode(); {
+		case mode.IsDir():
+			return os.Mkdir(target, mode.Perm())
+
+		case mode&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+
+		case mode.IsRegular():
+			return copyFile(path, target, mode.Perm())
+		}
+
+		return nil
+	})
+}
+
+func copyFile(src, dst string, perm os.FileMode) (err error) {
+	in, err := os.OpenFile(src, os.O_RDONLY|syscall.O_NOFOLLOW, 0)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL|syscall.O_NOFOLLOW, perm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = io.Copy(out, in)
+	return err
+}
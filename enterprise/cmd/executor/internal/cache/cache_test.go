@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSaveRestore(t *testing.T) {
+	ctx := context.Background()
+	cache := New(t.TempDir(), 1<<20)
+
+	src := t.TempDir()
+	writeFile(t, filepath.Join(src, "a.txt"), "a")
+	writeFile(t, filepath.Join(src, "nested", "b.txt"), "b")
+	if err := os.Symlink("nested/b.txt", filepath.Join(src, "link")); err != nil {
+		t.Fatal(err)
+	}
+
+	if hit, err := cache.Restore(ctx, "key", t.TempDir()); err != nil || hit {
+		t.Fatalf("expected cache miss, got hit=%v err=%v", hit, err)
+	}
+
+	if err := cache.Save(ctx, "key", src); err != nil {
+		t.Fatalf("unexpected error saving cache entry: %s", err)
+	}
+
+	// Saving a missing directory doesn't replace the entry.
+	if err := cache.Save(ctx, "key", filepath.Join(src, "missing")); err != nil {
+		t.Fatalf("unexpected error saving missing directory: %s", err)
+	}
+
+	dst := filepath.Join(t.TempDir(), "node_modules")
+	if hit, err := cache.Restore(ctx, "key", dst); err != nil || !hit {
+		t.Fatalf("expected cache hit, got hit=%v err=%v", hit, err)
+	}
+
+	for path, want := range map[string]string{"a.txt": "a", "nested/b.txt": "b", "link": "b"} {
+		contents, err := os.ReadFile(filepath.Join(dst, path))
+		if err != nil {
+			t.Fatalf("unexpected error reading %s: %s", path, err)
+		}
+		if have := string(contents); have != want {
+			t.Errorf("unexpected contents of %s. want=%q have=%q", path, want, have)
+		}
+	}
+	if link, err := os.Readlink(filepath.Join(dst, "link")); err != nil || link != "nested/b.txt" {
+		t.Errorf("expected symlink to be preserved, got link=%q err=%v", link, err)
+	}
+}
+
+func TestSaveRestoreSymlinks(t *testing.T) {
+	ctx := context.Background()
+	cache := New(t.TempDir(), 1<<20)
+
+	src := t.TempDir()
+	writeFile(t, filepath.Join(src, "a.txt"), "a")
+	if err := cache.Save(ctx, "key", src); err != nil {
+		t.Fatalf("unexpected error saving cache entry: %s", err)
+	}
+
+	// A symlink to a directory is not cached.
+	link := filepath.Join(t.TempDir(), "node_modules")
+	if err := os.Symlink(src, link); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Save(ctx, "key", link); err == nil {
+		t.Fatalf("expected error saving symlink")
+	}
+
+	// Restoring into a symlink replaces the symlink rather than writing into its target.
+	outside := t.TempDir()
+	if err := os.Symlink(outside, link+".restore"); err != nil {
+		t.Fatal(err)
+	}
+	if hit, err := cache.Restore(ctx, "key", link+".restore"); err != nil || !hit {
+		t.Fatalf("expected cache hit, got hit=%v err=%v", hit, err)
+	}
+	if info, err := os.Lstat(link + ".restore"); err != nil || !info.IsDir() {
+		t.Fatalf("expected symlink to be replaced by a directory, got info=%v err=%v", info, err)
+	}
+	if entries, err := os.ReadDir(outside); err != nil || len(entries) != 0 {
+		t.Errorf("expected symlink target to be untouched, got entries=%v err=%v", entries, err)
+	}
+}
+
+func TestLockRepository(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	cache := New(root, 1<<20)
+
+	dir, release, err := cache.LockRepository(ctx, "github.com/sourcegraph/sourcegraph")
+	if err != nil {
+		t.Fatalf("unexpected error locking repository: %s", err)
+	}
+	if !strings.HasPrefix(dir, filepath.Join(root, repositoriesDir)) {
+		t.Errorf("unexpected repository directory %q", dir)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Errorf("expected repository directory to exist: %s", err)
+	}
+
+	// The directory is locked until released.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := cache.LockRepository(timeoutCtx, "github.com/sourcegraph/sourcegraph"); err == nil {
+		t.Fatalf("expected repository to be locked")
+	}
+
+	release()
+	dir2, release2, err := cache.LockRepository(ctx, "github.com/sourcegraph/sourcegraph")
+	if err != nil {
+		t.Fatalf("unexpected error locking repository: %s", err)
+	}
+	defer release2()
+	if dir2 != dir {
+		t.Errorf("expected stable repository directory. want=%q have=%q", dir, dir2)
+	}
+}
+
+func TestEvict(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	cache := New(root, 25)
+
+	src := t.TempDir()
+	writeFile(t, filepath.Join(src, "file"), strings.Repeat("x", 10))
+
+	for _, key := range []string{"oldest", "older", "newest"} {
+		if err := cache.Save(ctx, key, src); err != nil {
+			t.Fatalf("unexpected error saving cache entry: %s", err)
+		}
+	}
+	for i, key := range []string{"oldest", "older", "newest"} {
+		lastUsed := time.Now().Add(time.Duration(i-3) * time.Hour)
+		if err := os.Chtimes(cache.entryPath(directoriesDir, key), lastUsed, lastUsed); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The oldest entry is in use and must not be removed.
+	release, err := cache.lock(ctx, cache.entryPath(directoriesDir, "oldest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	evicted, err := cache.Evict(ctx)
+	release()
+	if err != nil {
+		t.Fatalf("unexpected error evicting cache entries: %s", err)
+	}
+	if evicted != 1 {
+		t.Fatalf("unexpected number of evicted entries. want=%d have=%d", 1, evicted)
+	}
+	for key, exists := range map[string]bool{"oldest": true, "older": false, "newest": true} {
+		if _, err := os.Stat(cache.entryPath(directoriesDir, key)); (err == nil) != exists {
+			t.Errorf("unexpected existence of entry %q. want=%v err=%v", key, exists, err)
+		}
+	}
+
+	// Once released, the oldest entry is evicted when the cache grows again.
+	if err := cache.Save(ctx, "latest", src); err != nil {
+		t.Fatalf("unexpected error saving cache entry: %s", err)
+	}
+	if evicted, err := cache.Evict(ctx); err != nil || evicted != 1 {
+		t.Fatalf("unexpected eviction result. evicted=%d err=%v", evicted, err)
+	}
+	if _, err := os.Stat(cache.entryPath(directoriesDir, "oldest")); !os.IsNotExist(err) {
+		t.Errorf("expected oldest entry to be evicted")
+	}
+}
+
+func TestEvictMissingEntry(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	cache := New(root, 0)
+
+	src := t.TempDir()
+	writeFile(t, filepath.Join(src, "file"), "x")
+	if err := cache.Save(ctx, "key", src); err != nil {
+		t.Fatalf("unexpected error saving cache entry: %s", err)
+	}
+
+	// An entry that disappears while the cache is scanned, such as one concurrently
+	// replaced by Save, is skipped.
+	if err := os.Symlink(filepath.Join(root, "missing"), filepath.Join(root, directoriesDir, "missing")); err != nil {
+		t.Fatal(err)
+	}
+	if evicted, err := cache.Evict(ctx); err != nil || evicted != 1 {
+		t.Fatalf("unexpected eviction result. evicted=%d err=%v", evicted, err)
+	}
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
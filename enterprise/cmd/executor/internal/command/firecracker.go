@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -125,6 +126,18 @@ func teardownFirecracker(ctx context.Context, runner commandRunner, logger *Logg
 	return nil
 }
 
+// FirecrackerCopyOutCommand returns the command that copies the file or directory at the
+// given path relative to the workspace of the Firecracker virtual machine with the given
+// name to dst on the host. The command must be run on the host before the virtual machine
+// is torn down.
+func FirecrackerCopyOutCommand(key, name, relativePath, dst string, operations *Operations) CommandSpec {
+	return CommandSpec{
+		Key:       key,
+		Command:   []string{"ignite", "cp", fmt.Sprintf("%s:%s", name, filepath.Join(firecrackerContainerDir, relativePath)), dst},
+		Operation: operations.TeardownCacheCopy,
+	}
+}
+
 func firecrackerResourceFlags(options ResourceOptions) []string {
 	return []string{
 		"--cpus", strconv.Itoa(options.NumCPUs),
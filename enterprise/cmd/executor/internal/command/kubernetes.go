@@ -0,0 +1,320 @@
+package command
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/inconshreveable/log15"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+const (
+	// KubernetesManagedByLabel and KubernetesManagedByValue identify the pods created by
+	// executors.
+	KubernetesManagedByLabel = "app.kubernetes.io/managed-by"
+	KubernetesManagedByValue = "sourcegraph-executor"
+
+	// KubernetesExecutorNameLabel is the label holding the name of the runner (see
+	// Options.ExecutorName) that created a pod.
+	KubernetesExecutorNameLabel = "sourcegraph.com/executor-name"
+
+	kubernetesContainerName = "step"
+	kubernetesVolumeName    = "workspace"
+	kubernetesContainerDir  = "/data"
+)
+
+// kubernetesPollInterval is the interval with which the status of a pod is checked
+// while waiting for it to start or finish. It can be replaced for testing.
+var kubernetesPollInterval = time.Second
+
+// kubernetesRunner runs commands that specify an image as pods in a Kubernetes cluster.
+// The workspace is shared with the pods through a persistent volume claim mounted into
+// the executor, and commands without an image are run directly in the executor.
+type kubernetesRunner struct {
+	name    string
+	dir     string
+	subPath string
+	logger  *Logger
+	options Options
+}
+
+var _ Runner = &kubernetesRunner{}
+
+// Setup ensures that the workspace is on the shared workspace volume.
+func (r *kubernetesRunner) Setup(ctx context.Context) error {
+	subPath, err := kubernetesWorkspaceSubPath(r.dir, r.options.KubernetesOptions)
+	if err != nil {
+		return err
+	}
+
+	r.subPath = subPath
+	return nil
+}
+
+// Teardown is a no-op, as the pods are deleted once their command has completed.
+func (r *kubernetesRunner) Teardown(ctx context.Context) error {
+	return nil
+}
+
+func (r *kubernetesRunner) Run(ctx context.Context, command CommandSpec) error {
+	// TODO - make this a non-special case
+	if command.Image == "" {
+		return runCommand(ctx, formatRawOrDockerCommand(command, r.dir, r.options), r.logger)
+	}
+
+	return runKubernetesPod(ctx, formatKubernetesPod(command, r.name, r.subPath, r.options), r.options.KubernetesOptions, command, r.logger)
+}
+
+// kubernetesWorkspaceSubPath returns the path of the given workspace relative to the mount
+// path of the workspace volume in the executor.
+func kubernetesWorkspaceSubPath(dir string, options KubernetesOptions) (string, error) {
+	subPath, err := filepath.Rel(options.WorkspaceMountPath, dir)
+	if err != nil || subPath == "." || strings.HasPrefix(subPath, "..") {
+		return "", errors.Errorf("workspace %q is not within the mount path %q of the workspace volume", dir, options.WorkspaceMountPath)
+	}
+
+	return filepath.ToSlash(subPath), nil
+}
+
+// formatKubernetesPod constructs the pod that runs the script of the given spec in the
+// spec's image. The subPath value is the path of the workspace within the workspace
+// volume, which is mounted into the container in place of the workspace. The container
+// is subject to the resource limits specified in the given options.
+func formatKubernetesPod(spec CommandSpec, name, subPath string, options Options) *corev1.Pod {
+	env := make([]corev1.EnvVar, 0, len(spec.Env))
+	for _, e := range spec.Env {
+		parts := strings.SplitN(e, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		env = append(env, corev1.EnvVar{Name: parts[0], Value: parts[1]})
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kubernetesPodName(name, spec.Key),
+			Namespace: options.KubernetesOptions.Namespace,
+			Labels: map[string]string{
+				KubernetesManagedByLabel:    KubernetesManagedByValue,
+				KubernetesExecutorNameLabel: name,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			NodeSelector:  options.KubernetesOptions.NodeSelector,
+			// Steps run untrusted code and must not be able to talk to the Kubernetes
+			// API with the credentials of the namespace's service account.
+			AutomountServiceAccountToken: boolPtr(false),
+			Containers: []corev1.Container{
+				{
+					Name:       kubernetesContainerName,
+					Image:      spec.Image,
+					Command:    []string{"/bin/sh", path.Join(kubernetesContainerDir, ScriptsPath, spec.ScriptPath)},
+					WorkingDir: path.Join(kubernetesContainerDir, spec.Dir),
+					Env:        env,
+					Resources: corev1.ResourceRequirements{
+						Limits: kubernetesResourceLimits(options.ResourceOptions),
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      kubernetesVolumeName,
+							MountPath: kubernetesContainerDir,
+							SubPath:   subPath,
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: kubernetesVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: options.KubernetesOptions.WorkspaceVolumeClaim,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// kubernetesPodName returns a valid pod name (a DNS label) for the command with the given
+// key run by the runner with the given name.
+func kubernetesPodName(name, key string) string {
+	podName := strings.ToLower(fmt.Sprintf("%s-%s", name, key))
+	podName = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
+			return r
+		}
+		return '-'
+	}, podName)
+
+	// Names of runners end with a unique suffix, so keep the end of the name.
+	if len(podName) > 63 {
+		podName = podName[len(podName)-63:]
+	}
+
+	return strings.Trim(podName, "-")
+}
+
+// kubernetesResourceLimits returns the resource limits of the given options. Invalid
+// memory and disk space quantities are ignored.
+func kubernetesResourceLimits(options ResourceOptions) corev1.ResourceList {
+	limits := corev1.ResourceList{}
+	if options.NumCPUs > 0 {
+		limits[corev1.ResourceCPU] = *resource.NewQuantity(int64(options.NumCPUs), resource.DecimalSI)
+	}
+	if quantity, err := resource.ParseQuantity(options.Memory); err == nil {
+		limits[corev1.ResourceMemory] = quantity
+	}
+	if quantity, err := resource.ParseQuantity(options.DiskSpace); err == nil {
+		limits[corev1.ResourceEphemeralStorage] = quantity
+	}
+
+	return limits
+}
+
+// runKubernetesPod creates the given pod and waits for it to finish. The logs of the pod are
+// written to the given logger. The pod is deleted once it has finished.
+func runKubernetesPod(ctx context.Context, pod *corev1.Pod, options KubernetesOptions, spec CommandSpec, logger *Logger) (err error) {
+	ctx, endObservation := spec.Operation.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	log15.Info(fmt.Sprintf("Running pod: %s", pod.Name), "image", pod.Spec.Containers[0].Image)
+
+	pods := options.Clientset.CoreV1().Pods(pod.Namespace)
+	if _, err := pods.Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+		return errors.Wrap(err, "failed to create pod")
+	}
+	defer func() {
+		// Perform this outside of the command context. If there is a timeout or cancellation
+		// error we don't want to leave the pod running in the cluster.
+		if deleteErr := pods.Delete(context.Background(), pod.Name, metav1.DeleteOptions{}); deleteErr != nil && !kerrors.IsNotFound(deleteErr) {
+			err = multierror.Append(err, errors.Wrap(deleteErr, "failed to delete pod"))
+		}
+	}()
+
+	handle := logger.Log(spec.Key, append([]string{"image=" + spec.Image}, pod.Spec.Containers[0].Command...))
+	defer handle.Close()
+
+	// Logs can only be streamed once the container has started.
+	if _, err := waitForKubernetesPod(ctx, pods, pod.Name, func(pod *corev1.Pod) bool {
+		return pod.Status.Phase != corev1.PodPending
+	}); err != nil {
+		return err
+	}
+
+	if err := streamKubernetesPodLogs(ctx, pods, pod.Name, handle); err != nil {
+		return err
+	}
+
+	finishedPod, err := waitForKubernetesPod(ctx, pods, pod.Name, func(pod *corev1.Pod) bool {
+		return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+	})
+	if err != nil {
+		return err
+	}
+
+	exitCode := kubernetesPodExitCode(finishedPod)
+	handle.Finalize(exitCode)
+	if exitCode != 0 {
+		return errors.New("command failed")
+	}
+
+	return nil
+}
+
+// kubernetesWaitingErrorReasons are the reasons of waiting containers that will not start
+// without intervention.
+var kubernetesWaitingErrorReasons = map[string]struct{}{
+	"ErrImagePull":               {},
+	"ImagePullBackOff":           {},
+	"InvalidImageName":           {},
+	"CreateContainerConfigError": {},
+	"CreateContainerError":       {},
+}
+
+// waitForKubernetesPod polls the pod with the given name until the given condition holds.
+// An error is returned if the container of the pod fails to start.
+func waitForKubernetesPod(ctx context.Context, pods typedcorev1.PodInterface, name string, condition func(pod *corev1.Pod) bool) (*corev1.Pod, error) {
+	for {
+		pod, err := pods.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get pod")
+		}
+		if condition(pod) {
+			return pod, nil
+		}
+
+		for _, status := range pod.Status.ContainerStatuses {
+			if waiting := status.State.Waiting; waiting != nil {
+				if _, ok := kubernetesWaitingErrorReasons[waiting.Reason]; ok {
+					return nil, errors.Errorf("failed to start container: %s: %s", waiting.Reason, waiting.Message)
+				}
+			}
+		}
+
+		select {
+		case <-time.After(kubernetesPollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// streamKubernetesPodLogs writes the logs of the pod with the given name to the given
+// writer until the container exits. Kubernetes interleaves the standard output and error
+// streams of the container, so all lines are attributed to the standard output.
+func streamKubernetesPodLogs(ctx context.Context, pods typedcorev1.PodInterface, name string, w io.Writer) error {
+	stream, err := pods.GetLogs(name, &corev1.PodLogOptions{
+		Container: kubernetesContainerName,
+		Follow:    true,
+	}).Stream(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to stream pod logs")
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	// Allocate an initial buffer of 4k and buffer tokens of up to 100M, like for commands
+	// run on the host.
+	scanner.Buffer(make([]byte, 4*1024), 100*1024*1024)
+	for scanner.Scan() {
+		fmt.Fprintf(w, "stdout: %s\n", scanner.Text())
+	}
+
+	return errors.Wrap(scanner.Err(), "reading pod logs")
+}
+
+// kubernetesPodExitCode returns the exit code of the container of the given finished pod.
+func kubernetesPodExitCode(pod *corev1.Pod) int {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == kubernetesContainerName && status.State.Terminated != nil {
+			return int(status.State.Terminated.ExitCode)
+		}
+	}
+
+	// The pod failed without a terminated container, e.g. because it was evicted.
+	if pod.Status.Phase == corev1.PodFailed {
+		return 1
+	}
+	return 0
+}
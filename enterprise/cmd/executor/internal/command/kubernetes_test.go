@@ -0,0 +1,283 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+)
+
+func TestFormatKubernetesPod(t *testing.T) {
+	pod := formatKubernetesPod(
+		CommandSpec{
+			Key:        "step.docker.0",
+			Image:      "alpine:latest",
+			ScriptPath: "myscript.sh",
+			Dir:        "subdir",
+			Env:        []string{"TEST=true"},
+			Operation:  makeTestOperation(),
+		},
+		"sourcegraph-executor-deadbeef",
+		"workspace-1234",
+		Options{
+			KubernetesOptions: KubernetesOptions{
+				Namespace:            "executors",
+				WorkspaceVolumeClaim: "executor-workspaces",
+			},
+			ResourceOptions: ResourceOptions{
+				NumCPUs:   4,
+				Memory:    "20G",
+				DiskSpace: "10G",
+			},
+		},
+	)
+
+	if pod.Name != "sourcegraph-executor-deadbeef-step-docker-0" {
+		t.Errorf("unexpected pod name. want=%q have=%q", "sourcegraph-executor-deadbeef-step-docker-0", pod.Name)
+	}
+	if pod.Namespace != "executors" {
+		t.Errorf("unexpected namespace. want=%q have=%q", "executors", pod.Namespace)
+	}
+	expectedLabels := map[string]string{
+		KubernetesManagedByLabel:    KubernetesManagedByValue,
+		KubernetesExecutorNameLabel: "sourcegraph-executor-deadbeef",
+	}
+	if diff := cmp.Diff(expectedLabels, pod.Labels); diff != "" {
+		t.Errorf("unexpected labels (-want +got):\n%s", diff)
+	}
+	if pod.Spec.RestartPolicy != corev1.RestartPolicyNever {
+		t.Errorf("unexpected restart policy. want=%q have=%q", corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+	}
+	if automount := pod.Spec.AutomountServiceAccountToken; automount == nil || *automount {
+		t.Errorf("expected the service account token to not be mounted")
+	}
+
+	container := pod.Spec.Containers[0]
+	if container.Image != "alpine:latest" {
+		t.Errorf("unexpected image. want=%q have=%q", "alpine:latest", container.Image)
+	}
+	if diff := cmp.Diff([]string{"/bin/sh", "/data/.sourcegraph-executor/myscript.sh"}, container.Command); diff != "" {
+		t.Errorf("unexpected command (-want +got):\n%s", diff)
+	}
+	if container.WorkingDir != "/data/subdir" {
+		t.Errorf("unexpected working directory. want=%q have=%q", "/data/subdir", container.WorkingDir)
+	}
+	if diff := cmp.Diff([]corev1.EnvVar{{Name: "TEST", Value: "true"}}, container.Env); diff != "" {
+		t.Errorf("unexpected env (-want +got):\n%s", diff)
+	}
+	expectedMounts := []corev1.VolumeMount{{Name: "workspace", MountPath: "/data", SubPath: "workspace-1234"}}
+	if diff := cmp.Diff(expectedMounts, container.VolumeMounts); diff != "" {
+		t.Errorf("unexpected volume mounts (-want +got):\n%s", diff)
+	}
+
+	for name, expected := range map[corev1.ResourceName]string{
+		corev1.ResourceCPU:              "4",
+		corev1.ResourceMemory:           "20G",
+		corev1.ResourceEphemeralStorage: "10G",
+	} {
+		quantity := container.Resources.Limits[name]
+		if quantity.Cmp(resource.MustParse(expected)) != 0 {
+			t.Errorf("unexpected %s limit. want=%q have=%q", name, expected, quantity.String())
+		}
+	}
+
+	if claim := pod.Spec.Volumes[0].PersistentVolumeClaim; claim == nil || claim.ClaimName != "executor-workspaces" {
+		t.Errorf("unexpected volume. want claim %q have=%v", "executor-workspaces", pod.Spec.Volumes[0].VolumeSource)
+	}
+}
+
+func TestKubernetesPodName(t *testing.T) {
+	name := kubernetesPodName("sourcegraph-executor-7a52a9b2-5d6b-4ae9-8f4c-3a4c0d6b9a1e", "step.src.batch-exec.0")
+	if len(name) > 63 {
+		t.Errorf("pod name too long. want<=%d have=%d", 63, len(name))
+	}
+	if !strings.HasSuffix(name, "-step-src-batch-exec-0") {
+		t.Errorf("unexpected pod name suffix. have=%q", name)
+	}
+	if strings.HasPrefix(name, "-") {
+		t.Errorf("unexpected leading dash. have=%q", name)
+	}
+}
+
+func TestKubernetesWorkspaceSubPath(t *testing.T) {
+	options := KubernetesOptions{WorkspaceMountPath: "/workspaces"}
+
+	subPath, err := kubernetesWorkspaceSubPath("/workspaces/workspace-1234", options)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if subPath != "workspace-1234" {
+		t.Errorf("unexpected sub path. want=%q have=%q", "workspace-1234", subPath)
+	}
+
+	for _, dir := range []string{"/workspaces", "/tmp/workspace-1234", "/workspaces/../workspace-1234"} {
+		if _, err := kubernetesWorkspaceSubPath(dir, options); err == nil {
+			t.Errorf("expected error for workspace %q", dir)
+		}
+	}
+}
+
+func TestKubernetesRunner(t *testing.T) {
+	for _, testCase := range []struct {
+		name          string
+		exitCode      int32
+		expectedError string
+	}{
+		{name: "success", exitCode: 0},
+		{name: "failure", exitCode: 1, expectedError: "command failed"},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			clientset.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() != "" {
+					return false, nil, nil
+				}
+
+				return true, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: action.(k8stesting.GetAction).GetName()},
+					Status: corev1.PodStatus{
+						Phase: corev1.PodSucceeded,
+						ContainerStatuses: []corev1.ContainerStatus{
+							{
+								Name:  "step",
+								State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: testCase.exitCode}},
+							},
+						},
+					},
+				}, nil
+			})
+
+			store := NewMockExecutionLogEntryStore()
+			logger := NewLogger(store, executor.Job{}, 1, map[string]string{})
+
+			runner := NewRunner("/workspaces/workspace-1234", logger, Options{
+				ExecutorName: "sourcegraph-executor-deadbeef",
+				KubernetesOptions: KubernetesOptions{
+					Enabled:              true,
+					Clientset:            clientset,
+					Namespace:            "executors",
+					WorkspaceVolumeClaim: "executor-workspaces",
+					WorkspaceMountPath:   "/workspaces",
+				},
+			}, nil)
+			if err := runner.Setup(context.Background()); err != nil {
+				t.Fatalf("unexpected error setting up runner: %s", err)
+			}
+
+			err := runner.Run(context.Background(), CommandSpec{
+				Key:        "step.docker.0",
+				Image:      "alpine:latest",
+				ScriptPath: "myscript.sh",
+				Operation:  makeTestOperation(),
+			})
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error running command: %s", err)
+				}
+			} else if err == nil || err.Error() != testCase.expectedError {
+				t.Fatalf("unexpected error. want=%q have=%v", testCase.expectedError, err)
+			}
+
+			if err := logger.Flush(); err != nil {
+				t.Fatalf("unexpected error flushing logger: %s", err)
+			}
+
+			var created, deleted bool
+			for _, action := range clientset.Actions() {
+				switch {
+				case action.Matches("create", "pods"):
+					created = true
+					if namespace := action.GetNamespace(); namespace != "executors" {
+						t.Errorf("unexpected namespace. want=%q have=%q", "executors", namespace)
+					}
+				case action.Matches("delete", "pods"):
+					deleted = true
+					if name := action.(k8stesting.DeleteAction).GetName(); name != "sourcegraph-executor-deadbeef-step-docker-0" {
+						t.Errorf("unexpected deleted pod. want=%q have=%q", "sourcegraph-executor-deadbeef-step-docker-0", name)
+					}
+				}
+			}
+			if !created {
+				t.Errorf("expected pod to be created")
+			}
+			if !deleted {
+				t.Errorf("expected pod to be deleted")
+			}
+
+			// The entry may be complete by the time it is first written to the store.
+			entry := store.AddExecutionLogEntryFunc.History()[0].Arg2
+			if history := store.UpdateExecutionLogEntryFunc.History(); len(history) > 0 {
+				entry = history[len(history)-1].Arg3
+			}
+			assertLogEntry(t, entry, "stdout: fake logs\n", int(testCase.exitCode))
+		})
+	}
+}
+
+func TestKubernetesRunnerImagePullError(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.Pod{
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:  "step",
+						State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "not found"}},
+					},
+				},
+			},
+		}, nil
+	})
+
+	logger := NewLogger(NewMockExecutionLogEntryStore(), executor.Job{}, 1, map[string]string{})
+	defer logger.Flush()
+
+	runner := NewRunner("/workspaces/workspace-1234", logger, Options{
+		ExecutorName: "sourcegraph-executor-deadbeef",
+		KubernetesOptions: KubernetesOptions{
+			Enabled:            true,
+			Clientset:          clientset,
+			WorkspaceMountPath: "/workspaces",
+		},
+	}, nil)
+	if err := runner.Setup(context.Background()); err != nil {
+		t.Fatalf("unexpected error setting up runner: %s", err)
+	}
+
+	err := runner.Run(context.Background(), CommandSpec{
+		Key:       "step.docker.0",
+		Image:     "alpine:doesnotexist",
+		Operation: makeTestOperation(),
+	})
+	if err == nil || !strings.Contains(err.Error(), "ImagePullBackOff") {
+		t.Fatalf("unexpected error. want ImagePullBackOff have=%v", err)
+	}
+
+	if pods, err := clientset.Tracker().List(corev1.SchemeGroupVersion.WithResource("pods"), corev1.SchemeGroupVersion.WithKind("Pod"), ""); err != nil {
+		t.Fatalf("unexpected error listing pods: %s", err)
+	} else if items := pods.(*corev1.PodList).Items; len(items) != 0 {
+		t.Errorf("expected pod to be deleted. have=%d pods", len(items))
+	}
+}
+
+func assertLogEntry(t *testing.T, entry workerutil.ExecutionLogEntry, expectedOut string, expectedExitCode int) {
+	t.Helper()
+
+	if entry.Out != expectedOut {
+		t.Errorf("unexpected output. want=%q have=%q", expectedOut, entry.Out)
+	}
+	if entry.ExitCode == nil || *entry.ExitCode != expectedExitCode {
+		t.Errorf("unexpected exit code. want=%d have=%v", expectedExitCode, entry.ExitCode)
+	}
+}
@@ -14,9 +14,13 @@ type Operations struct {
 	SetupGitFetch             *observation.Operation
 	SetupAddRemote            *observation.Operation
 	SetupGitCheckout          *observation.Operation
+	SetupGitCacheInit         *observation.Operation
+	SetupGitCacheFetch        *observation.Operation
+	SetupGitCachePrune        *observation.Operation
 	SetupFirecrackerStart     *observation.Operation
 	SetupStartupScript        *observation.Operation
 	TeardownFirecrackerRemove *observation.Operation
+	TeardownCacheCopy         *observation.Operation
 	Exec                      *observation.Operation
 
 	RunLockWaitTotal prometheus.Counter
@@ -56,9 +60,13 @@ func NewOperations(observationContext *observation.Context) *Operations {
 		SetupGitFetch:             op("setup.git.fetch"),
 		SetupAddRemote:            op("setup.git.add-remote"),
 		SetupGitCheckout:          op("setup.git.checkout"),
+		SetupGitCacheInit:         op("setup.git.cache-init"),
+		SetupGitCacheFetch:        op("setup.git.cache-fetch"),
+		SetupGitCachePrune:        op("setup.git.cache-prune"),
 		SetupFirecrackerStart:     op("setup.firecracker.start"),
 		SetupStartupScript:        op("setup.startup-script"),
 		TeardownFirecrackerRemove: op("teardown.firecracker.remove"),
+		TeardownCacheCopy:         op("teardown.cache.copy"),
 		Exec:                      op("exec"),
 
 		RunLockWaitTotal: runLockWaitTotal,
@@ -3,13 +3,15 @@ package command
 import (
 	"context"
 
+	"k8s.io/client-go/kubernetes"
+
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
 // Runner is the interface between an executor and the host on which commands
 // are invoked. Having this interface at this level allows us to use the same
 // code paths for local development (via shell + docker) as well as production
-// usage (via Firecracker).
+// usage (via Firecracker or Kubernetes).
 type Runner interface {
 	// Setup prepares the runner to invoke a series of commands.
 	Setup(ctx context.Context) error
@@ -41,8 +43,11 @@ type Options struct {
 	// FirecrackerOptions configures the behavior of Firecracker virtual machine creation.
 	FirecrackerOptions FirecrackerOptions
 
-	// ResourceOptions configures the resource limits of docker container and Firecracker
-	// virtual machines running on the executor.
+	// KubernetesOptions configures the behavior of Kubernetes pod creation.
+	KubernetesOptions KubernetesOptions
+
+	// ResourceOptions configures the resource limits of docker container, Firecracker
+	// virtual machines, and Kubernetes pods running on the executor.
 	ResourceOptions ResourceOptions
 }
 
@@ -58,19 +63,50 @@ type FirecrackerOptions struct {
 	VMStartupScriptPath string
 }
 
+type KubernetesOptions struct {
+	// Enabled determines if commands will be run in Kubernetes pods.
+	Enabled bool
+
+	// Clientset is the client used to create pods in the cluster.
+	Clientset kubernetes.Interface
+
+	// Namespace is the namespace in which pods are created.
+	Namespace string
+
+	// NodeSelector constrains the nodes on which pods are scheduled.
+	NodeSelector map[string]string
+
+	// WorkspaceVolumeClaim is the name of the persistent volume claim holding the
+	// workspaces. It must be mounted into the executor, as well as into the pods.
+	WorkspaceVolumeClaim string
+
+	// WorkspaceMountPath is the path at which the workspace volume is mounted into
+	// the executor. Workspaces must be created within this directory.
+	WorkspaceMountPath string
+}
+
 type ResourceOptions struct {
-	// NumCPUs is the number of virtual CPUs a container or VM can use.
+	// NumCPUs is the number of virtual CPUs a container, VM, or pod can use.
 	NumCPUs int
 
-	// Memory is the maximum amount of memory a container or VM can use.
+	// Memory is the maximum amount of memory a container, VM, or pod can use.
 	Memory string
 
-	// DiskSpace is the maximum amount of disk a container or VM can use.
+	// DiskSpace is the maximum amount of disk a container, VM, or pod can use.
 	DiskSpace string
 }
 
 // NewRunner creates a new runner with the given options.
 func NewRunner(dir string, logger *Logger, options Options, operations *Operations) Runner {
+	if options.KubernetesOptions.Enabled {
+		return &kubernetesRunner{
+			name:    options.ExecutorName,
+			dir:     dir,
+			logger:  logger,
+			options: options,
+		}
+	}
+
 	if !options.FirecrackerOptions.Enabled {
 		return &dockerRunner{dir: dir, logger: logger, options: options}
 	}
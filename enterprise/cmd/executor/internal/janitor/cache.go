@@ -0,0 +1,47 @@
+package janitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/cache"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+type cacheJanitor struct {
+	cache   *cache.Cache
+	metrics *metrics
+}
+
+var _ goroutine.Handler = &cacheJanitor{}
+var _ goroutine.ErrorHandler = &cacheJanitor{}
+
+// NewCacheJanitor returns a background routine that periodically evicts the least
+// recently used entries of the executor cache once it exceeds its size limit.
+func NewCacheJanitor(
+	cache *cache.Cache,
+	interval time.Duration,
+	metrics *metrics,
+) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(context.Background(), interval, &cacheJanitor{
+		cache:   cache,
+		metrics: metrics,
+	})
+}
+
+func (j *cacheJanitor) Handle(ctx context.Context) error {
+	evicted, err := j.cache.Evict(ctx)
+	if evicted > 0 {
+		log15.Info("Evicted executor cache entries", "count", evicted)
+		j.metrics.numCacheEntriesEvicted.Add(float64(evicted))
+	}
+
+	return err
+}
+
+func (j *cacheJanitor) HandleError(err error) {
+	j.metrics.numErrors.Inc()
+	log15.Error("Failed to evict executor cache entries", "error", err)
+}
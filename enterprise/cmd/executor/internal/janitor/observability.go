@@ -7,8 +7,10 @@ import (
 )
 
 type metrics struct {
-	numVMsRemoved prometheus.Counter
-	numErrors     prometheus.Counter
+	numVMsRemoved          prometheus.Counter
+	numPodsRemoved         prometheus.Counter
+	numCacheEntriesEvicted prometheus.Counter
+	numErrors              prometheus.Counter
 }
 
 var NewMetrics = newMetrics
@@ -28,13 +30,23 @@ func newMetrics(observationContext *observation.Context) *metrics {
 		"src_executor_orphaned_vms_removed_total",
 		"The number of orphaned virtual machines removed from the host.",
 	)
+	numPodsRemoved := counter(
+		"src_executor_orphaned_pods_removed_total",
+		"The number of orphaned pods removed from the Kubernetes cluster.",
+	)
+	numCacheEntriesEvicted := counter(
+		"src_executor_cache_entries_evicted_total",
+		"The number of cached repositories and directories evicted from the host.",
+	)
 	numErrors := counter(
 		"src_executor_janitor_errors_total",
 		"The number of errors that occur during the janitor job.",
 	)
 
 	return &metrics{
-		numVMsRemoved: numVMsRemoved,
-		numErrors:     numErrors,
+		numVMsRemoved:          numVMsRemoved,
+		numPodsRemoved:         numPodsRemoved,
+		numCacheEntriesEvicted: numCacheEntriesEvicted,
+		numErrors:              numErrors,
 	}
 }
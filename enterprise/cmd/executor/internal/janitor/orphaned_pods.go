@@ -0,0 +1,121 @@
+package janitor
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/inconshreveable/log15"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/command"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+type orphanedPodJanitor struct {
+	options command.KubernetesOptions
+	prefix  string
+	names   *NameSet
+	maxAge  time.Duration
+	metrics *metrics
+}
+
+var _ goroutine.Handler = &orphanedPodJanitor{}
+var _ goroutine.ErrorHandler = &orphanedPodJanitor{}
+
+// NewOrphanedPodJanitor returns a background routine that periodically removes pods created
+// by executors with the given prefix that are not known by the worker running within this
+// executor instance. As other executor instances may share the namespace, only pods older
+// than the given maximum age are removed.
+func NewOrphanedPodJanitor(
+	options command.KubernetesOptions,
+	prefix string,
+	names *NameSet,
+	maxAge time.Duration,
+	interval time.Duration,
+	metrics *metrics,
+) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(context.Background(), interval, newOrphanedPodJanitor(
+		options,
+		prefix,
+		names,
+		maxAge,
+		metrics,
+	))
+}
+
+func newOrphanedPodJanitor(
+	options command.KubernetesOptions,
+	prefix string,
+	names *NameSet,
+	maxAge time.Duration,
+	metrics *metrics,
+) *orphanedPodJanitor {
+	return &orphanedPodJanitor{
+		options: options,
+		prefix:  prefix,
+		names:   names,
+		maxAge:  maxAge,
+		metrics: metrics,
+	}
+}
+
+func (j *orphanedPodJanitor) Handle(ctx context.Context) (err error) {
+	pods := j.options.Clientset.CoreV1().Pods(j.options.Namespace)
+
+	podList, err := pods.List(ctx, metav1.ListOptions{
+		LabelSelector: command.KubernetesManagedByLabel + "=" + command.KubernetesManagedByValue,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, name := range findOrphanedPods(podList.Items, j.prefix, j.names.Slice(), time.Now().Add(-j.maxAge)) {
+		log15.Info("Removing orphaned pod", "name", name)
+
+		if removeErr := pods.Delete(ctx, name, metav1.DeleteOptions{}); removeErr != nil && !kerrors.IsNotFound(removeErr) {
+			err = multierror.Append(err, removeErr)
+		} else {
+			j.metrics.numPodsRemoved.Inc()
+		}
+	}
+
+	return err
+}
+
+func (j *orphanedPodJanitor) HandleError(err error) {
+	j.metrics.numErrors.Inc()
+	log15.Error("Failed to remove orphaned pods", "error", err)
+}
+
+// findOrphanedPods returns the names of the given pods that were created by executors with
+// the given prefix before the given time, excluding the pods of the expected executor names.
+func findOrphanedPods(pods []corev1.Pod, prefix string, expectedNames []string, createdBefore time.Time) []string {
+	expectedMap := make(map[string]struct{}, len(expectedNames))
+	for _, name := range expectedNames {
+		expectedMap[name] = struct{}{}
+	}
+
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		executorName := pod.Labels[command.KubernetesExecutorNameLabel]
+		if !strings.HasPrefix(executorName, prefix+"-") {
+			continue
+		}
+		if _, ok := expectedMap[executorName]; ok {
+			continue
+		}
+		if !pod.CreationTimestamp.Time.Before(createdBefore) {
+			continue
+		}
+
+		names = append(names, pod.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
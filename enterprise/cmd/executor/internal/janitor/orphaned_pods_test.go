@@ -0,0 +1,72 @@
+package janitor
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/command"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestOrphanedPodJanitor(t *testing.T) {
+	now := time.Now()
+	pod := func(name, executorName string, createdAt time.Time) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "executors",
+				CreationTimestamp: metav1.NewTime(createdAt),
+				Labels: map[string]string{
+					command.KubernetesManagedByLabel:    command.KubernetesManagedByValue,
+					command.KubernetesExecutorNameLabel: executorName,
+				},
+			},
+		}
+	}
+
+	unmanaged := pod("unmanaged", "executor-a", now.Add(-time.Hour))
+	unmanaged.Labels = nil
+
+	clientset := fake.NewSimpleClientset(
+		pod("orphan-a", "executor-a", now.Add(-time.Hour)),
+		pod("orphan-b", "executor-b", now.Add(-time.Hour)),
+		pod("active", "executor-c", now.Add(-time.Hour)),
+		pod("recent", "executor-d", now),
+		pod("other-prefix", "other-a", now.Add(-time.Hour)),
+		unmanaged,
+	)
+
+	names := NewNameSet()
+	names.Add("executor-c")
+
+	janitor := newOrphanedPodJanitor(
+		command.KubernetesOptions{Clientset: clientset, Namespace: "executors"},
+		"executor",
+		names,
+		30*time.Minute,
+		newMetrics(&observation.TestContext),
+	)
+	if err := janitor.Handle(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	podList, err := clientset.CoreV1().Pods("executors").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error listing pods: %s", err)
+	}
+	var remaining []string
+	for _, pod := range podList.Items {
+		remaining = append(remaining, pod.Name)
+	}
+	sort.Strings(remaining)
+	if diff := cmp.Diff([]string{"active", "other-prefix", "recent", "unmanaged"}, remaining); diff != "" {
+		t.Fatalf("unexpected remaining pods (-want +got):\n%s", diff)
+	}
+}
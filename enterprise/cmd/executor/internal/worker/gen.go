@@ -2,3 +2,4 @@ package worker
 
 //go:generate ../../../../../dev/mockgen.sh github.com/sourcegraph/sourcegraph/internal/workerutil -i Store -o mock_store_test.go
 //go:generate ../../../../../dev/mockgen.sh github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/command -i Runner -o mock_command_runner_test.go
+//go:generate ../../../../../dev/mockgen.sh github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker -i ArtifactStore -o mock_artifact_store_test.go
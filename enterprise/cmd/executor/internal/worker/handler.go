@@ -2,10 +2,14 @@ package worker
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -13,6 +17,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/inconshreveable/log15"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/cache"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/command"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/ignite"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/janitor"
@@ -23,7 +28,9 @@ import (
 
 type handler struct {
 	nameSet       *janitor.NameSet
+	cache         *cache.Cache
 	store         workerutil.Store
+	artifactStore ArtifactStore
 	options       Options
 	operations    *command.Operations
 	runnerFactory func(dir string, logger *command.Logger, options command.Options, operations *command.Operations) command.Runner
@@ -133,6 +140,7 @@ func (h *handler) Handle(ctx context.Context, record workerutil.Record) (err err
 	options := command.Options{
 		ExecutorName:       name,
 		FirecrackerOptions: h.options.FirecrackerOptions,
+		KubernetesOptions:  h.options.KubernetesOptions,
 		ResourceOptions:    h.options.ResourceOptions,
 	}
 	runner := h.runnerFactory(workingDirectory, logger, options, h.operations)
@@ -167,6 +175,19 @@ func (h *handler) Handle(ctx context.Context, record workerutil.Record) (err err
 		return wrapError(err, "failed to write virtual machine files")
 	}
 
+	// Compute the cache keys before any step runs, as steps may modify the files that
+	// the keys are derived from.
+	cacheKeys, err := h.cacheKeys(job, workingDirectory)
+	if err != nil {
+		return wrapError(err, "failed to compute cache keys")
+	}
+
+	// Restore the cached directories before the VM is set up, as the workspace is copied
+	// into the VM on startup.
+	if err := h.restoreCacheDirectories(ctx, job, cacheKeys, workingDirectory, logger); err != nil {
+		return wrapError(err, "failed to restore cache directories")
+	}
+
 	log15.Info("Setting up VM", "jobID", job.ID, "repositoryName", job.RepositoryName, "commit", job.Commit)
 
 	// Setup Firecracker VM (if enabled)
@@ -181,6 +202,19 @@ func (h *handler) Handle(ctx context.Context, record workerutil.Record) (err err
 			err = multierror.Append(err, teardownErr)
 		}
 	}()
+	defer func() {
+		// Upload the artifacts before the VM is torn down. This also happens when a step
+		// failed, so that outputs such as test reports are available for failed jobs. As
+		// a failed step may not have produced all artifacts, upload errors only fail jobs
+		// that have otherwise succeeded.
+		if uploadErr := h.uploadArtifacts(ctx, job, hostRunner, name, workingDirectory, logger); uploadErr != nil {
+			if err == nil {
+				err = wrapError(uploadErr, "failed to upload artifacts")
+			} else {
+				log15.Warn("Failed to upload artifacts", "jobID", job.ID, "error", uploadErr)
+			}
+		}
+	}()
 
 	// Invoke each docker step sequentially
 	for i, dockerStep := range job.DockerSteps {
@@ -217,9 +251,302 @@ func (h *handler) Handle(ctx context.Context, record workerutil.Record) (err err
 		}
 	}
 
+	// Save the cache directories before the VM is torn down. Failing to do so doesn't
+	// fail the job, as subsequent jobs only run slower without the cache.
+	if err := h.saveCacheDirectories(ctx, job, cacheKeys, hostRunner, name, workingDirectory, logger); err != nil {
+		log15.Warn("Failed to save cache directories", "jobID", job.ID, "error", err)
+	}
+
+	return nil
+}
+
+// restoreCacheDirectories copies the cached contents of the cache directories of the
+// given job, stored under the given keys, into the workspace. Cache directories without
+// cached contents are skipped.
+func (h *handler) restoreCacheDirectories(ctx context.Context, job executor.Job, cacheKeys []string, workingDirectory string, logger *command.Logger) (err error) {
+	if h.cache == nil || len(job.CacheDirectories) == 0 {
+		return nil
+	}
+
+	handle := logger.Log("setup.cache.restore", nil)
+	defer func() {
+		if err == nil {
+			handle.Finalize(0)
+		} else {
+			handle.Finalize(1)
+		}
+
+		handle.Close()
+	}()
+
+	for i, cacheDirectory := range job.CacheDirectories {
+		path, err := cacheDirectoryPath(workingDirectory, cacheDirectory)
+		if err != nil {
+			return err
+		}
+
+		hit, err := h.cache.Restore(ctx, cacheKeys[i], path)
+		if err != nil {
+			return err
+		}
+		if hit {
+			fmt.Fprintf(handle, "Restored %s from cache key %s\n", cacheDirectory.Path, cacheDirectory.Key)
+		} else {
+			fmt.Fprintf(handle, "No cached contents of %s for cache key %s\n", cacheDirectory.Path, cacheDirectory.Key)
+		}
+	}
+
+	return nil
+}
+
+// saveCacheDirectories saves the contents of the cache directories of the given job to
+// the cache under the given keys. When commands run in a Firecracker VM with the given
+// name, the directories are first copied out of the VM with the given host runner.
+func (h *handler) saveCacheDirectories(ctx context.Context, job executor.Job, cacheKeys []string, hostRunner command.Runner, vmName, workingDirectory string, logger *command.Logger) (err error) {
+	if h.cache == nil || len(job.CacheDirectories) == 0 {
+		return nil
+	}
+
+	for i, cacheDirectory := range job.CacheDirectories {
+		path, err := cacheDirectoryPath(workingDirectory, cacheDirectory)
+		if err != nil {
+			return err
+		}
+
+		if h.options.FirecrackerOptions.Enabled {
+			tmp, err := makeTempDir()
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tmp)
+
+			path = filepath.Join(tmp, "contents")
+			copyCommand := command.FirecrackerCopyOutCommand(fmt.Sprintf("teardown.cache.copy.%d", i), vmName, cacheDirectory.Path, path, h.operations)
+			if err := hostRunner.Run(ctx, copyCommand); err != nil {
+				return err
+			}
+		}
+
+		if err := h.cache.Save(ctx, cacheKeys[i], path); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// cacheKeys returns the keys under which the cache directories of the given job are
+// cached, in the same order as the cache directories.
+func (h *handler) cacheKeys(job executor.Job, workingDirectory string) ([]string, error) {
+	if h.cache == nil {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(job.CacheDirectories))
+	for _, cacheDirectory := range job.CacheDirectories {
+		key, err := h.cacheKey(job, cacheDirectory, workingDirectory)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// cacheKey returns the key under which the given cache directory of the job is cached.
+// Keys are namespaced by the queue and the repository of the job, so that a job cannot
+// restore the contents saved by jobs of other queues or repositories. If the cache
+// directory has key files, the key also includes a hash of their contents in the
+// given working directory.
+func (h *handler) cacheKey(job executor.Job, cacheDirectory executor.CacheDirectory, workingDirectory string) (string, error) {
+	key := fmt.Sprintf("%q %q %q", h.options.QueueName, job.RepositoryName, cacheDirectory.Key)
+	if len(cacheDirectory.KeyFiles) == 0 {
+		return key, nil
+	}
+
+	hash := sha256.New()
+	for _, keyFile := range cacheDirectory.KeyFiles {
+		path, err := workspacePath(workingDirectory, keyFile)
+		if err != nil {
+			return "", errors.Wrapf(err, "refusing to read cache key file %q", keyFile)
+		}
+		if err := hashFile(hash, keyFile, path); err != nil {
+			return "", err
+		}
+	}
+
+	return key + " " + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// hashFile writes the name, the size and the contents of the file at the given path to
+// the given hash. Missing files are written with a size of -1, so that adding a file
+// changes the hash as well.
+func hashFile(hash io.Writer, name, path string) error {
+	f, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NOFOLLOW, 0)
+	if err != nil {
+		if os.IsNotExist(err) {
+			_, err = fmt.Fprintf(hash, "%q -1\n", name)
+		}
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return errors.Errorf("cache key file %q is not a regular file", name)
+	}
+	if _, err := fmt.Fprintf(hash, "%q %d\n", name, info.Size()); err != nil {
+		return err
+	}
+	_, err = io.CopyN(hash, f, info.Size())
+	return err
+}
+
+// cacheDirectoryPath returns the absolute path of the given cache directory, which must
+// be within the working directory.
+func cacheDirectoryPath(workingDirectory string, cacheDirectory executor.CacheDirectory) (string, error) {
+	path, err := workspacePath(workingDirectory, cacheDirectory.Path)
+	if err != nil {
+		return "", errors.Wrapf(err, "refusing to cache directory %q", cacheDirectory.Path)
+	}
+
+	return path, nil
+}
+
+// workspacePath returns the absolute path of the given path relative to the working
+// directory. The path is accessed on the executor host, so it must be within the
+// working directory, and neither it nor any of its parents within the working directory
+// may be a symlink, as symlinks committed to the repository could point anywhere on the
+// host. Paths that don't exist yet are accepted.
+func workspacePath(workingDirectory, relativePath string) (string, error) {
+	root, err := filepath.EvalSymlinks(workingDirectory)
+	if err != nil {
+		return "", err
+	}
+	path, err := filepath.Abs(filepath.Join(root, relativePath))
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(path, root+string(os.PathSeparator)) {
+		return "", errors.New("path is outside of working directory")
+	}
+
+	current := root
+	for _, part := range strings.Split(strings.TrimPrefix(path, root+string(os.PathSeparator)), string(os.PathSeparator)) {
+		current = filepath.Join(current, part)
+
+		info, err := os.Lstat(current)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return path, nil
+			}
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return "", errors.Errorf("%q is a symlink", strings.TrimPrefix(current, root+string(os.PathSeparator)))
+		}
+	}
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(resolved, root+string(os.PathSeparator)) {
+		return "", errors.New("path resolves to outside of working directory")
+	}
+
+	return path, nil
+}
+
+// uploadArtifacts uploads the artifacts of the given job to the job queue API. When commands
+// run in a Firecracker VM with the given name, the files are first copied out of the VM with
+// the given host runner.
+func (h *handler) uploadArtifacts(ctx context.Context, job executor.Job, hostRunner command.Runner, vmName, workingDirectory string, logger *command.Logger) (err error) {
+	if len(job.Artifacts) == 0 {
+		return nil
+	}
+
+	handle := logger.Log("teardown.artifacts.upload", nil)
+	defer func() {
+		if err == nil {
+			handle.Finalize(0)
+		} else {
+			handle.Finalize(1)
+		}
+
+		handle.Close()
+	}()
+
+	var errs error
+	for i, artifact := range job.Artifacts {
+		if err := h.uploadArtifact(ctx, i, job.ID, artifact, hostRunner, vmName, workingDirectory); err != nil {
+			fmt.Fprintf(handle, "Failed to upload %s as artifact %s: %s\n", artifact.Path, artifact.Name, err)
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		fmt.Fprintf(handle, "Uploaded %s as artifact %s\n", artifact.Path, artifact.Name)
+	}
+
+	return errs
+}
+
+// uploadArtifact uploads the file of the given artifact as the artifact of the job with the given
+// identifier. The index of the artifact is used to distinguish the commands copying files out of
+// the VM in the job's log.
+func (h *handler) uploadArtifact(ctx context.Context, index, jobID int, artifact executor.Artifact, hostRunner command.Runner, vmName, workingDirectory string) error {
+	path, err := artifactPath(workingDirectory, artifact)
+	if err != nil {
+		return err
+	}
+
+	if h.options.FirecrackerOptions.Enabled {
+		tmp, err := makeTempDir()
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmp)
+
+		path = filepath.Join(tmp, "contents")
+		copyCommand := command.FirecrackerCopyOutCommand(fmt.Sprintf("teardown.artifacts.copy.%d", index), vmName, artifact.Path, path, h.operations)
+		if err := hostRunner.Run(ctx, copyCommand); err != nil {
+			return err
+		}
+	}
+
+	// The file is opened without following symlinks, so that it cannot be swapped for a
+	// symlink to a file on the host once the path has been checked.
+	f, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NOFOLLOW, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return errors.Errorf("artifact %q is not a regular file", artifact.Path)
+	}
+
+	return h.artifactStore.UploadArtifact(ctx, jobID, artifact.Name, f)
+}
+
+// artifactPath returns the absolute path of the given artifact, which must be within the
+// working directory.
+func artifactPath(workingDirectory string, artifact executor.Artifact) (string, error) {
+	path, err := workspacePath(workingDirectory, artifact.Path)
+	if err != nil {
+		return "", errors.Wrapf(err, "refusing to upload artifact %q", artifact.Path)
+	}
+
+	return path, nil
+}
+
 var scriptPreamble = `
 set -x
 `
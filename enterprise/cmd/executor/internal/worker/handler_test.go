@@ -2,12 +2,17 @@ package worker
 
 import (
 	"context"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/cache"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/command"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/janitor"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor"
@@ -110,3 +115,297 @@ func TestHandle(t *testing.T) {
 		t.Errorf("unexpected commands (-want +got):\n%s", diff)
 	}
 }
+
+func TestHandleCacheDirectories(t *testing.T) {
+	testDir := t.TempDir()
+	makeTempDir = func() (string, error) { return testDir, nil }
+	defer func() { makeTempDir = makeTemporaryDirectory }()
+	if err := os.MkdirAll(filepath.Join(testDir, command.ScriptsPath), os.ModePerm); err != nil {
+		t.Fatalf("unexpected error creating workspace: %s", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(testDir, "yarn.lock"), []byte("v1"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	executorCache := cache.New(t.TempDir(), 1<<30)
+	cached := t.TempDir()
+	if err := os.WriteFile(filepath.Join(cached, "cached.txt"), []byte("cached"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	runner := NewMockRunner()
+	runner.RunFunc.SetDefaultHook(func(ctx context.Context, spec command.CommandSpec) error {
+		// The cached directory is restored before any step runs.
+		contents, err := os.ReadFile(filepath.Join(testDir, "node_modules", "cached.txt"))
+		if err != nil || string(contents) != "cached" {
+			t.Errorf("expected cache directory to be restored, got %q err=%v", contents, err)
+		}
+
+		// Installing dependencies may update the lock file, which must not change the
+		// key the directory is saved under.
+		if err := os.WriteFile(filepath.Join(testDir, "yarn.lock"), []byte("v1 updated"), os.ModePerm); err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(testDir, "node_modules", "installed.txt"), []byte("installed"), os.ModePerm)
+	})
+
+	job := executor.Job{
+		ID: 42,
+		DockerSteps: []executor.DockerStep{
+			{Image: "node", Commands: []string{"yarn", "install"}},
+		},
+		CacheDirectories: []executor.CacheDirectory{
+			{Key: "yarn", Path: "node_modules", KeyFiles: []string{"yarn.lock", "package-lock.json"}},
+		},
+	}
+
+	handler := &handler{
+		store:      NewMockStore(),
+		nameSet:    janitor.NewNameSet(),
+		cache:      executorCache,
+		options:    Options{QueueName: "codeintel", MaximumRuntimePerJob: time.Minute},
+		operations: command.NewOperations(&observation.TestContext),
+		runnerFactory: func(dir string, logger *command.Logger, options command.Options, operations *command.Operations) command.Runner {
+			if dir == "" {
+				return NewMockRunner()
+			}
+
+			return runner
+		},
+	}
+
+	key, err := handler.cacheKey(job, job.CacheDirectories[0], testDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := executorCache.Save(context.Background(), key, cached); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := handler.Handle(context.Background(), job); err != nil {
+		t.Fatalf("unexpected error handling record: %s", err)
+	}
+
+	// The directory modified by the step is saved to the cache.
+	restored := t.TempDir()
+	if hit, err := executorCache.Restore(context.Background(), key, restored); err != nil || !hit {
+		t.Fatalf("expected cache hit, got hit=%v err=%v", hit, err)
+	}
+	for _, name := range []string{"cached.txt", "installed.txt"} {
+		if _, err := os.Stat(filepath.Join(restored, name)); err != nil {
+			t.Errorf("expected %s to be cached: %s", name, err)
+		}
+	}
+
+	// Keys change with the contents of the key files.
+	workspace := t.TempDir()
+	for _, files := range []map[string]string{
+		{"yarn.lock": "v1 updated"},
+		{"yarn.lock": "v1", "package-lock.json": ""},
+	} {
+		for name, contents := range files {
+			if err := os.WriteFile(filepath.Join(workspace, name), []byte(contents), os.ModePerm); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if otherKey, err := handler.cacheKey(job, job.CacheDirectories[0], workspace); err != nil || otherKey == key {
+			t.Errorf("expected cache keys of other key files %v to differ, got %q err=%v", files, otherKey, err)
+		}
+	}
+	if err := os.Remove(filepath.Join(workspace, "package-lock.json")); err != nil {
+		t.Fatal(err)
+	}
+	if sameKey, err := handler.cacheKey(job, job.CacheDirectories[0], workspace); err != nil || sameKey != key {
+		t.Errorf("expected cache keys of the same key files to match, got %q err=%v", sameKey, err)
+	}
+
+	// Keys are namespaced by queue and repository.
+	otherRepository := executor.Job{RepositoryName: "github.com/sourcegraph/other"}
+	if otherKey, err := handler.cacheKey(otherRepository, job.CacheDirectories[0], workspace); err != nil || otherKey == key {
+		t.Errorf("expected cache keys of other repositories to differ, got %q err=%v", otherKey, err)
+	}
+	handler.options.QueueName = "batches"
+	if otherKey, err := handler.cacheKey(job, job.CacheDirectories[0], workspace); err != nil || otherKey == key {
+		t.Errorf("expected cache keys of other queues to differ, got %q err=%v", otherKey, err)
+	}
+}
+
+func TestHandleSymlinkedCacheDirectory(t *testing.T) {
+	testDir := t.TempDir()
+	makeTempDir = func() (string, error) { return testDir, nil }
+	defer func() { makeTempDir = makeTemporaryDirectory }()
+	if err := os.MkdirAll(filepath.Join(testDir, command.ScriptsPath), os.ModePerm); err != nil {
+		t.Fatalf("unexpected error creating workspace: %s", err)
+	}
+
+	// The repository commits the cache directory as a symlink to a directory on the host.
+	outside := t.TempDir()
+	if err := os.Symlink(outside, filepath.Join(testDir, "node_modules")); err != nil {
+		t.Fatal(err)
+	}
+
+	executorCache := cache.New(t.TempDir(), 1<<30)
+	cached := t.TempDir()
+	if err := os.WriteFile(filepath.Join(cached, "cached.txt"), []byte("cached"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	job := executor.Job{
+		ID: 42,
+		DockerSteps: []executor.DockerStep{
+			{Image: "node", Commands: []string{"yarn", "install"}},
+		},
+		CacheDirectories: []executor.CacheDirectory{
+			{Key: "yarn-abc", Path: "node_modules"},
+		},
+	}
+
+	handler := &handler{
+		store:      NewMockStore(),
+		nameSet:    janitor.NewNameSet(),
+		cache:      executorCache,
+		options:    Options{QueueName: "codeintel", MaximumRuntimePerJob: time.Minute},
+		operations: command.NewOperations(&observation.TestContext),
+		runnerFactory: func(dir string, logger *command.Logger, options command.Options, operations *command.Operations) command.Runner {
+			return NewMockRunner()
+		},
+	}
+
+	key, err := handler.cacheKey(job, job.CacheDirectories[0], testDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := executorCache.Save(context.Background(), key, cached); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := handler.Handle(context.Background(), job); err == nil || !strings.Contains(err.Error(), "symlink") {
+		t.Fatalf("unexpected error handling record: %v", err)
+	}
+	if entries, err := os.ReadDir(outside); err != nil || len(entries) != 0 {
+		t.Errorf("expected symlink target to be untouched, got entries=%v err=%v", entries, err)
+	}
+}
+
+func TestHandleArtifacts(t *testing.T) {
+	testDir := t.TempDir()
+	makeTempDir = func() (string, error) { return testDir, nil }
+	defer func() { makeTempDir = makeTemporaryDirectory }()
+	if err := os.MkdirAll(filepath.Join(testDir, command.ScriptsPath), os.ModePerm); err != nil {
+		t.Fatalf("unexpected error creating workspace: %s", err)
+	}
+
+	runner := NewMockRunner()
+	runner.RunFunc.SetDefaultHook(func(ctx context.Context, spec command.CommandSpec) error {
+		if err := os.MkdirAll(filepath.Join(testDir, "reports"), os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(testDir, "reports", "junit.xml"), []byte("<testsuites/>"), os.ModePerm); err != nil {
+			return err
+		}
+		return errors.New("tests failed")
+	})
+
+	uploaded := map[string]string{}
+	artifactStore := NewMockArtifactStore()
+	artifactStore.UploadArtifactFunc.SetDefaultHook(func(ctx context.Context, jobID int, name string, r io.Reader) error {
+		contents, err := io.ReadAll(r)
+		uploaded[name] = string(contents)
+		return err
+	})
+
+	job := executor.Job{
+		ID: 42,
+		DockerSteps: []executor.DockerStep{
+			{Image: "golang", Commands: []string{"go", "test"}},
+		},
+		Artifacts: []executor.Artifact{
+			{Name: "coverage.out", Path: "coverage.out"},
+			{Name: "junit.xml", Path: "reports/junit.xml"},
+		},
+	}
+
+	handler := &handler{
+		store:         NewMockStore(),
+		artifactStore: artifactStore,
+		nameSet:       janitor.NewNameSet(),
+		options:       Options{MaximumRuntimePerJob: time.Minute},
+		operations:    command.NewOperations(&observation.TestContext),
+		runnerFactory: func(dir string, logger *command.Logger, options command.Options, operations *command.Operations) command.Runner {
+			if dir == "" {
+				return NewMockRunner()
+			}
+
+			return runner
+		},
+	}
+
+	// The step error is reported rather than the missing coverage artifact, but the test
+	// report produced before the failure is still uploaded.
+	if err := handler.Handle(context.Background(), job); err == nil || !strings.Contains(err.Error(), "tests failed") {
+		t.Fatalf("unexpected error handling record: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"junit.xml": "<testsuites/>"}, uploaded); diff != "" {
+		t.Errorf("unexpected uploaded artifacts (-want +got):\n%s", diff)
+	}
+}
+
+func TestArtifactPath(t *testing.T) {
+	workspace := t.TempDir()
+	outside := t.TempDir()
+	if err := os.WriteFile(filepath.Join(outside, "secret"), []byte("secret"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	// Symlinks committed to the repository must not be followed, whether they are the
+	// artifact itself or one of its parents.
+	if err := os.Symlink(filepath.Join(outside, "secret"), filepath.Join(workspace, "coverage.out")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(workspace, "linked")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, invalid := range []string{"", ".", "../outside", "reports/../../outside", "coverage.out", "linked/secret"} {
+		if _, err := artifactPath(workspace, executor.Artifact{Name: "name", Path: invalid}); err == nil {
+			t.Errorf("expected error for path %q", invalid)
+		}
+	}
+
+	path, err := artifactPath(workspace, executor.Artifact{Name: "name", Path: "reports/junit.xml"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := filepath.Join(workspace, "reports/junit.xml"); path != want {
+		t.Errorf("unexpected path. want=%q have=%q", want, path)
+	}
+}
+
+func TestCacheDirectoryPath(t *testing.T) {
+	workspace := t.TempDir()
+	outside := t.TempDir()
+
+	// Symlinks committed to the repository must not be followed, whether they are the
+	// cache directory itself or one of its parents.
+	if err := os.Symlink(outside, filepath.Join(workspace, "node_modules")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(workspace, "linked")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, invalid := range []string{"", ".", "../outside", "node_modules/../../outside", "node_modules", "node_modules/.cache", "linked/node_modules"} {
+		if _, err := cacheDirectoryPath(workspace, executor.CacheDirectory{Key: "key", Path: invalid}); err == nil {
+			t.Errorf("expected error for path %q", invalid)
+		}
+	}
+
+	path, err := cacheDirectoryPath(workspace, executor.CacheDirectory{Key: "key", Path: "web/node_modules"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := filepath.Join(workspace, "web/node_modules"); path != want {
+		t.Errorf("unexpected path. want=%q have=%q", want, path)
+	}
+}
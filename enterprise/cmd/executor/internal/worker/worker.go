@@ -11,6 +11,7 @@ import (
 	"github.com/inconshreveable/log15"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/cache"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/command"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/janitor"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
@@ -52,8 +53,11 @@ type Options struct {
 	// FirecrackerOptions configures the behavior of Firecracker virtual machine creation.
 	FirecrackerOptions command.FirecrackerOptions
 
-	// ResourceOptions configures the resource limits of docker container and Firecracker
-	// virtual machines running on the executor.
+	// KubernetesOptions configures the behavior of Kubernetes pod creation.
+	KubernetesOptions command.KubernetesOptions
+
+	// ResourceOptions configures the resource limits of docker container, Firecracker
+	// virtual machines, and Kubernetes pods running on the executor.
 	ResourceOptions command.ResourceOptions
 
 	// MaximumRuntimePerJob is the maximum wall time that can be spent on a single job.
@@ -64,8 +68,9 @@ type Options struct {
 // routine contains both a worker that periodically polls for new work to perform, as well
 // as a heartbeat routine that will periodically hit the remote API with the work that is
 // currently being performed, which is necessary so the job queue API doesn't hand out jobs
-// it thinks may have been dropped.
-func NewWorker(nameSet *janitor.NameSet, options Options, observationContext *observation.Context) (worker goroutine.WaitableBackgroundRoutine, canceler goroutine.BackgroundRoutine) {
+// it thinks may have been dropped. The given cache may be nil, in which case nothing is
+// cached between jobs.
+func NewWorker(nameSet *janitor.NameSet, cache *cache.Cache, options Options, observationContext *observation.Context) (worker goroutine.WaitableBackgroundRoutine, canceler goroutine.BackgroundRoutine) {
 	queueStore := apiclient.New(options.ClientOptions, observationContext)
 	store := &storeShim{queueName: options.QueueName, queueStore: queueStore}
 
@@ -75,7 +80,9 @@ func NewWorker(nameSet *janitor.NameSet, options Options, observationContext *ob
 
 	handler := &handler{
 		nameSet:       nameSet,
+		cache:         cache,
 		store:         store,
+		artifactStore: store,
 		options:       options,
 		operations:    command.NewOperations(observationContext),
 		runnerFactory: command.NewRunner,
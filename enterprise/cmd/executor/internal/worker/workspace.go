@@ -15,7 +15,9 @@ import (
 // prepareWorkspace creates and returns a temporary director in which acts the workspace
 // while processing a single job. It is up to the caller to ensure that this directory is
 // removed after the job has finished processing. If a repository name is supplied, then
-// that repository will be cloned (through the frontend API) into the workspace.
+// that repository will be cloned (through the frontend API) into the workspace. If the
+// executor cache is enabled, the repository is fetched incrementally into a clone cached
+// on the host first, and the workspace is populated from the cached clone.
 func (h *handler) prepareWorkspace(ctx context.Context, commandRunner command.Runner, repositoryName, commit string) (_ string, err error) {
 	tempDir, err := makeTempDir()
 	if err != nil {
@@ -38,9 +40,37 @@ func (h *handler) prepareWorkspace(ctx context.Context, commandRunner command.Ru
 			return "", err
 		}
 
+		fetchURL := cloneURL.String()
+		if h.cache != nil {
+			cacheDir, release, err := h.cache.LockRepository(ctx, repositoryName)
+			if err != nil {
+				return "", err
+			}
+			defer release()
+
+			// The fetched commit is kept under a ref in the cached clone, so that its objects
+			// are not garbage collected and the next fetch only downloads the objects that are
+			// missing. Refs of previously fetched commits are pruned afterwards; the clone is
+			// locked, so no other job depends on them. The clone as a whole is evicted by the
+			// janitor once the cache grows too large.
+			cacheRef := cacheRefPrefix + commit
+			cacheCommands := []command.CommandSpec{
+				{Key: "setup.git.cache-init", Command: []string{"git", "-C", cacheDir, "init", "--bare"}, Operation: h.operations.SetupGitCacheInit},
+				{Key: "setup.git.cache-fetch", Command: []string{"git", "-C", cacheDir, "-c", "protocol.version=2", "fetch", "--no-tags", cloneURL.String(), fmt.Sprintf("+%s:%s", commit, cacheRef)}, Operation: h.operations.SetupGitCacheFetch},
+				{Key: "setup.git.cache-prune", Command: []string{"sh", "-c", pruneCacheRefsScript, "sh", cacheDir, cacheRef}, Operation: h.operations.SetupGitCachePrune},
+			}
+			for _, spec := range cacheCommands {
+				if err := commandRunner.Run(ctx, spec); err != nil {
+					return "", errors.Wrap(err, fmt.Sprintf("failed %s", spec.Key))
+				}
+			}
+
+			fetchURL = cacheDir
+		}
+
 		gitCommands := []command.CommandSpec{
 			{Key: "setup.git.init", Command: []string{"git", "-C", tempDir, "init"}, Operation: h.operations.SetupGitInit},
-			{Key: "setup.git.fetch", Command: []string{"git", "-C", tempDir, "-c", "protocol.version=2", "fetch", cloneURL.String(), "-t", commit}, Operation: h.operations.SetupGitFetch},
+			{Key: "setup.git.fetch", Command: []string{"git", "-C", tempDir, "-c", "protocol.version=2", "fetch", fetchURL, "-t", commit}, Operation: h.operations.SetupGitFetch},
 			{Key: "setup.git.add-remote", Command: []string{"git", "-C", tempDir, "remote", "add", "origin", repositoryName}, Operation: h.operations.SetupAddRemote},
 			{Key: "setup.git.checkout", Command: []string{"git", "-C", tempDir, "checkout", commit}, Operation: h.operations.SetupGitCheckout},
 		}
@@ -58,6 +88,13 @@ func (h *handler) prepareWorkspace(ctx context.Context, commandRunner command.Ru
 	return tempDir, nil
 }
 
+// cacheRefPrefix is the prefix of the refs of fetched commits in cached clones.
+const cacheRefPrefix = "refs/sg-cache/"
+
+// pruneCacheRefsScript deletes all refs under cacheRefPrefix of the cached clone given
+// as first argument, except for the ref given as second argument.
+const pruneCacheRefsScript = `git -C "$1" for-each-ref --format='delete %(refname)' ` + cacheRefPrefix + ` | grep -vxF "delete $2" | git -C "$1" update-ref --stdin`
+
 func makeURL(base, password string, path ...string) (*url.URL, error) {
 	u, err := makeRelativeURL(base, path...)
 	if err != nil {
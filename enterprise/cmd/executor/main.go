@@ -9,8 +9,11 @@ import (
 	"github.com/inconshreveable/log15"
 	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/cache"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/ignite"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/janitor"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker"
@@ -60,23 +63,53 @@ func main() {
 	log15.Info("Telemetry information gathered", "info", fmt.Sprintf("%+v", telemetryOptions))
 
 	nameSet := janitor.NewNameSet()
+	var executorCache *cache.Cache
+	if config.CacheDir != "" {
+		executorCache = cache.New(config.CacheDir, int64(config.CacheMaxSizeMB)*1024*1024)
+	}
+	workerOptions := config.APIWorkerOptions(telemetryOptions)
+	if config.UseKubernetes {
+		clientset, err := newInClusterClientset()
+		if err != nil {
+			log.Fatalf("failed to create Kubernetes client: %s", err)
+		}
+		workerOptions.KubernetesOptions.Clientset = clientset
+	}
 	ctx, cancel := context.WithCancel(context.Background())
-	worker, canceler := worker.NewWorker(nameSet, config.APIWorkerOptions(telemetryOptions), observationContext)
+	worker, canceler := worker.NewWorker(nameSet, executorCache, workerOptions, observationContext)
 
 	routines := []goroutine.BackgroundRoutine{
 		worker,
 		canceler,
 	}
+	janitorMetrics := janitor.NewMetrics(observationContext)
 	if config.UseFirecracker {
 		routines = append(routines, janitor.NewOrphanedVMJanitor(
 			config.VMPrefix,
 			nameSet,
 			config.CleanupTaskInterval,
-			janitor.NewMetrics(observationContext),
+			janitorMetrics,
 		))
 
 		mustRegisterVMCountMetric(observationContext, config.VMPrefix)
 	}
+	if config.UseKubernetes {
+		routines = append(routines, janitor.NewOrphanedPodJanitor(
+			workerOptions.KubernetesOptions,
+			config.VMPrefix,
+			nameSet,
+			config.MaximumRuntimePerJob,
+			config.CleanupTaskInterval,
+			janitorMetrics,
+		))
+	}
+	if executorCache != nil {
+		routines = append(routines, janitor.NewCacheJanitor(
+			executorCache,
+			config.CleanupTaskInterval,
+			janitorMetrics,
+		))
+	}
 
 	go func() {
 		// Block until the worker has exited. The executor worker is unique
@@ -111,6 +144,17 @@ func makeWorkerMetrics(queueName string) workerutil.WorkerMetrics {
 	)
 }
 
+// newInClusterClientset creates a Kubernetes client authenticated with the service account
+// of the pod the executor runs in.
+func newInClusterClientset() (kubernetes.Interface, error) {
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewForConfig(restConfig)
+}
+
 func mustRegisterVMCountMetric(observationContext *observation.Context, prefix string) {
 	observationContext.Registerer.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
 		Name: "src_executor_vms_total",
@@ -0,0 +1,61 @@
+package bitbucketcloudoauth
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/auth/oauth"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestParseConfig(t *testing.T) {
+	provider := &schema.BitbucketCloudAuthProvider{
+		ClientKey:    "my-client-key",
+		ClientSecret: "my-client-secret",
+		DisplayName:  "Bitbucket Cloud",
+		Type:         "bitbucketcloud",
+	}
+
+	t.Run("no external URL", func(t *testing.T) {
+		ps, problems := parseConfig(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+			AuthProviders: []schema.AuthProviders{{Bitbucketcloud: provider}},
+		}}, nil)
+		if len(ps) != 0 || len(problems) != 1 {
+			t.Fatalf("unexpected providers or problems: %v %v", ps, problems)
+		}
+	})
+
+	t.Run("bitbucket.org", func(t *testing.T) {
+		ps, problems := parseConfig(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+			ExternalURL:   "https://sourcegraph.example.com",
+			AuthProviders: []schema.AuthProviders{{Bitbucketcloud: provider}},
+		}}, nil)
+		if len(problems) != 0 {
+			t.Fatalf("unexpected problems: %v", problems)
+		}
+
+		p, ok := ps[*provider].(*oauth.Provider)
+		if !ok {
+			t.Fatalf("provider not found: %v", ps)
+		}
+		if have, want := p.ServiceID, "https://bitbucket.org/"; have != want {
+			t.Errorf("unexpected service ID: have %q, want %q", have, want)
+		}
+
+		want := oauth2.Config{
+			RedirectURL:  "https://sourcegraph.example.com/.auth/bitbucketcloud/callback",
+			ClientID:     "my-client-key",
+			ClientSecret: "my-client-secret",
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  "https://bitbucket.org/site/oauth2/authorize",
+				TokenURL: "https://bitbucket.org/site/oauth2/access_token",
+			},
+		}
+		if diff := cmp.Diff(want, p.OAuth2Config()); diff != "" {
+			t.Fatalf("unexpected OAuth config (-want +got):\n%s", diff)
+		}
+	})
+}
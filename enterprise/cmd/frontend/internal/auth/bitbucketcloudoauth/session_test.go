@@ -0,0 +1,26 @@
+package bitbucketcloudoauth
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+)
+
+func TestGetVerifiedEmails(t *testing.T) {
+	emails := []*bitbucketcloud.Email{
+		{Email: "alice@example.org", IsConfirmed: true},
+		{Email: "unconfirmed@example.com"},
+		{Email: "alice@example.com", IsPrimary: true, IsConfirmed: true},
+	}
+
+	want := []string{"alice@example.com", "alice@example.org"}
+	if diff := cmp.Diff(want, getVerifiedEmails(emails)); diff != "" {
+		t.Fatalf("unexpected emails (-want +got):\n%s", diff)
+	}
+
+	if have := getVerifiedEmails(emails[1:2]); len(have) != 0 {
+		t.Fatalf("unexpected emails: %v", have)
+	}
+}
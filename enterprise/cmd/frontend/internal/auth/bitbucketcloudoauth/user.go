@@ -0,0 +1,28 @@
+package bitbucketcloudoauth
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+)
+
+// unexported key type prevents collisions
+type key int
+
+const userKey key = iota
+
+// WithUser returns a copy of ctx that stores the Bitbucket Cloud user.
+func WithUser(ctx context.Context, user *bitbucketcloud.Account) context.Context {
+	return context.WithValue(ctx, userKey, user)
+}
+
+// UserFromContext returns the Bitbucket Cloud user from the ctx.
+func UserFromContext(ctx context.Context) (*bitbucketcloud.Account, error) {
+	user, ok := ctx.Value(userKey).(*bitbucketcloud.Account)
+	if !ok {
+		return nil, errors.Errorf("bitbucketcloud: Context missing Bitbucket Cloud user")
+	}
+	return user, nil
+}
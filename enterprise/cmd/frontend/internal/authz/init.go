@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/inconshreveable/log15"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
@@ -16,6 +17,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/authz/resolvers"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/licensing/enforcement"
 	eiauthz "github.com/sourcegraph/sourcegraph/enterprise/internal/authz"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/authz/pathrules"
 	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/licensing"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
@@ -181,6 +183,15 @@ func Init(ctx context.Context, db database.DB, _ conftypes.UnifiedWatchable, out
 		}
 	}()
 
+	// Sub-repository permissions are checked against the rules synced from code
+	// hosts as well as the path rules in the site configuration.
+	conf.ContributeValidator(pathrules.Validate)
+	subRepoPerms, err := authz.NewSubRepoPermsClient(pathrules.NewSubRepoPermissionsGetter(db))
+	if err != nil {
+		return errors.Wrap(err, "creating sub-repo permissions client")
+	}
+	authz.DefaultSubRepoPermsChecker = subRepoPerms
+
 	enterpriseServices.AuthzResolver = resolvers.NewResolver(db, timeutil.Now)
 	return nil
 }
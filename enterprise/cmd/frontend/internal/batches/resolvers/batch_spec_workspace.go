@@ -326,6 +326,17 @@ func (r *batchSpecWorkspaceResolver) PlaceInQueue() *int32 {
 	return &i32
 }
 
+// Artifacts returns the artifacts uploaded by the executor that processed the
+// execution of the workspace. Executions are dequeued by executors from the "batches"
+// queue.
+func (r *batchSpecWorkspaceResolver) Artifacts(ctx context.Context) ([]graphqlbackend.ExecutorJobArtifactResolver, error) {
+	if r.execution == nil {
+		return []graphqlbackend.ExecutorJobArtifactResolver{}, nil
+	}
+
+	return graphqlbackend.NewExecutorJobArtifactResolvers(ctx, r.store.DatabaseDB(), "batches", int(r.execution.ID))
+}
+
 type batchSpecWorkspaceStagesResolver struct {
 	store     *store.Store
 	execution *btypes.BatchSpecWorkspaceExecutionJob
@@ -368,3 +368,12 @@ func (c *changesetSpecDeltaResolver) AuthorNameChanged() bool {
 func (c *changesetSpecDeltaResolver) AuthorEmailChanged() bool {
 	return c.delta.AuthorEmailChanged
 }
+func (c *changesetSpecDeltaResolver) LabelsChanged() bool {
+	return c.delta.LabelsChanged
+}
+func (c *changesetSpecDeltaResolver) ReviewersChanged() bool {
+	return c.delta.ReviewersChanged
+}
+func (c *changesetSpecDeltaResolver) AssigneesChanged() bool {
+	return c.delta.AssigneesChanged
+}
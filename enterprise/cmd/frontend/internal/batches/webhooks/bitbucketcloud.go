@@ -0,0 +1,216 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/inconshreveable/log15"
+
+	fewebhooks "github.com/sourcegraph/sourcegraph/cmd/frontend/webhooks"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type BitbucketCloudWebhook struct {
+	*Webhook
+}
+
+func NewBitbucketCloudWebhook(store *store.Store) *BitbucketCloudWebhook {
+	return &BitbucketCloudWebhook{
+		Webhook: &Webhook{store, extsvc.TypeBitbucketCloud},
+	}
+}
+
+func (h *BitbucketCloudWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e, extSvc, hErr := h.parseEvent(r)
+	if hErr != nil {
+		respond(w, hErr.code, hErr)
+		return
+	}
+
+	fewebhooks.SetExternalServiceID(r.Context(), extSvc.ID)
+
+	// 🚨 SECURITY: now that the shared secret has been validated, we can use an
+	// internal actor on the context.
+	ctx := actor.WithInternalActor(r.Context())
+
+	externalServiceID, err := extractExternalServiceID(extSvc)
+	if err != nil {
+		respond(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	prs, ev, err := h.convertEvent(ctx, externalServiceID, e)
+	if err != nil {
+		respond(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	m := new(multierror.Error)
+	for _, pr := range prs {
+		if pr == (PR{}) {
+			log15.Warn("Dropping Bitbucket Cloud webhook event", "type", fmt.Sprintf("%T", e))
+			continue
+		}
+
+		err := h.upsertChangesetEvent(ctx, externalServiceID, pr, ev)
+		if err != nil {
+			m = multierror.Append(m, err)
+		}
+	}
+	if m.ErrorOrNil() != nil {
+		respond(w, http.StatusInternalServerError, m)
+	}
+}
+
+func (h *BitbucketCloudWebhook) parseEvent(r *http.Request) (interface{}, *types.ExternalService, *httpError) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, nil, &httpError{http.StatusInternalServerError, err}
+	}
+
+	// Bitbucket Cloud doesn't sign webhook payloads, so the shared secret is
+	// passed as a query parameter of the webhook URL instead.
+	secret := r.URL.Query().Get("secret")
+
+	rawID := r.FormValue(extsvc.IDParam)
+	var externalServiceID int64
+	if rawID != "" {
+		externalServiceID, err = strconv.ParseInt(rawID, 10, 64)
+		if err != nil {
+			return nil, nil, &httpError{http.StatusBadRequest, errors.Wrap(err, "invalid external service id")}
+		}
+	}
+
+	args := database.ExternalServicesListOptions{Kinds: []string{extsvc.KindBitbucketCloud}}
+	if externalServiceID != 0 {
+		args.IDs = append(args.IDs, externalServiceID)
+	}
+	es, err := h.Store.ExternalServices().List(r.Context(), args)
+	if err != nil {
+		return nil, nil, &httpError{http.StatusInternalServerError, err}
+	}
+
+	var extSvc *types.ExternalService
+	for _, e := range es {
+		if externalServiceID != 0 && e.ID != externalServiceID {
+			continue
+		}
+
+		c, _ := e.Configuration()
+		con, ok := c.(*schema.BitbucketCloudConnection)
+		if !ok || con.WebhookSecret == "" {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(secret), []byte(con.WebhookSecret)) == 1 {
+			extSvc = e
+			break
+		}
+	}
+
+	if extSvc == nil {
+		return nil, nil, &httpError{http.StatusUnauthorized, nil}
+	}
+
+	e, err := bitbucketcloud.ParseWebhookEvent(bitbucketcloud.WebhookEventType(r), payload)
+	if err != nil {
+		return nil, nil, &httpError{http.StatusBadRequest, errors.Wrap(err, "parsing webhook")}
+	}
+	return e, extSvc, nil
+}
+
+func (h *BitbucketCloudWebhook) convertEvent(ctx context.Context, externalServiceID string, theirs interface{}) (prs []PR, ours keyer, err error) {
+	log15.Debug("Bitbucket Cloud webhook received", "type", fmt.Sprintf("%T", theirs))
+
+	switch e := theirs.(type) {
+	case *bitbucketcloud.PullRequestApprovedEvent:
+		prs = append(prs, bitbucketCloudPR(&e.PullRequestEvent))
+		return prs, &bitbucketcloud.Participant{
+			User:           e.Approval.User,
+			Approved:       true,
+			State:          bitbucketcloud.ParticipantStateApproved,
+			ParticipatedOn: e.Approval.Date,
+		}, nil
+	case *bitbucketcloud.PullRequestChangesRequestCreatedEvent:
+		prs = append(prs, bitbucketCloudPR(&e.PullRequestEvent))
+		return prs, &bitbucketcloud.Participant{
+			User:           e.ChangesRequest.User,
+			State:          bitbucketcloud.ParticipantStateChangesRequested,
+			ParticipatedOn: e.ChangesRequest.Date,
+		}, nil
+	case *bitbucketcloud.PullRequestUnapprovedEvent:
+		prs = append(prs, bitbucketCloudPR(&e.PullRequestEvent))
+		return prs, e, nil
+	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
+		prs = append(prs, bitbucketCloudPR(&e.PullRequestEvent))
+		return prs, e, nil
+	case *bitbucketcloud.PullRequestFulfilledEvent:
+		prs = append(prs, bitbucketCloudPR(&e.PullRequestEvent))
+		return prs, e, nil
+	case *bitbucketcloud.PullRequestRejectedEvent:
+		prs = append(prs, bitbucketCloudPR(&e.PullRequestEvent))
+		return prs, e, nil
+	case *bitbucketcloud.RepoCommitStatusEvent:
+		// Commit statuses don't reference a pull request, so we have to find
+		// the changeset that was opened from the branch the status was
+		// reported on.
+		pr, err := h.prForBranch(ctx, externalServiceID, e.Repository.UUID, e.CommitStatus.RefName)
+		if err != nil {
+			return nil, nil, err
+		}
+		prs = append(prs, pr)
+		return prs, &e.CommitStatus, nil
+	}
+
+	return nil, nil, nil
+}
+
+// prForBranch returns the PR that was opened from the given branch of the
+// repository with the given UUID. If no changeset tracks such a PR, an empty
+// PR is returned.
+func (h *BitbucketCloudWebhook) prForBranch(ctx context.Context, externalServiceID, repoUUID, branch string) (PR, error) {
+	if branch == "" {
+		return PR{}, nil
+	}
+
+	r, err := h.getRepoForPR(ctx, h.Store, PR{RepoExternalID: repoUUID}, externalServiceID)
+	if err != nil {
+		log15.Warn("Webhook event could not be matched to repo", "err", err)
+		return PR{}, nil
+	}
+
+	cs, err := h.Store.GetChangeset(ctx, store.GetChangesetOpts{
+		RepoID:              r.ID,
+		ExternalBranch:      git.EnsureRefPrefix(branch),
+		ExternalServiceType: h.ServiceType,
+	})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return PR{}, nil
+		}
+		return PR{}, err
+	}
+
+	id, err := strconv.ParseInt(cs.ExternalID, 10, 64)
+	if err != nil {
+		return PR{}, errors.Wrap(err, "parsing changeset external ID")
+	}
+	return PR{ID: id, RepoExternalID: repoUUID}, nil
+}
+
+func bitbucketCloudPR(e *bitbucketcloud.PullRequestEvent) PR {
+	return PR{ID: e.PullRequest.ID, RepoExternalID: e.Repository.UUID}
+}
@@ -0,0 +1,96 @@
+package webhooks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+)
+
+func TestBitbucketCloudWebhook_convertEvent(t *testing.T) {
+	h := NewBitbucketCloudWebhook(nil)
+	date := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	prEvent := bitbucketcloud.PullRequestEvent{
+		PullRequest: bitbucketcloud.PullRequest{ID: 42},
+		Repository:  bitbucketcloud.Repo{UUID: "{repo}"},
+	}
+	reviewer := bitbucketcloud.Account{UUID: "{reviewer}"}
+	wantPRs := []PR{{ID: 42, RepoExternalID: "{repo}"}}
+
+	for name, tc := range map[string]struct {
+		event    interface{}
+		wantKind btypes.ChangesetEventKind
+		wantKey  string
+	}{
+		"approved": {
+			event: &bitbucketcloud.PullRequestApprovedEvent{
+				PullRequestEvent: prEvent,
+				Approval:         bitbucketcloud.Approval{Date: date, User: reviewer},
+			},
+			wantKind: btypes.ChangesetEventKindBitbucketCloudApproved,
+			wantKey:  "{reviewer}:approved",
+		},
+		"changes requested": {
+			event: &bitbucketcloud.PullRequestChangesRequestCreatedEvent{
+				PullRequestEvent: prEvent,
+				ChangesRequest:   bitbucketcloud.Approval{Date: date, User: reviewer},
+			},
+			wantKind: btypes.ChangesetEventKindBitbucketCloudChangesRequested,
+			wantKey:  "{reviewer}:changes_requested",
+		},
+		"unapproved": {
+			event: &bitbucketcloud.PullRequestUnapprovedEvent{
+				PullRequestEvent: prEvent,
+				Approval:         bitbucketcloud.Approval{Date: date, User: reviewer},
+			},
+			wantKind: btypes.ChangesetEventKindBitbucketCloudUnapproved,
+			wantKey:  "{reviewer}:2021-12-01T10:00:00Z",
+		},
+		"fulfilled": {
+			event:    &bitbucketcloud.PullRequestFulfilledEvent{PullRequestEvent: prEvent},
+			wantKind: btypes.ChangesetEventKindBitbucketCloudFulfilled,
+			wantKey:  "42",
+		},
+		"rejected": {
+			event:    &bitbucketcloud.PullRequestRejectedEvent{PullRequestEvent: prEvent},
+			wantKind: btypes.ChangesetEventKindBitbucketCloudRejected,
+			wantKey:  "42",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			prs, ev, err := h.convertEvent(context.Background(), "https://bitbucket.org/", tc.event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(wantPRs, prs); diff != "" {
+				t.Errorf("unexpected PRs (-want +have):\n%s", diff)
+			}
+
+			kind, err := btypes.ChangesetEventKindFor(ev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if kind != tc.wantKind {
+				t.Errorf("unexpected kind: have=%q want=%q", kind, tc.wantKind)
+			}
+			if have := ev.Key(); have != tc.wantKey {
+				t.Errorf("unexpected key: have=%q want=%q", have, tc.wantKey)
+			}
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		prs, ev, err := h.convertEvent(context.Background(), "https://bitbucket.org/", &bitbucketcloud.PullRequestCommentEvent{PullRequestEvent: prEvent})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(prs) != 0 || ev != nil {
+			t.Errorf("expected event to be dropped, got prs=%v event=%v", prs, ev)
+		}
+	})
+}
@@ -52,21 +52,25 @@ func checkGitHubPermissions(ctx context.Context, repoName string, client GitHubC
 // is present in set of visible repositories, indicating authorship of the user initiating the current
 // upload request.
 func checkGitHubAppInstallationPermissions(ctx context.Context, nameWithOwner string, client GitHubClient) (author bool, wrongTokenType bool, _ error) {
-	installationRepositories, err := client.ListInstallationRepositories(ctx)
-	if err != nil {
-		// A 403 error with this text indicates that the supplied token is a user token and not
-		// an app installation token. We'll send back a special flag to the caller to inform them
-		// that they should fall back to hitting the repository endpoint as the user.
-		if githubErr, ok := err.(*github.APIError); ok && githubErr.Code == 403 && strings.Contains(githubErr.Message, "installation access token") {
-			return false, true, nil
+	for page, hasNextPage := 1, true; hasNextPage; page++ {
+		var installationRepositories []*github.Repository
+		var err error
+		installationRepositories, hasNextPage, _, err = client.ListInstallationRepositories(ctx, page)
+		if err != nil {
+			// A 403 error with this text indicates that the supplied token is a user token and not
+			// an app installation token. We'll send back a special flag to the caller to inform them
+			// that they should fall back to hitting the repository endpoint as the user.
+			if githubErr, ok := err.(*github.APIError); ok && githubErr.Code == 403 && strings.Contains(githubErr.Message, "installation access token") {
+				return false, true, nil
+			}
+
+			return false, false, errors.Wrap(err, "githubClient.ListInstallationRepositories")
 		}
 
-		return false, false, errors.Wrap(err, "githubClient.ListInstallationRepositories")
-	}
-
-	for _, repository := range installationRepositories {
-		if repository.NameWithOwner == nameWithOwner {
-			return true, false, nil
+		for _, repository := range installationRepositories {
+			if repository.NameWithOwner == nameWithOwner {
+				return true, false, nil
+			}
 		}
 	}
 
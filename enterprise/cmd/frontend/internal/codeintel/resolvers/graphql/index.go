@@ -77,3 +77,9 @@ func (r *IndexResolver) AssociatedUpload(ctx context.Context) (gql.LSIFUploadRes
 func (r *IndexResolver) ProjectRoot(ctx context.Context) (*gql.GitTreeEntryResolver, error) {
 	return r.locationResolver.Path(ctx, api.RepoID(r.index.RepositoryID), r.index.Commit, r.index.Root)
 }
+
+// Artifacts returns the artifacts uploaded by the executor that processed the index
+// job. Index jobs are dequeued by executors from the "codeintel" queue.
+func (r *IndexResolver) Artifacts(ctx context.Context) ([]gql.ExecutorJobArtifactResolver, error) {
+	return gql.NewExecutorJobArtifactResolvers(ctx, r.db, "codeintel", r.index.ID)
+}
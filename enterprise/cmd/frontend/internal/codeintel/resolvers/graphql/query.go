@@ -8,6 +8,7 @@ import (
 	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 )
 
 // DefaultReferencesPageSize is the reference result page size when no limit is supplied.
@@ -32,6 +33,7 @@ var ErrIllegalBounds = errors.New("illegal bounds")
 type QueryResolver struct {
 	resolver         resolvers.QueryResolver
 	locationResolver *CachedLocationResolver
+	subRepoPerms     authz.SubRepoPermissionChecker
 }
 
 // NewQueryResolver creates a new QueryResolver with the given resolver that defines all code intel-specific
@@ -41,6 +43,7 @@ func NewQueryResolver(resolver resolvers.QueryResolver, locationResolver *Cached
 	return &QueryResolver{
 		resolver:         resolver,
 		locationResolver: locationResolver,
+		subRepoPerms:     authz.DefaultSubRepoPermsChecker,
 	}
 }
 
@@ -70,6 +73,9 @@ func (r *QueryResolver) Ranges(ctx context.Context, args *gql.LSIFRangesArgs) (g
 	if err != nil {
 		return nil, err
 	}
+	if ranges, err = filterRanges(ctx, r.subRepoPerms, ranges); err != nil {
+		return nil, err
+	}
 
 	return &CodeIntelligenceRangeConnectionResolver{
 		ranges:           ranges,
@@ -82,6 +88,9 @@ func (r *QueryResolver) Definitions(ctx context.Context, args *gql.LSIFQueryPosi
 	if err != nil {
 		return nil, err
 	}
+	if locations, err = filterLocations(ctx, r.subRepoPerms, locations); err != nil {
+		return nil, err
+	}
 
 	return NewLocationConnectionResolver(locations, nil, r.locationResolver), nil
 }
@@ -100,6 +109,9 @@ func (r *QueryResolver) References(ctx context.Context, args *gql.LSIFPagedQuery
 	if err != nil {
 		return nil, err
 	}
+	if locations, err = filterLocations(ctx, r.subRepoPerms, locations); err != nil {
+		return nil, err
+	}
 
 	return NewLocationConnectionResolver(locations, strPtr(cursor), r.locationResolver), nil
 }
@@ -118,6 +130,9 @@ func (r *QueryResolver) Implementations(ctx context.Context, args *gql.LSIFPaged
 	if err != nil {
 		return nil, err
 	}
+	if locations, err = filterLocations(ctx, r.subRepoPerms, locations); err != nil {
+		return nil, err
+	}
 
 	return NewLocationConnectionResolver(locations, strPtr(cursor), r.locationResolver), nil
 }
@@ -141,6 +156,12 @@ func (r *QueryResolver) Diagnostics(ctx context.Context, args *gql.LSIFDiagnosti
 	if err != nil {
 		return nil, err
 	}
+	filtered, err := filterDiagnostics(ctx, r.subRepoPerms, diagnostics)
+	if err != nil {
+		return nil, err
+	}
+	totalCount -= len(diagnostics) - len(filtered)
+	diagnostics = filtered
 
 	return NewDiagnosticConnectionResolver(diagnostics, totalCount, r.locationResolver), nil
 }
@@ -0,0 +1,74 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+)
+
+// filterLocations returns the locations in files the user in ctx is allowed to
+// read according to sub-repo permissions.
+func filterLocations(ctx context.Context, checker authz.SubRepoPermissionChecker, locations []resolvers.AdjustedLocation) ([]resolvers.AdjustedLocation, error) {
+	if !checker.Enabled() {
+		return locations, nil
+	}
+
+	a := actor.FromContext(ctx)
+	filtered := make([]resolvers.AdjustedLocation, 0, len(locations))
+	for _, location := range locations {
+		ok, err := authz.FilterActorPath(ctx, checker, a, api.RepoName(location.Dump.RepositoryName), location.Path)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered = append(filtered, location)
+		}
+	}
+	return filtered, nil
+}
+
+// filterDiagnostics returns the diagnostics in files the user in ctx is allowed
+// to read according to sub-repo permissions.
+func filterDiagnostics(ctx context.Context, checker authz.SubRepoPermissionChecker, diagnostics []resolvers.AdjustedDiagnostic) ([]resolvers.AdjustedDiagnostic, error) {
+	if !checker.Enabled() {
+		return diagnostics, nil
+	}
+
+	a := actor.FromContext(ctx)
+	filtered := make([]resolvers.AdjustedDiagnostic, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		ok, err := authz.FilterActorPath(ctx, checker, a, api.RepoName(diagnostic.Dump.RepositoryName), diagnostic.Path)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered = append(filtered, diagnostic)
+		}
+	}
+	return filtered, nil
+}
+
+// filterRanges removes the definitions, references, and implementations in
+// files the user in ctx is not allowed to read from the given ranges.
+func filterRanges(ctx context.Context, checker authz.SubRepoPermissionChecker, ranges []resolvers.AdjustedCodeIntelligenceRange) ([]resolvers.AdjustedCodeIntelligenceRange, error) {
+	if !checker.Enabled() {
+		return ranges, nil
+	}
+
+	for i := range ranges {
+		var err error
+		if ranges[i].Definitions, err = filterLocations(ctx, checker, ranges[i].Definitions); err != nil {
+			return nil, err
+		}
+		if ranges[i].References, err = filterLocations(ctx, checker, ranges[i].References); err != nil {
+			return nil, err
+		}
+		if ranges[i].Implementations, err = filterLocations(ctx, checker, ranges[i].Implementations); err != nil {
+			return nil, err
+		}
+	}
+	return ranges, nil
+}
@@ -0,0 +1,64 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+)
+
+func TestFilterSubRepoPermissions(t *testing.T) {
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.PermissionsFunc.SetDefaultHook(func(ctx context.Context, userID int32, content authz.RepoContent) (authz.Perms, error) {
+		if strings.HasPrefix(content.Path, "internal/") {
+			return authz.None, nil
+		}
+		return authz.Read, nil
+	})
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	dump := dbstore.Dump{RepositoryName: "github.com/sourcegraph/sourcegraph"}
+
+	locations := []resolvers.AdjustedLocation{
+		{Dump: dump, Path: "cmd/main.go"},
+		{Dump: dump, Path: "internal/secret.go"},
+	}
+	filteredLocations, err := filterLocations(ctx, checker, locations)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(locations[:1], filteredLocations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	diagnostics := []resolvers.AdjustedDiagnostic{
+		{Dump: dump, Diagnostic: lsifstore.Diagnostic{Path: "internal/secret.go"}},
+		{Dump: dump, Diagnostic: lsifstore.Diagnostic{Path: "cmd/main.go"}},
+	}
+	filteredDiagnostics, err := filterDiagnostics(ctx, checker, diagnostics)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(diagnostics[1:], filteredDiagnostics); diff != "" {
+		t.Errorf("unexpected diagnostics (-want +got):\n%s", diff)
+	}
+
+	ranges := []resolvers.AdjustedCodeIntelligenceRange{
+		{Definitions: locations, References: locations},
+	}
+	filteredRanges, err := filterRanges(ctx, checker, ranges)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(locations[:1], filteredRanges[0].References); diff != "" {
+		t.Errorf("unexpected references (-want +got):\n%s", diff)
+	}
+}
@@ -36,6 +36,7 @@ type Services struct {
 	// shared with executorqueue
 	InternalUploadHandler http.Handler
 	ExternalUploadHandler http.Handler
+	ArtifactStore         uploadstore.Store
 
 	locker          *locker.Locker
 	gitserverClient *gitserver.Client
@@ -96,6 +97,7 @@ func NewServices(ctx context.Context, siteConfig conftypes.SiteConfigQuerier, db
 
 		InternalUploadHandler: internalUploadHandler,
 		ExternalUploadHandler: externalUploadHandler,
+		ArtifactStore:         uploadStore,
 
 		locker:          locker,
 		gitserverClient: gitserverClient,
@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -12,6 +13,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
 	cm "github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/background"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
@@ -147,7 +149,11 @@ func (r *Resolver) CreateCodeMonitor(ctx context.Context, args *graphqlbackend.C
 	}
 
 	// Create trigger.
-	_, err = tx.store.CreateQueryTrigger(ctx, m.ID, args.Trigger.Query)
+	schedule, err := normalizeSchedule(args.Trigger.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.store.CreateQueryTrigger(ctx, m.ID, args.Trigger.Query, schedule)
 	if err != nil {
 		return nil, err
 	}
@@ -221,6 +227,9 @@ func (r *Resolver) UpdateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}
 
 	toCreate, toDelete, err := splitActionIDs(ctx, args, actionIDs)
+	if err != nil {
+		return nil, err
+	}
 	if len(toDelete) == len(actionIDs) {
 		return nil, errors.Errorf("you tried to delete all actions, but every monitor must be connected to at least 1 action")
 	}
@@ -233,7 +242,7 @@ func (r *Resolver) UpdateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}
 	defer func() { err = tx.store.Done(err) }()
 
-	err = tx.store.DeleteEmailActions(ctx, toDelete, monitorID)
+	err = tx.deleteActions(ctx, monitorID, toDelete)
 	if err != nil {
 		return nil, err
 	}
@@ -266,11 +275,58 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 				return err
 			}
 		}
-		// TODO(camdencheek): add other action types (webhooks) here
+		if a.Webhook != nil {
+			_, err := r.store.CreateWebhookAction(ctx, monitorID, &cm.WebhookActionArgs{
+				Enabled: a.Webhook.Enabled,
+				URL:     a.Webhook.URL,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if a.SlackWebhook != nil {
+			_, err := r.store.CreateSlackWebhookAction(ctx, monitorID, &cm.SlackWebhookActionArgs{
+				Enabled: a.SlackWebhook.Enabled,
+				URL:     a.SlackWebhook.URL,
+			})
+			if err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// deleteActions deletes the actions with the given IDs, which may be of any
+// action kind, from the monitor.
+func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []graphql.ID) error {
+	var email, webhook, slackWebhook []int64
+	for _, id := range ids {
+		var actionID int64
+		if err := relay.UnmarshalSpec(id, &actionID); err != nil {
+			return err
+		}
+		switch kind := relay.UnmarshalKind(id); kind {
+		case monitorActionEmailKind:
+			email = append(email, actionID)
+		case monitorActionWebhookKind:
+			webhook = append(webhook, actionID)
+		case monitorActionSlackWebhookKind:
+			slackWebhook = append(slackWebhook, actionID)
+		default:
+			return errors.Errorf("unknown action kind %q", kind)
+		}
+	}
+
+	if err := r.store.DeleteEmailActions(ctx, email, monitorID); err != nil {
+		return err
+	}
+	if err := r.store.DeleteWebhookActions(ctx, webhook, monitorID); err != nil {
+		return err
+	}
+	return r.store.DeleteSlackWebhookActions(ctx, slackWebhook, monitorID)
+}
+
 func (r *Resolver) createRecipients(ctx context.Context, emailID int64, recipients []graphql.ID) error {
 	for _, recipient := range recipients {
 		userID, orgID, err := graphqlbackend.UnmarshalNamespaceToIDs(recipient)
@@ -322,6 +378,32 @@ func (r *Resolver) TriggerTestEmailAction(ctx context.Context, args *graphqlback
 	return &graphqlbackend.EmptyResponse{}, nil
 }
 
+func (r *Resolver) TriggerTestWebhookAction(ctx context.Context, args *graphqlbackend.TriggerTestWebhookActionArgs) (*graphqlbackend.EmptyResponse, error) {
+	err := r.isAllowedToCreate(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := background.SendTestWebhook(ctx, args.Description, args.Webhook.URL); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+func (r *Resolver) TriggerTestSlackWebhookAction(ctx context.Context, args *graphqlbackend.TriggerTestSlackWebhookActionArgs) (*graphqlbackend.EmptyResponse, error) {
+	err := r.isAllowedToCreate(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := background.SendTestSlackWebhook(ctx, args.Description, args.SlackWebhook.URL); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
 func sendTestEmail(ctx context.Context, recipient graphql.ID, description string) error {
 	var (
 		userID int32
@@ -340,45 +422,78 @@ func sendTestEmail(ctx context.Context, recipient graphql.ID, description string
 }
 
 func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int64) (actionIDs []graphql.ID, err error) {
-	emailActions, err := r.store.ListEmailActions(ctx, cm.ListActionsOpts{
-		MonitorID: intPtr(int(monitorID)),
-	})
+	actions, err := r.listActions(ctx, nil, monitorID)
 	if err != nil {
 		return nil, err
 	}
-	ids := make([]graphql.ID, len(emailActions))
-	for i, emailAction := range emailActions {
-		ids[i] = (&monitorEmail{EmailAction: emailAction}).ID()
+	ids := make([]graphql.ID, len(actions))
+	for i, a := range actions {
+		ids[i] = a.ID()
 	}
 	return ids, nil
 }
 
+// normalizeSchedule validates the cron schedule of a trigger. An empty schedule
+// is treated as no schedule.
+func normalizeSchedule(schedule *string) (*string, error) {
+	if schedule == nil || strings.TrimSpace(*schedule) == "" {
+		return nil, nil
+	}
+	if _, err := cm.ParseSchedule(*schedule); err != nil {
+		return nil, err
+	}
+	trimmed := strings.TrimSpace(*schedule)
+	return &trimmed, nil
+}
+
 // splitActionIDs splits actions into three buckets: create, delete and update.
 // Note: args is mutated. After splitActionIDs, args only contains actions to be updated.
-func splitActionIDs(ctx context.Context, args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []graphql.ID) (toCreate []*graphqlbackend.CreateActionArgs, toDelete []int64, err error) {
+func splitActionIDs(ctx context.Context, args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []graphql.ID) (toCreate []*graphqlbackend.CreateActionArgs, toDelete []graphql.ID, err error) {
 	aMap := make(map[graphql.ID]struct{}, len(actionIDs))
 	for _, id := range actionIDs {
 		aMap[id] = struct{}{}
 	}
 	var toUpdateActions []*graphqlbackend.EditActionArgs
 	for _, a := range args.Actions {
-		if a.Email.Id == nil {
-			toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{Email: a.Email.Update})
-			continue
+		var (
+			id   *graphql.ID
+			kind string
+		)
+		switch {
+		case a.Email != nil:
+			if a.Email.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{Email: a.Email.Update})
+				continue
+			}
+			id, kind = a.Email.Id, monitorActionEmailKind
+		case a.Webhook != nil:
+			if a.Webhook.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{Webhook: a.Webhook.Update})
+				continue
+			}
+			id, kind = a.Webhook.Id, monitorActionWebhookKind
+		case a.SlackWebhook != nil:
+			if a.SlackWebhook.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{SlackWebhook: a.SlackWebhook.Update})
+				continue
+			}
+			id, kind = a.SlackWebhook.Id, monitorActionSlackWebhookKind
+		default:
+			return nil, nil, errors.New("action must be one of email, webhook or slack webhook")
 		}
-		if _, ok := aMap[*a.Email.Id]; !ok {
-			return nil, nil, errors.Errorf("unknown ID=%s for action", *a.Email.Id)
+		// The IDs of the different action kinds share the same integer space, so
+		// we have to make sure the ID actually belongs to an action of this kind.
+		if got := relay.UnmarshalKind(*id); got != kind {
+			return nil, nil, errors.Errorf("invalid ID=%s for %s action", *id, kind)
+		}
+		if _, ok := aMap[*id]; !ok {
+			return nil, nil, errors.Errorf("unknown ID=%s for action", *id)
 		}
 		toUpdateActions = append(toUpdateActions, a)
-		delete(aMap, *a.Email.Id)
+		delete(aMap, *id)
 	}
-	var actionID int64
 	for k := range aMap {
-		err = relay.UnmarshalSpec(k, &actionID)
-		if err != nil {
-			return nil, nil, err
-		}
-		toDelete = append(toDelete, actionID)
+		toDelete = append(toDelete, k)
 	}
 	args.Actions = toUpdateActions
 	return toCreate, toDelete, nil
@@ -412,7 +527,11 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}
 
 	// Update trigger.
-	err = r.store.UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query)
+	schedule, err := normalizeSchedule(args.Trigger.Update.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	err = r.store.UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query, schedule)
 	if err != nil {
 		return nil, err
 	}
@@ -423,30 +542,17 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 			Monitor:  mo,
 		}, nil
 	}
-	var emailID int64
-	var e *cm.EmailAction
 	for i, action := range args.Actions {
-		if action.Email == nil {
-			return nil, errors.Errorf("missing email object for action %d", i)
-		}
-		err = relay.UnmarshalSpec(*action.Email.Id, &emailID)
-		if err != nil {
-			return nil, err
+		switch {
+		case action.Email != nil:
+			err = r.updateEmailAction(ctx, action.Email)
+		case action.Webhook != nil:
+			err = r.updateWebhookAction(ctx, mo.ID, action.Webhook)
+		case action.SlackWebhook != nil:
+			err = r.updateSlackWebhookAction(ctx, mo.ID, action.SlackWebhook)
+		default:
+			err = errors.Errorf("missing action object for action %d", i)
 		}
-		err = r.store.DeleteRecipients(ctx, emailID)
-		if err != nil {
-			return nil, err
-		}
-
-		e, err = r.store.UpdateEmailAction(ctx, emailID, &cm.EmailActionArgs{
-			Enabled:  action.Email.Update.Enabled,
-			Priority: action.Email.Update.Priority,
-			Header:   action.Email.Update.Header,
-		})
-		if err != nil {
-			return nil, err
-		}
-		err = r.createRecipients(ctx, e.ID, action.Email.Update.Recipients)
 		if err != nil {
 			return nil, err
 		}
@@ -457,6 +563,56 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}, nil
 }
 
+func (r *Resolver) updateEmailAction(ctx context.Context, args *graphqlbackend.EditActionEmailArgs) error {
+	var emailID int64
+	err := relay.UnmarshalSpec(*args.Id, &emailID)
+	if err != nil {
+		return err
+	}
+	err = r.store.DeleteRecipients(ctx, emailID)
+	if err != nil {
+		return err
+	}
+
+	e, err := r.store.UpdateEmailAction(ctx, emailID, &cm.EmailActionArgs{
+		Enabled:  args.Update.Enabled,
+		Priority: args.Update.Priority,
+		Header:   args.Update.Header,
+	})
+	if err != nil {
+		return err
+	}
+	return r.createRecipients(ctx, e.ID, args.Update.Recipients)
+}
+
+func (r *Resolver) updateWebhookAction(ctx context.Context, monitorID int64, args *graphqlbackend.EditActionWebhookArgs) error {
+	var webhookID int64
+	err := relay.UnmarshalSpec(*args.Id, &webhookID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.store.UpdateWebhookAction(ctx, monitorID, webhookID, &cm.WebhookActionArgs{
+		Enabled: args.Update.Enabled,
+		URL:     args.Update.URL,
+	})
+	return err
+}
+
+func (r *Resolver) updateSlackWebhookAction(ctx context.Context, monitorID int64, args *graphqlbackend.EditActionSlackWebhookArgs) error {
+	var slackWebhookID int64
+	err := relay.UnmarshalSpec(*args.Id, &slackWebhookID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.store.UpdateSlackWebhookAction(ctx, monitorID, slackWebhookID, &cm.SlackWebhookActionArgs{
+		Enabled: args.Update.Enabled,
+		URL:     args.Update.URL,
+	})
+	return err
+}
+
 func (r *Resolver) transact(ctx context.Context) (*Resolver, error) {
 	txStore, err := r.store.Transact(ctx)
 	if err != nil {
@@ -550,6 +706,8 @@ const (
 	monitorTriggerQueryKind         = "CodeMonitorTriggerQuery"
 	monitorTriggerEventKind         = "CodeMonitorTriggerEvent"
 	monitorActionEmailKind          = "CodeMonitorActionEmail"
+	monitorActionWebhookKind        = "CodeMonitorActionWebhook"
+	monitorActionSlackWebhookKind   = "CodeMonitorActionSlackWebhook"
 	monitorActionEventKind          = "CodeMonitorActionEmailEvent"
 	monitorActionEmailRecipientKind = "CodeMonitorActionEmailRecipient"
 )
@@ -592,38 +750,143 @@ func (m *monitor) Actions(ctx context.Context, args *graphqlbackend.ListActionAr
 }
 
 func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, triggerEventID *int32, monitorID int64, args *graphqlbackend.ListActionArgs) (graphqlbackend.MonitorActionConnectionResolver, error) {
+	// Actions are spread over one table per action kind and are listed ordered
+	// by kind and then by ID. The cursor therefore identifies both the table and
+	// the row to continue after.
+	var afterKind string
 	after, err := unmarshalAfter(args.After)
 	if err != nil {
 		return nil, err
 	}
-	// For now, we only support emails as actions. Once we add other actions such as
-	// webhooks, we have to query those tables here too.
-	es, err := r.store.ListEmailActions(ctx, cm.ListActionsOpts{
-		MonitorID: intPtr(int(monitorID)),
-		After:     after,
-		First:     intPtr(int(args.First)),
-	})
-	if err != nil {
-		return nil, err
+	if args.After != nil {
+		afterKind = relay.UnmarshalKind(graphql.ID(*args.After))
 	}
 
-	totalCount, err := r.store.CountEmailActions(ctx, monitorID)
-	if err != nil {
-		return nil, err
+	var (
+		actions    []graphqlbackend.MonitorAction
+		totalCount int32
+		remaining  = int(args.First)
+		reached    = args.After == nil
+	)
+	for _, kind := range actionKinds {
+		count, err := r.countActions(ctx, kind, monitorID)
+		if err != nil {
+			return nil, err
+		}
+		totalCount += count
+
+		opts := cm.ListActionsOpts{MonitorID: intPtr(int(monitorID))}
+		if !reached {
+			if kind != afterKind {
+				continue
+			}
+			reached = true
+			opts.After = after
+		}
+		if remaining <= 0 {
+			continue
+		}
+		opts.First = intPtr(remaining)
+
+		as, err := r.listActionsOfKind(ctx, kind, triggerEventID, opts)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, as...)
+		remaining -= len(as)
 	}
-	actions := make([]graphqlbackend.MonitorAction, 0, len(es))
-	for _, e := range es {
-		actions = append(actions, &action{
-			email: &monitorEmail{
-				Resolver:       r,
-				EmailAction:    e,
-				triggerEventID: triggerEventID,
-			},
-		})
+	if !reached {
+		return nil, errors.Errorf("unknown action kind %q", afterKind)
 	}
 	return &monitorActionConnection{actions: actions, totalCount: totalCount}, nil
 }
 
+// actionKinds are the kinds of actions that can be defined on a monitor, in the
+// order in which they are listed.
+var actionKinds = []string{
+	monitorActionEmailKind,
+	monitorActionWebhookKind,
+	monitorActionSlackWebhookKind,
+}
+
+// listActions returns the actions of all kinds that are defined on the given
+// monitor, ordered by kind and then by ID.
+func (r *Resolver) listActions(ctx context.Context, triggerEventID *int32, monitorID int64) ([]graphqlbackend.MonitorAction, error) {
+	var actions []graphqlbackend.MonitorAction
+	for _, kind := range actionKinds {
+		as, err := r.listActionsOfKind(ctx, kind, triggerEventID, cm.ListActionsOpts{MonitorID: intPtr(int(monitorID))})
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, as...)
+	}
+	return actions, nil
+}
+
+func (r *Resolver) countActions(ctx context.Context, kind string, monitorID int64) (int32, error) {
+	switch kind {
+	case monitorActionEmailKind:
+		return r.store.CountEmailActions(ctx, monitorID)
+	case monitorActionWebhookKind:
+		return r.store.CountWebhookActions(ctx, monitorID)
+	case monitorActionSlackWebhookKind:
+		return r.store.CountSlackWebhookActions(ctx, monitorID)
+	default:
+		return 0, errors.Errorf("unknown action kind %q", kind)
+	}
+}
+
+func (r *Resolver) listActionsOfKind(ctx context.Context, kind string, triggerEventID *int32, opts cm.ListActionsOpts) ([]graphqlbackend.MonitorAction, error) {
+	var actions []graphqlbackend.MonitorAction
+	switch kind {
+	case monitorActionEmailKind:
+		es, err := r.store.ListEmailActions(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range es {
+			actions = append(actions, &action{
+				email: &monitorEmail{
+					Resolver:       r,
+					EmailAction:    e,
+					triggerEventID: triggerEventID,
+				},
+			})
+		}
+	case monitorActionWebhookKind:
+		ws, err := r.store.ListWebhookActions(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range ws {
+			actions = append(actions, &action{
+				webhook: &monitorWebhook{
+					Resolver:       r,
+					WebhookAction:  w,
+					triggerEventID: triggerEventID,
+				},
+			})
+		}
+	case monitorActionSlackWebhookKind:
+		sws, err := r.store.ListSlackWebhookActions(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, sw := range sws {
+			actions = append(actions, &action{
+				slackWebhook: &monitorSlackWebhook{
+					Resolver:           r,
+					SlackWebhookAction: sw,
+					triggerEventID:     triggerEventID,
+				},
+			})
+		}
+	default:
+		return nil, errors.Errorf("unknown action kind %q", kind)
+	}
+	return actions, nil
+}
+
 //
 // MonitorTrigger <<UNION>>
 //
@@ -651,6 +914,10 @@ func (q *monitorQuery) Query() string {
 	return q.QueryString
 }
 
+func (q *monitorQuery) Schedule() *string {
+	return q.QueryTrigger.Schedule
+}
+
 func (q *monitorQuery) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorTriggerEventConnectionResolver, error) {
 	after, err := unmarshalAfter(args.After)
 	if err != nil {
@@ -767,24 +1034,43 @@ func (a *monitorActionConnection) PageInfo(ctx context.Context) (*graphqlutil.Pa
 	if len(a.actions) == 0 {
 		return graphqlutil.HasNextPage(false), nil
 	}
-	last := a.actions[len(a.actions)-1]
-	if email, ok := last.ToMonitorEmail(); ok {
-		return graphqlutil.NextPageCursor(string(email.ID())), nil
-	}
-	return nil, errors.Errorf("we only support email actions for now")
+	return graphqlutil.NextPageCursor(string(a.actions[len(a.actions)-1].ID())), nil
 }
 
 //
 // Action <<UNION>>
 //
 type action struct {
-	email graphqlbackend.MonitorEmailResolver
+	email        graphqlbackend.MonitorEmailResolver
+	webhook      graphqlbackend.MonitorWebhookResolver
+	slackWebhook graphqlbackend.MonitorSlackWebhookResolver
+}
+
+func (a *action) ID() graphql.ID {
+	switch {
+	case a.email != nil:
+		return a.email.ID()
+	case a.webhook != nil:
+		return a.webhook.ID()
+	case a.slackWebhook != nil:
+		return a.slackWebhook.ID()
+	default:
+		panic("action must have a type")
+	}
 }
 
 func (a *action) ToMonitorEmail() (graphqlbackend.MonitorEmailResolver, bool) {
 	return a.email, a.email != nil
 }
 
+func (a *action) ToMonitorWebhook() (graphqlbackend.MonitorWebhookResolver, bool) {
+	return a.webhook, a.webhook != nil
+}
+
+func (a *action) ToMonitorSlackWebhook() (graphqlbackend.MonitorSlackWebhookResolver, bool) {
+	return a.slackWebhook, a.slackWebhook != nil
+}
+
 //
 // Email
 //
@@ -888,6 +1174,96 @@ func (m *monitorEmail) Events(ctx context.Context, args *graphqlbackend.ListEven
 	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
 }
 
+//
+// Webhook
+//
+type monitorWebhook struct {
+	*Resolver
+	*cm.WebhookAction
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int32
+}
+
+func (m *monitorWebhook) ID() graphql.ID {
+	return relay.MarshalID(monitorActionWebhookKind, m.WebhookAction.ID)
+}
+
+func (m *monitorWebhook) Enabled() bool {
+	return m.WebhookAction.Enabled
+}
+
+func (m *monitorWebhook) URL() string {
+	return m.WebhookAction.URL
+}
+
+func (m *monitorWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	return m.actionEvents(ctx, cm.ListActionJobsOpts{
+		WebhookID:      intPtr(int(m.WebhookAction.ID)),
+		TriggerEventID: m.triggerEventID,
+	}, args)
+}
+
+//
+// SlackWebhook
+//
+type monitorSlackWebhook struct {
+	*Resolver
+	*cm.SlackWebhookAction
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int32
+}
+
+func (m *monitorSlackWebhook) ID() graphql.ID {
+	return relay.MarshalID(monitorActionSlackWebhookKind, m.SlackWebhookAction.ID)
+}
+
+func (m *monitorSlackWebhook) Enabled() bool {
+	return m.SlackWebhookAction.Enabled
+}
+
+func (m *monitorSlackWebhook) URL() string {
+	return m.SlackWebhookAction.URL
+}
+
+func (m *monitorSlackWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	return m.actionEvents(ctx, cm.ListActionJobsOpts{
+		SlackWebhookID: intPtr(int(m.SlackWebhookAction.ID)),
+		TriggerEventID: m.triggerEventID,
+	}, args)
+}
+
+// actionEvents returns a page of the action jobs matching opts.
+func (r *Resolver) actionEvents(ctx context.Context, opts cm.ListActionJobsOpts, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	after, err := unmarshalAfter(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := r.store.CountActionJobs(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.First = intPtr(int(args.First))
+	opts.After = after
+	ajs, err := r.store.ListActionJobs(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]graphqlbackend.MonitorActionEventResolver, len(ajs))
+	for i, aj := range ajs {
+		events[i] = &monitorActionEvent{Resolver: r, ActionJob: aj}
+	}
+	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
+}
+
 func intPtr(i int) *int { return &i }
 func intPtrToInt64Ptr(i *int) *int64 {
 	if i == nil {
@@ -1176,3 +1176,27 @@ func TestMonitorKindEqualsResolvers(t *testing.T) {
 		t.Fatal("email.MonitorKind should match resolvers.MonitorKind")
 	}
 }
+
+func TestSplitActionIDsRejectsMismatchedKind(t *testing.T) {
+	emailID := relay.MarshalID(monitorActionEmailKind, 1)
+	webhookID := relay.MarshalID(monitorActionWebhookKind, 1)
+
+	// The email action of the monitor must not be usable to address the
+	// webhook action with the same integer ID, which may belong to a
+	// different monitor.
+	args := &graphqlbackend.UpdateCodeMonitorArgs{
+		Actions: []*graphqlbackend.EditActionArgs{{
+			Webhook: &graphqlbackend.EditActionWebhookArgs{
+				Id:     &emailID,
+				Update: &graphqlbackend.CreateActionWebhookArgs{URL: "https://example.com"},
+			},
+		}},
+	}
+	_, _, err := splitActionIDs(context.Background(), args, []graphql.ID{emailID})
+	require.Error(t, err)
+
+	args.Actions[0].Webhook.Id = &webhookID
+	_, toDelete, err := splitActionIDs(context.Background(), args, []graphql.ID{emailID, webhookID})
+	require.NoError(t, err)
+	require.Equal(t, []graphql.ID{emailID}, toDelete)
+}
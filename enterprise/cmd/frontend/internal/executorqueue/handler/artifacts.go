@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gorilla/mux"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+)
+
+// NewArtifactHandler returns a handler that serves the content of the artifacts uploaded
+// by executors for the jobs of the given queues. Artifacts are only served to users that
+// can view the record of the job, as determined by the queue's RecordAccessChecker.
+//
+// GET /{queueName}/{jobId}/{name}
+func NewArtifactHandler(executorStore database.ExecutorStore, artifactStore uploadstore.Store, queueOptions []QueueOptions) http.Handler {
+	accessCheckers := make(map[string]func(ctx context.Context, id int) (bool, error), len(queueOptions))
+	for _, options := range queueOptions {
+		if options.RecordAccessChecker != nil {
+			accessCheckers[options.Name] = options.RecordAccessChecker
+		}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		jobID, err := strconv.Atoi(vars["jobId"])
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid jobId: %s", err.Error()), http.StatusBadRequest)
+			return
+		}
+
+		rc, err := openArtifact(r.Context(), executorStore, artifactStore, accessCheckers[vars["queueName"]], vars["queueName"], jobID, vars["name"])
+		if err != nil {
+			if err == ErrUnknownArtifact {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+
+			log15.Error("Failed to open executor artifact", "err", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer rc.Close()
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", vars["name"]))
+		if _, err := io.Copy(w, rc); err != nil {
+			log15.Error("Failed to write executor artifact", "err", err)
+		}
+	})
+}
+
+// ErrUnknownArtifact occurs when the artifact does not exist or the current user cannot
+// view the record of its job. Both cases are indistinguishable for the user, so that
+// the existence of records cannot be probed.
+var ErrUnknownArtifact = errors.New("unknown artifact")
+
+// openArtifact returns the content of the artifact with the given name of the given job,
+// if the given access checker allows the current user to view the job's record.
+func openArtifact(
+	ctx context.Context,
+	executorStore database.ExecutorStore,
+	artifactStore uploadstore.Store,
+	accessChecker func(ctx context.Context, id int) (bool, error),
+	queueName string,
+	jobID int,
+	name string,
+) (io.ReadCloser, error) {
+	// 🚨 SECURITY: Artifacts contain outputs of jobs, so only users that can view the
+	// record of the job may download them.
+	if accessChecker == nil {
+		return nil, ErrUnknownArtifact
+	}
+	if ok, err := accessChecker(ctx, jobID); err != nil {
+		return nil, errors.Wrap(err, "RecordAccessChecker")
+	} else if !ok {
+		return nil, ErrUnknownArtifact
+	}
+
+	artifacts, err := executorStore.ListArtifacts(ctx, queueName, jobID)
+	if err != nil {
+		return nil, errors.Wrap(err, "executorStore.ListArtifacts")
+	}
+	for _, artifact := range artifacts {
+		if artifact.Name == name {
+			rc, err := artifactStore.Get(ctx, artifact.ObjectKey)
+			return rc, errors.Wrap(err, "uploadstore.Get")
+		}
+	}
+
+	return nil, ErrUnknownArtifact
+}
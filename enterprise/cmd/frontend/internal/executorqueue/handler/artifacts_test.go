@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	uploadstoremocks "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore/mocks"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestArtifactHandler(t *testing.T) {
+	executorStore := NewMockExecutorStore()
+	executorStore.ListArtifactsFunc.SetDefaultReturn([]types.ExecutorArtifact{
+		{QueueName: "codeintel", JobID: 42, Name: "report.xml", ObjectKey: "executor-artifacts/codeintel/42/report.xml"},
+	}, nil)
+	artifactStore := uploadstoremocks.NewMockStore()
+	artifactStore.GetFunc.SetDefaultHook(func(ctx context.Context, key string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("contents of " + key)), nil
+	})
+
+	queueOptions := []QueueOptions{
+		{
+			Name: "codeintel",
+			RecordAccessChecker: func(ctx context.Context, id int) (bool, error) {
+				return id == 42, nil
+			},
+		},
+		// Artifacts of queues without an access checker are never served.
+		{Name: "batches"},
+	}
+
+	router := mux.NewRouter()
+	router.Path("/{queueName}/{jobId:[0-9]+}/{name}").Handler(NewArtifactHandler(executorStore, artifactStore, queueOptions))
+
+	for _, tc := range []struct {
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{"/codeintel/42/report.xml", http.StatusOK, "contents of executor-artifacts/codeintel/42/report.xml"},
+		{"/codeintel/42/missing.xml", http.StatusNotFound, ""},
+		{"/codeintel/43/report.xml", http.StatusNotFound, ""},
+		{"/batches/42/report.xml", http.StatusNotFound, ""},
+		{"/unknown/42/report.xml", http.StatusNotFound, ""},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest("GET", tc.path, nil))
+
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("unexpected status. want=%d have=%d", tc.wantStatus, rec.Code)
+			}
+			if tc.wantBody != "" && rec.Body.String() != tc.wantBody {
+				t.Errorf("unexpected body. want=%q have=%q", tc.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
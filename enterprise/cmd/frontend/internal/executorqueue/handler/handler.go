@@ -3,12 +3,15 @@ package handler
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/cockroachdb/errors"
 	"github.com/inconshreveable/log15"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore"
 	apiclient "github.com/sourcegraph/sourcegraph/enterprise/internal/executor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
@@ -17,6 +20,7 @@ import (
 type handler struct {
 	QueueOptions
 	executorStore database.ExecutorStore
+	artifactStore uploadstore.Store
 }
 
 type QueueOptions struct {
@@ -34,16 +38,25 @@ type QueueOptions struct {
 	// If it is set, it will be invoked periodically and should return the IDs to be
 	// canceled for the given executor.
 	CanceledRecordsFetcher func(ctx context.Context, executorName string) (canceledIDs []int, err error)
+
+	// RecordAccessChecker is an optional hook that returns whether the current user can view
+	// the record with the given identifier. Artifacts of the queue's jobs can only be
+	// downloaded if it is set and returns true.
+	RecordAccessChecker func(ctx context.Context, id int) (bool, error)
 }
 
-func newHandler(executorStore database.ExecutorStore, queueOptions QueueOptions) *handler {
+func newHandler(executorStore database.ExecutorStore, artifactStore uploadstore.Store, queueOptions QueueOptions) *handler {
 	return &handler{
 		executorStore: executorStore,
+		artifactStore: artifactStore,
 		QueueOptions:  queueOptions,
 	}
 }
 
-var ErrUnknownJob = errors.New("unknown job")
+var (
+	ErrUnknownJob          = errors.New("unknown job")
+	ErrInvalidArtifactName = errors.New("invalid artifact name")
+)
 
 // dequeue selects a job record from the database and stashes metadata including
 // the job record and the locking transaction. If no job is available for processing,
@@ -179,3 +192,42 @@ func (h *handler) canceled(ctx context.Context, executorName string) (knownIDs [
 	knownIDs, err = h.CanceledRecordsFetcher(ctx, executorName)
 	return knownIDs, errors.Wrap(err, "CanceledRecordsFetcher")
 }
+
+var artifactNamePattern = lazyregexp.New(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// uploadArtifact writes the content of the given reader to the artifact store and links the
+// resulting object to the given job.
+func (h *handler) uploadArtifact(ctx context.Context, executorName string, jobID int, name string, r io.Reader) error {
+	if !artifactNamePattern.MatchString(name) {
+		return ErrInvalidArtifactName
+	}
+
+	knownIDs, err := h.Store.Heartbeat(ctx, []int{jobID}, store.HeartbeatOptions{
+		// We pass the WorkerHostname, so the store enforces the record to be owned by this executor. This
+		// ensures that only the executor currently processing the job can attach artifacts to it.
+		WorkerHostname: executorName,
+	})
+	if err != nil {
+		return errors.Wrap(err, "dbworkerstore.Heartbeat")
+	}
+	if len(knownIDs) == 0 {
+		return ErrUnknownJob
+	}
+
+	// Uploading an artifact of a retried job with the same name replaces the previous one.
+	objectKey := fmt.Sprintf("executor-artifacts/%s/%d/%s", h.Name, jobID, name)
+
+	size, err := h.artifactStore.Upload(ctx, objectKey, r)
+	if err != nil {
+		return errors.Wrap(err, "uploadstore.Upload")
+	}
+
+	err = h.executorStore.UpsertArtifact(ctx, types.ExecutorArtifact{
+		QueueName: h.Name,
+		JobID:     jobID,
+		Name:      name,
+		ObjectKey: objectKey,
+		Size:      size,
+	})
+	return errors.Wrap(err, "executorStore.UpsertArtifact")
+}
@@ -2,11 +2,14 @@ package handler
 
 import (
 	"context"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/cockroachdb/errors"
 	"github.com/google/go-cmp/cmp"
 
+	uploadstoremocks "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore/mocks"
 	apiclient "github.com/sourcegraph/sourcegraph/enterprise/internal/executor"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
@@ -40,7 +43,7 @@ func TestDequeue(t *testing.T) {
 
 	executorStore := NewMockExecutorStore()
 
-	handler := newHandler(executorStore, QueueOptions{Store: store, RecordTransformer: recordTransformer})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store, RecordTransformer: recordTransformer})
 
 	job, dequeued, err := handler.dequeue(context.Background(), "deadbeef")
 	if err != nil {
@@ -58,7 +61,7 @@ func TestDequeue(t *testing.T) {
 }
 
 func TestDequeueNoRecord(t *testing.T) {
-	handler := newHandler(NewMockExecutorStore(), QueueOptions{Store: workerstoremocks.NewMockStore()})
+	handler := newHandler(NewMockExecutorStore(), nil, QueueOptions{Store: workerstoremocks.NewMockStore()})
 
 	_, dequeued, err := handler.dequeue(context.Background(), "deadbeef")
 	if err != nil {
@@ -80,7 +83,7 @@ func TestAddExecutionLogEntry(t *testing.T) {
 
 	executorStore := NewMockExecutorStore()
 
-	handler := newHandler(executorStore, QueueOptions{Store: store, RecordTransformer: recordTransformer})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store, RecordTransformer: recordTransformer})
 
 	job, dequeued, err := handler.dequeue(context.Background(), "deadbeef")
 	if err != nil {
@@ -118,7 +121,7 @@ func TestAddExecutionLogEntryUnknownJob(t *testing.T) {
 	store := workerstoremocks.NewMockStore()
 	store.AddExecutionLogEntryFunc.SetDefaultReturn(0, workerstore.ErrExecutionLogEntryNotUpdated)
 	executorStore := NewMockExecutorStore()
-	handler := newHandler(executorStore, QueueOptions{Store: store})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store})
 
 	entry := workerutil.ExecutionLogEntry{
 		Command: []string{"ls", "-a"},
@@ -138,7 +141,7 @@ func TestUpdateExecutionLogEntry(t *testing.T) {
 
 	executorStore := NewMockExecutorStore()
 
-	handler := newHandler(executorStore, QueueOptions{Store: store, RecordTransformer: recordTransformer})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store, RecordTransformer: recordTransformer})
 
 	job, dequeued, err := handler.dequeue(context.Background(), "deadbeef")
 	if err != nil {
@@ -176,7 +179,7 @@ func TestUpdateExecutionLogEntryUnknownJob(t *testing.T) {
 	store := workerstoremocks.NewMockStore()
 	store.UpdateExecutionLogEntryFunc.SetDefaultReturn(workerstore.ErrExecutionLogEntryNotUpdated)
 	executorStore := NewMockExecutorStore()
-	handler := newHandler(executorStore, QueueOptions{Store: store})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store})
 
 	entry := workerutil.ExecutionLogEntry{
 		Command: []string{"ls", "-a"},
@@ -197,7 +200,7 @@ func TestMarkComplete(t *testing.T) {
 
 	executorStore := NewMockExecutorStore()
 
-	handler := newHandler(executorStore, QueueOptions{Store: store, RecordTransformer: recordTransformer})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store, RecordTransformer: recordTransformer})
 
 	job, dequeued, err := handler.dequeue(context.Background(), "deadbeef")
 	if err != nil {
@@ -224,7 +227,7 @@ func TestMarkCompleteUnknownJob(t *testing.T) {
 	store := workerstoremocks.NewMockStore()
 	store.MarkCompleteFunc.SetDefaultReturn(false, nil)
 	executorStore := NewMockExecutorStore()
-	handler := newHandler(executorStore, QueueOptions{Store: store})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store})
 
 	if err := handler.markComplete(context.Background(), "deadbeef", 42); err != ErrUnknownJob {
 		t.Fatalf("unexpected error. want=%q have=%q", ErrUnknownJob, err)
@@ -236,7 +239,7 @@ func TestMarkCompleteStoreError(t *testing.T) {
 	internalErr := errors.New("something went wrong")
 	store.MarkCompleteFunc.SetDefaultReturn(false, internalErr)
 	executorStore := NewMockExecutorStore()
-	handler := newHandler(executorStore, QueueOptions{Store: store})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store})
 
 	if err := handler.markComplete(context.Background(), "deadbeef", 42); err == nil || errors.UnwrapAll(err).Error() != internalErr.Error() {
 		t.Fatalf("unexpected error. want=%q have=%q", internalErr, errors.UnwrapAll(err))
@@ -253,7 +256,7 @@ func TestMarkErrored(t *testing.T) {
 
 	executorStore := NewMockExecutorStore()
 
-	handler := newHandler(executorStore, QueueOptions{Store: store, RecordTransformer: recordTransformer})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store, RecordTransformer: recordTransformer})
 
 	job, dequeued, err := handler.dequeue(context.Background(), "deadbeef")
 	if err != nil {
@@ -283,7 +286,7 @@ func TestMarkErroredUnknownJob(t *testing.T) {
 	store := workerstoremocks.NewMockStore()
 	store.MarkErroredFunc.SetDefaultReturn(false, nil)
 	executorStore := NewMockExecutorStore()
-	handler := newHandler(executorStore, QueueOptions{Store: store})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store})
 
 	if err := handler.markErrored(context.Background(), "deadbeef", 42, "OH NO"); err != ErrUnknownJob {
 		t.Fatalf("unexpected error. want=%q have=%q", ErrUnknownJob, err)
@@ -295,7 +298,7 @@ func TestMarkErroredStoreError(t *testing.T) {
 	storeErr := errors.New("something went wrong")
 	store.MarkErroredFunc.SetDefaultReturn(false, storeErr)
 	executorStore := NewMockExecutorStore()
-	handler := newHandler(executorStore, QueueOptions{Store: store})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store})
 
 	if err := handler.markErrored(context.Background(), "deadbeef", 42, "OH NO"); err == nil || errors.UnwrapAll(err).Error() != storeErr.Error() {
 		t.Fatalf("unexpected error. want=%q have=%q", storeErr, errors.UnwrapAll(err))
@@ -312,7 +315,7 @@ func TestMarkFailed(t *testing.T) {
 
 	executorStore := NewMockExecutorStore()
 
-	handler := newHandler(executorStore, QueueOptions{Store: store, RecordTransformer: recordTransformer})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store, RecordTransformer: recordTransformer})
 
 	job, dequeued, err := handler.dequeue(context.Background(), "deadbeef")
 	if err != nil {
@@ -342,7 +345,7 @@ func TestMarkFailedUnknownJob(t *testing.T) {
 	store := workerstoremocks.NewMockStore()
 	store.MarkFailedFunc.SetDefaultReturn(false, nil)
 	executorStore := NewMockExecutorStore()
-	handler := newHandler(executorStore, QueueOptions{Store: store})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store})
 
 	if err := handler.markFailed(context.Background(), "deadbeef", 42, "OH NO"); err != ErrUnknownJob {
 		t.Fatalf("unexpected error. want=%q have=%q", ErrUnknownJob, err)
@@ -354,7 +357,7 @@ func TestMarkFailedStoreError(t *testing.T) {
 	storeErr := errors.New("something went wrong")
 	store.MarkFailedFunc.SetDefaultReturn(false, storeErr)
 	executorStore := NewMockExecutorStore()
-	handler := newHandler(executorStore, QueueOptions{Store: store})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: store})
 
 	if err := handler.markFailed(context.Background(), "deadbeef", 42, "OH NO"); err == nil || errors.UnwrapAll(err).Error() != storeErr.Error() {
 		t.Fatalf("unexpected error. want=%q have=%q", storeErr, errors.UnwrapAll(err))
@@ -385,7 +388,7 @@ func TestHeartbeat(t *testing.T) {
 		SrcCliVersion:   "test-src-cli-version",
 	}
 
-	handler := newHandler(executorStore, QueueOptions{Store: s, RecordTransformer: recordTransformer})
+	handler := newHandler(executorStore, nil, QueueOptions{Store: s, RecordTransformer: recordTransformer})
 
 	if knownIDs, err := handler.heartbeat(context.Background(), executor, []int{testKnownID, 10}); err != nil {
 		t.Fatalf("unexpected error performing heartbeat: %s", err)
@@ -400,6 +403,75 @@ func TestHeartbeat(t *testing.T) {
 	}
 }
 
+func TestUploadArtifact(t *testing.T) {
+	s := workerstoremocks.NewMockStore()
+	s.HeartbeatFunc.SetDefaultReturn([]int{42}, nil)
+	artifactStore := uploadstoremocks.NewMockStore()
+	artifactStore.UploadFunc.SetDefaultHook(func(ctx context.Context, key string, r io.Reader) (int64, error) {
+		return io.Copy(io.Discard, r)
+	})
+	executorStore := NewMockExecutorStore()
+
+	handler := newHandler(executorStore, artifactStore, QueueOptions{Name: "codeintel", Store: s})
+
+	if err := handler.uploadArtifact(context.Background(), "deadbeef", 42, "report.xml", strings.NewReader("<xml/>")); err != nil {
+		t.Fatalf("unexpected error uploading artifact: %s", err)
+	}
+
+	if callCount := len(s.HeartbeatFunc.History()); callCount != 1 {
+		t.Fatalf("unexpected heartbeat count. want=%d have=%d", 1, callCount)
+	} else if options := s.HeartbeatFunc.History()[0].Arg2; options.WorkerHostname != "deadbeef" {
+		t.Errorf("unexpected worker hostname. want=%q have=%q", "deadbeef", options.WorkerHostname)
+	}
+
+	if callCount := len(artifactStore.UploadFunc.History()); callCount != 1 {
+		t.Fatalf("unexpected upload count. want=%d have=%d", 1, callCount)
+	} else if key := artifactStore.UploadFunc.History()[0].Arg1; key != "executor-artifacts/codeintel/42/report.xml" {
+		t.Errorf("unexpected object key. want=%q have=%q", "executor-artifacts/codeintel/42/report.xml", key)
+	}
+
+	expectedArtifact := types.ExecutorArtifact{
+		QueueName: "codeintel",
+		JobID:     42,
+		Name:      "report.xml",
+		ObjectKey: "executor-artifacts/codeintel/42/report.xml",
+		Size:      6,
+	}
+	if callCount := len(executorStore.UpsertArtifactFunc.History()); callCount != 1 {
+		t.Fatalf("unexpected artifact upsert count. want=%d have=%d", 1, callCount)
+	} else if diff := cmp.Diff(expectedArtifact, executorStore.UpsertArtifactFunc.History()[0].Arg1); diff != "" {
+		t.Errorf("unexpected artifact (-want +got):\n%s", diff)
+	}
+}
+
+func TestUploadArtifactUnknownJob(t *testing.T) {
+	s := workerstoremocks.NewMockStore()
+	s.HeartbeatFunc.SetDefaultReturn([]int{}, nil)
+	artifactStore := uploadstoremocks.NewMockStore()
+	executorStore := NewMockExecutorStore()
+
+	handler := newHandler(executorStore, artifactStore, QueueOptions{Name: "codeintel", Store: s})
+
+	if err := handler.uploadArtifact(context.Background(), "deadbeef", 42, "report.xml", strings.NewReader("<xml/>")); err != ErrUnknownJob {
+		t.Fatalf("unexpected error. want=%q have=%q", ErrUnknownJob, err)
+	}
+	if callCount := len(artifactStore.UploadFunc.History()); callCount != 0 {
+		t.Errorf("unexpected upload count. want=%d have=%d", 0, callCount)
+	}
+}
+
+func TestUploadArtifactInvalidName(t *testing.T) {
+	s := workerstoremocks.NewMockStore()
+	s.HeartbeatFunc.SetDefaultReturn([]int{42}, nil)
+	handler := newHandler(NewMockExecutorStore(), uploadstoremocks.NewMockStore(), QueueOptions{Name: "codeintel", Store: s})
+
+	for _, name := range []string{"", "../report.xml", "reports/junit.xml", ".hidden"} {
+		if err := handler.uploadArtifact(context.Background(), "deadbeef", 42, name, strings.NewReader("")); err != ErrInvalidArtifactName {
+			t.Errorf("unexpected error for name %q. want=%q have=%q", name, ErrInvalidArtifactName, err)
+		}
+	}
+}
+
 type testRecord struct {
 	ID      int
 	Payload string
@@ -16,6 +16,9 @@ import (
 // (from the package github.com/sourcegraph/sourcegraph/internal/database)
 // used for unit testing.
 type MockExecutorStore struct {
+	// DeleteExpiredArtifactsFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteExpiredArtifacts.
+	DeleteExpiredArtifactsFunc *ExecutorStoreDeleteExpiredArtifactsFunc
 	// DeleteInactiveHeartbeatsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteInactiveHeartbeats.
 	DeleteInactiveHeartbeatsFunc *ExecutorStoreDeleteInactiveHeartbeatsFunc
@@ -31,9 +34,15 @@ type MockExecutorStore struct {
 	// ListFunc is an instance of a mock function object controlling the
 	// behavior of the method List.
 	ListFunc *ExecutorStoreListFunc
+	// ListArtifactsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListArtifacts.
+	ListArtifactsFunc *ExecutorStoreListArtifactsFunc
 	// TransactFunc is an instance of a mock function object controlling the
 	// behavior of the method Transact.
 	TransactFunc *ExecutorStoreTransactFunc
+	// UpsertArtifactFunc is an instance of a mock function object
+	// controlling the behavior of the method UpsertArtifact.
+	UpsertArtifactFunc *ExecutorStoreUpsertArtifactFunc
 	// UpsertHeartbeatFunc is an instance of a mock function object
 	// controlling the behavior of the method UpsertHeartbeat.
 	UpsertHeartbeatFunc *ExecutorStoreUpsertHeartbeatFunc
@@ -46,6 +55,11 @@ type MockExecutorStore struct {
 // All methods return zero values for all results, unless overwritten.
 func NewMockExecutorStore() *MockExecutorStore {
 	return &MockExecutorStore{
+		DeleteExpiredArtifactsFunc: &ExecutorStoreDeleteExpiredArtifactsFunc{
+			defaultHook: func(context.Context, time.Duration) ([]string, error) {
+				return nil, nil
+			},
+		},
 		DeleteInactiveHeartbeatsFunc: &ExecutorStoreDeleteInactiveHeartbeatsFunc{
 			defaultHook: func(context.Context, time.Duration) error {
 				return nil
@@ -71,11 +85,21 @@ func NewMockExecutorStore() *MockExecutorStore {
 				return nil, 0, nil
 			},
 		},
+		ListArtifactsFunc: &ExecutorStoreListArtifactsFunc{
+			defaultHook: func(context.Context, string, int) ([]types.ExecutorArtifact, error) {
+				return nil, nil
+			},
+		},
 		TransactFunc: &ExecutorStoreTransactFunc{
 			defaultHook: func(context.Context) (database.ExecutorStore, error) {
 				return nil, nil
 			},
 		},
+		UpsertArtifactFunc: &ExecutorStoreUpsertArtifactFunc{
+			defaultHook: func(context.Context, types.ExecutorArtifact) error {
+				return nil
+			},
+		},
 		UpsertHeartbeatFunc: &ExecutorStoreUpsertHeartbeatFunc{
 			defaultHook: func(context.Context, types.Executor) error {
 				return nil
@@ -93,6 +117,11 @@ func NewMockExecutorStore() *MockExecutorStore {
 // interface. All methods panic on invocation, unless overwritten.
 func NewStrictMockExecutorStore() *MockExecutorStore {
 	return &MockExecutorStore{
+		DeleteExpiredArtifactsFunc: &ExecutorStoreDeleteExpiredArtifactsFunc{
+			defaultHook: func(context.Context, time.Duration) ([]string, error) {
+				panic("unexpected invocation of MockExecutorStore.DeleteExpiredArtifacts")
+			},
+		},
 		DeleteInactiveHeartbeatsFunc: &ExecutorStoreDeleteInactiveHeartbeatsFunc{
 			defaultHook: func(context.Context, time.Duration) error {
 				panic("unexpected invocation of MockExecutorStore.DeleteInactiveHeartbeats")
@@ -118,11 +147,21 @@ func NewStrictMockExecutorStore() *MockExecutorStore {
 				panic("unexpected invocation of MockExecutorStore.List")
 			},
 		},
+		ListArtifactsFunc: &ExecutorStoreListArtifactsFunc{
+			defaultHook: func(context.Context, string, int) ([]types.ExecutorArtifact, error) {
+				panic("unexpected invocation of MockExecutorStore.ListArtifacts")
+			},
+		},
 		TransactFunc: &ExecutorStoreTransactFunc{
 			defaultHook: func(context.Context) (database.ExecutorStore, error) {
 				panic("unexpected invocation of MockExecutorStore.Transact")
 			},
 		},
+		UpsertArtifactFunc: &ExecutorStoreUpsertArtifactFunc{
+			defaultHook: func(context.Context, types.ExecutorArtifact) error {
+				panic("unexpected invocation of MockExecutorStore.UpsertArtifact")
+			},
+		},
 		UpsertHeartbeatFunc: &ExecutorStoreUpsertHeartbeatFunc{
 			defaultHook: func(context.Context, types.Executor) error {
 				panic("unexpected invocation of MockExecutorStore.UpsertHeartbeat")
@@ -141,6 +180,9 @@ func NewStrictMockExecutorStore() *MockExecutorStore {
 // overwritten.
 func NewMockExecutorStoreFrom(i database.ExecutorStore) *MockExecutorStore {
 	return &MockExecutorStore{
+		DeleteExpiredArtifactsFunc: &ExecutorStoreDeleteExpiredArtifactsFunc{
+			defaultHook: i.DeleteExpiredArtifacts,
+		},
 		DeleteInactiveHeartbeatsFunc: &ExecutorStoreDeleteInactiveHeartbeatsFunc{
 			defaultHook: i.DeleteInactiveHeartbeats,
 		},
@@ -156,9 +198,15 @@ func NewMockExecutorStoreFrom(i database.ExecutorStore) *MockExecutorStore {
 		ListFunc: &ExecutorStoreListFunc{
 			defaultHook: i.List,
 		},
+		ListArtifactsFunc: &ExecutorStoreListArtifactsFunc{
+			defaultHook: i.ListArtifacts,
+		},
 		TransactFunc: &ExecutorStoreTransactFunc{
 			defaultHook: i.Transact,
 		},
+		UpsertArtifactFunc: &ExecutorStoreUpsertArtifactFunc{
+			defaultHook: i.UpsertArtifact,
+		},
 		UpsertHeartbeatFunc: &ExecutorStoreUpsertHeartbeatFunc{
 			defaultHook: i.UpsertHeartbeat,
 		},
@@ -168,6 +216,118 @@ func NewMockExecutorStoreFrom(i database.ExecutorStore) *MockExecutorStore {
 	}
 }
 
+// ExecutorStoreDeleteExpiredArtifactsFunc describes the behavior when the
+// DeleteExpiredArtifacts method of the parent MockExecutorStore instance is
+// invoked.
+type ExecutorStoreDeleteExpiredArtifactsFunc struct {
+	defaultHook func(context.Context, time.Duration) ([]string, error)
+	hooks       []func(context.Context, time.Duration) ([]string, error)
+	history     []ExecutorStoreDeleteExpiredArtifactsFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteExpiredArtifacts delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockExecutorStore) DeleteExpiredArtifacts(v0 context.Context, v1 time.Duration) ([]string, error) {
+	r0, r1 := m.DeleteExpiredArtifactsFunc.nextHook()(v0, v1)
+	m.DeleteExpiredArtifactsFunc.appendCall(ExecutorStoreDeleteExpiredArtifactsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteExpiredArtifacts method of the parent MockExecutorStore instance is
+// invoked and the hook queue is empty.
+func (f *ExecutorStoreDeleteExpiredArtifactsFunc) SetDefaultHook(hook func(context.Context, time.Duration) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteExpiredArtifacts method of the parent MockExecutorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *ExecutorStoreDeleteExpiredArtifactsFunc) PushHook(hook func(context.Context, time.Duration) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ExecutorStoreDeleteExpiredArtifactsFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ExecutorStoreDeleteExpiredArtifactsFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, time.Duration) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *ExecutorStoreDeleteExpiredArtifactsFunc) nextHook() func(context.Context, time.Duration) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ExecutorStoreDeleteExpiredArtifactsFunc) appendCall(r0 ExecutorStoreDeleteExpiredArtifactsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ExecutorStoreDeleteExpiredArtifactsFuncCall
+// objects describing the invocations of this function.
+func (f *ExecutorStoreDeleteExpiredArtifactsFunc) History() []ExecutorStoreDeleteExpiredArtifactsFuncCall {
+	f.mutex.Lock()
+	history := make([]ExecutorStoreDeleteExpiredArtifactsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ExecutorStoreDeleteExpiredArtifactsFuncCall is an object that describes
+// an invocation of method DeleteExpiredArtifacts on an instance of
+// MockExecutorStore.
+type ExecutorStoreDeleteExpiredArtifactsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Duration
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ExecutorStoreDeleteExpiredArtifactsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ExecutorStoreDeleteExpiredArtifactsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // ExecutorStoreDeleteInactiveHeartbeatsFunc describes the behavior when the
 // DeleteInactiveHeartbeats method of the parent MockExecutorStore instance
 // is invoked.
@@ -702,6 +862,118 @@ func (c ExecutorStoreListFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// ExecutorStoreListArtifactsFunc describes the behavior when the
+// ListArtifacts method of the parent MockExecutorStore instance is invoked.
+type ExecutorStoreListArtifactsFunc struct {
+	defaultHook func(context.Context, string, int) ([]types.ExecutorArtifact, error)
+	hooks       []func(context.Context, string, int) ([]types.ExecutorArtifact, error)
+	history     []ExecutorStoreListArtifactsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListArtifacts delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockExecutorStore) ListArtifacts(v0 context.Context, v1 string, v2 int) ([]types.ExecutorArtifact, error) {
+	r0, r1 := m.ListArtifactsFunc.nextHook()(v0, v1, v2)
+	m.ListArtifactsFunc.appendCall(ExecutorStoreListArtifactsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListArtifacts method
+// of the parent MockExecutorStore instance is invoked and the hook queue is
+// empty.
+func (f *ExecutorStoreListArtifactsFunc) SetDefaultHook(hook func(context.Context, string, int) ([]types.ExecutorArtifact, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListArtifacts method of the parent MockExecutorStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ExecutorStoreListArtifactsFunc) PushHook(hook func(context.Context, string, int) ([]types.ExecutorArtifact, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ExecutorStoreListArtifactsFunc) SetDefaultReturn(r0 []types.ExecutorArtifact, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, int) ([]types.ExecutorArtifact, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ExecutorStoreListArtifactsFunc) PushReturn(r0 []types.ExecutorArtifact, r1 error) {
+	f.PushHook(func(context.Context, string, int) ([]types.ExecutorArtifact, error) {
+		return r0, r1
+	})
+}
+
+func (f *ExecutorStoreListArtifactsFunc) nextHook() func(context.Context, string, int) ([]types.ExecutorArtifact, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ExecutorStoreListArtifactsFunc) appendCall(r0 ExecutorStoreListArtifactsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ExecutorStoreListArtifactsFuncCall objects
+// describing the invocations of this function.
+func (f *ExecutorStoreListArtifactsFunc) History() []ExecutorStoreListArtifactsFuncCall {
+	f.mutex.Lock()
+	history := make([]ExecutorStoreListArtifactsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ExecutorStoreListArtifactsFuncCall is an object that describes an
+// invocation of method ListArtifacts on an instance of MockExecutorStore.
+type ExecutorStoreListArtifactsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []types.ExecutorArtifact
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ExecutorStoreListArtifactsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ExecutorStoreListArtifactsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // ExecutorStoreTransactFunc describes the behavior when the Transact method
 // of the parent MockExecutorStore instance is invoked.
 type ExecutorStoreTransactFunc struct {
@@ -808,6 +1080,113 @@ func (c ExecutorStoreTransactFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ExecutorStoreUpsertArtifactFunc describes the behavior when the
+// UpsertArtifact method of the parent MockExecutorStore instance is
+// invoked.
+type ExecutorStoreUpsertArtifactFunc struct {
+	defaultHook func(context.Context, types.ExecutorArtifact) error
+	hooks       []func(context.Context, types.ExecutorArtifact) error
+	history     []ExecutorStoreUpsertArtifactFuncCall
+	mutex       sync.Mutex
+}
+
+// UpsertArtifact delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockExecutorStore) UpsertArtifact(v0 context.Context, v1 types.ExecutorArtifact) error {
+	r0 := m.UpsertArtifactFunc.nextHook()(v0, v1)
+	m.UpsertArtifactFunc.appendCall(ExecutorStoreUpsertArtifactFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the UpsertArtifact
+// method of the parent MockExecutorStore instance is invoked and the hook
+// queue is empty.
+func (f *ExecutorStoreUpsertArtifactFunc) SetDefaultHook(hook func(context.Context, types.ExecutorArtifact) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpsertArtifact method of the parent MockExecutorStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ExecutorStoreUpsertArtifactFunc) PushHook(hook func(context.Context, types.ExecutorArtifact) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ExecutorStoreUpsertArtifactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, types.ExecutorArtifact) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ExecutorStoreUpsertArtifactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, types.ExecutorArtifact) error {
+		return r0
+	})
+}
+
+func (f *ExecutorStoreUpsertArtifactFunc) nextHook() func(context.Context, types.ExecutorArtifact) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ExecutorStoreUpsertArtifactFunc) appendCall(r0 ExecutorStoreUpsertArtifactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ExecutorStoreUpsertArtifactFuncCall objects
+// describing the invocations of this function.
+func (f *ExecutorStoreUpsertArtifactFunc) History() []ExecutorStoreUpsertArtifactFuncCall {
+	f.mutex.Lock()
+	history := make([]ExecutorStoreUpsertArtifactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ExecutorStoreUpsertArtifactFuncCall is an object that describes an
+// invocation of method UpsertArtifact on an instance of MockExecutorStore.
+type ExecutorStoreUpsertArtifactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 types.ExecutorArtifact
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ExecutorStoreUpsertArtifactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ExecutorStoreUpsertArtifactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // ExecutorStoreUpsertHeartbeatFunc describes the behavior when the
 // UpsertHeartbeat method of the parent MockExecutorStore instance is
 // invoked.
@@ -7,20 +7,23 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/inconshreveable/log15"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore"
 	apiclient "github.com/sourcegraph/sourcegraph/enterprise/internal/executor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
 // SetupRoutes registers all route handlers required for all configured executor
-// queues with the given router.
-func SetupRoutes(executorStore database.ExecutorStore, queueOptionsMap []QueueOptions, router *mux.Router) {
+// queues with the given router. Artifacts uploaded by executors are written to the given
+// artifact store.
+func SetupRoutes(executorStore database.ExecutorStore, artifactStore uploadstore.Store, queueOptionsMap []QueueOptions, router *mux.Router) {
 	for _, queueOptions := range queueOptionsMap {
-		h := newHandler(executorStore, queueOptions)
+		h := newHandler(executorStore, artifactStore, queueOptions)
 
 		subRouter := router.PathPrefix(fmt.Sprintf("/{queueName:(?:%s)}/", regexp.QuoteMeta(queueOptions.Name))).Subrouter()
 		routes := map[string]func(w http.ResponseWriter, r *http.Request){
@@ -32,6 +35,7 @@ func SetupRoutes(executorStore database.ExecutorStore, queueOptionsMap []QueueOp
 			"markFailed":              h.handleMarkFailed,
 			"heartbeat":               h.handleHeartbeat,
 			"canceled":                h.handleCanceled,
+			"uploadArtifact":          h.handleUploadArtifact,
 		}
 		for path, handler := range routes {
 			subRouter.Path(fmt.Sprintf("/%s", path)).Methods("POST").HandlerFunc(handler)
@@ -147,6 +151,34 @@ func (h *handler) handleCanceled(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// POST /{queueName}/uploadArtifact?executorName={executorName}&jobId={jobId}&name={name}
+//
+// Unlike the other routes, the request body is the raw content of the artifact.
+func (h *handler) handleUploadArtifact(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	jobID, err := strconv.Atoi(q.Get("jobId"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid jobId: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	switch err := h.uploadArtifact(r.Context(), q.Get("executorName"), jobID, q.Get("name"), r.Body); err {
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+
+	case ErrInvalidArtifactName:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+	case ErrUnknownJob:
+		w.WriteHeader(http.StatusNotFound)
+
+	default:
+		log15.Error("Handler returned an error", "err", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
 type errorResponse struct {
 	Error string `json:"error"`
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/executorqueue/handler"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/executorqueue/queues/batches"
 	codeintelqueue "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/executorqueue/queues/codeintel"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore"
 	"github.com/sourcegraph/sourcegraph/internal/oobmigration"
 )
 
@@ -24,6 +25,7 @@ func Init(
 	enterpriseServices *enterprise.Services,
 	observationContext *observation.Context,
 	codeintelUploadHandler http.Handler,
+	artifactStore uploadstore.Store,
 ) error {
 	accessToken := func() string { return conf.SiteConfig().ExecutorsAccessToken }
 
@@ -35,11 +37,12 @@ func Init(
 		batches.QueueOptions(db, accessToken, observationContext),
 	}
 
-	queueHandler, err := newExecutorQueueHandler(db.Executors(), queueOptions, accessToken, codeintelUploadHandler)
+	queueHandler, err := newExecutorQueueHandler(db.Executors(), artifactStore, queueOptions, accessToken, codeintelUploadHandler)
 	if err != nil {
 		return err
 	}
 
 	enterpriseServices.NewExecutorProxyHandler = queueHandler
+	enterpriseServices.ExecutorArtifactHandler = handler.NewArtifactHandler(db.Executors(), artifactStore, queueOptions)
 	return nil
 }
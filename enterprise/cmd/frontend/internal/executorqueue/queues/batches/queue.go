@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/executorqueue/handler"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
@@ -20,11 +21,24 @@ func QueueOptions(db database.DB, accessToken func() string, observationContext
 		return transformRecord(ctx, batchesStore, record.(*btypes.BatchSpecWorkspaceExecutionJob), accessToken())
 	}
 
+	// Like batch spec workspaces, their executions are currently only visible to site
+	// admins.
+	recordAccessChecker := func(ctx context.Context, id int) (bool, error) {
+		if err := backend.CheckCurrentUserIsSiteAdmin(ctx, db); err != nil {
+			if err == backend.ErrMustBeSiteAdmin {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+
 	store := store.NewBatchSpecWorkspaceExecutionWorkerStore(basestore.NewHandleWithDB(db, sql.TxOptions{}), observationContext)
 	return handler.QueueOptions{
 		Name:                   "batches",
 		Store:                  store,
 		RecordTransformer:      recordTransformer,
 		CanceledRecordsFetcher: store.FetchCanceled,
+		RecordAccessChecker:    recordAccessChecker,
 	}
 }
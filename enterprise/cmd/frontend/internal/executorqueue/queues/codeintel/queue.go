@@ -18,9 +18,17 @@ func QueueOptions(db database.DB, accessToken func() string, observationContext
 		return transformRecord(record.(store.Index), accessToken())
 	}
 
+	// Indexes are only visible to users that can view their repository.
+	dbStore := store.NewWithDB(db, observationContext)
+	recordAccessChecker := func(ctx context.Context, id int) (bool, error) {
+		_, exists, err := dbStore.GetIndexByID(ctx, id)
+		return exists, err
+	}
+
 	return handler.QueueOptions{
-		Name:              "codeintel",
-		Store:             store.WorkerutilIndexStore(basestore.NewWithDB(db, sql.TxOptions{}), observationContext),
-		RecordTransformer: recordTransformer,
+		Name:                "codeintel",
+		Store:               store.WorkerutilIndexStore(basestore.NewWithDB(db, sql.TxOptions{}), observationContext),
+		RecordTransformer:   recordTransformer,
+		RecordAccessChecker: recordAccessChecker,
 	}
 }
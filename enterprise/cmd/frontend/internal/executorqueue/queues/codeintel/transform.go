@@ -3,6 +3,7 @@ package codeintel
 import (
 	"fmt"
 	"net/url"
+	"path"
 	"strconv"
 	"strings"
 
@@ -57,10 +58,11 @@ func transformRecord(index store.Index, accessToken string) (apiclient.Job, erro
 	}
 
 	return apiclient.Job{
-		ID:             index.ID,
-		Commit:         index.Commit,
-		RepositoryName: index.RepositoryName,
-		DockerSteps:    dockerSteps,
+		ID:               index.ID,
+		Commit:           index.Commit,
+		RepositoryName:   index.RepositoryName,
+		DockerSteps:      dockerSteps,
+		CacheDirectories: cacheDirectories(index.DockerSteps),
 		CliSteps: []apiclient.CliStep{
 			{
 				Commands: []string{
@@ -94,6 +96,47 @@ func transformRecord(index store.Index, accessToken string) (apiclient.Job, erro
 	}, nil
 }
 
+// nodePackageManagers are the commands that install the Node dependencies of the
+// package in the current directory.
+var nodePackageManagers = map[string]bool{"npm": true, "yarn": true}
+
+// cacheDirectories returns the directories of the workspace that executors cache between
+// index jobs of the same repository. These are the node_modules directories populated by
+// the docker steps that install Node dependencies, so that subsequent installs only have
+// to fetch what changed. The lock files of the package are part of the cache key, so
+// that dependency changes don't restore a stale node_modules directory.
+func cacheDirectories(dockerSteps []store.DockerStep) []apiclient.CacheDirectory {
+	var directories []apiclient.CacheDirectory
+	seen := map[string]struct{}{}
+	for _, dockerStep := range dockerSteps {
+		for _, command := range dockerStep.Commands {
+			fields := strings.Fields(command)
+			if len(fields) == 0 || !nodePackageManagers[fields[0]] {
+				continue
+			}
+
+			dir := path.Join(dockerStep.Root, "node_modules")
+			if _, ok := seen[dir]; !ok {
+				seen[dir] = struct{}{}
+				directories = append(directories, apiclient.CacheDirectory{
+					Key:      "node_modules:" + dir,
+					Path:     dir,
+					KeyFiles: nodeLockFiles(dockerStep.Root),
+				})
+			}
+			break
+		}
+	}
+
+	return directories
+}
+
+// nodeLockFiles returns the paths of the lock files of the Node package in the given
+// directory.
+func nodeLockFiles(dir string) []string {
+	return []string{path.Join(dir, "package-lock.json"), path.Join(dir, "yarn.lock")}
+}
+
 func makeURL(base, password string) (string, error) {
 	u, err := url.Parse(base)
 	if err != nil {
@@ -55,6 +55,9 @@ func TestTransformRecord(t *testing.T) {
 				Dir:      "web",
 			},
 		},
+		CacheDirectories: []apiclient.CacheDirectory{
+			{Key: "node_modules:web/node_modules", Path: "web/node_modules", KeyFiles: []string{"web/package-lock.json", "web/yarn.lock"}},
+		},
 		CliSteps: []apiclient.CliStep{
 			{
 				Commands: []string{
@@ -130,6 +133,9 @@ func TestTransformRecordWithoutIndexer(t *testing.T) {
 				Dir:      "web",
 			},
 		},
+		CacheDirectories: []apiclient.CacheDirectory{
+			{Key: "node_modules:web/node_modules", Path: "web/node_modules", KeyFiles: []string{"web/package-lock.json", "web/yarn.lock"}},
+		},
 		CliSteps: []apiclient.CliStep{
 			{
 				Commands: []string{
@@ -155,3 +161,20 @@ func TestTransformRecordWithoutIndexer(t *testing.T) {
 		t.Errorf("unexpected job (-want +got):\n%s", diff)
 	}
 }
+
+func TestCacheDirectories(t *testing.T) {
+	dockerSteps := []store.DockerStep{
+		{Image: "alpine", Commands: []string{"apk add git"}},
+		{Image: "node", Commands: []string{"N_NODE_MIRROR=x n auto", "npm install"}},
+		{Image: "node", Commands: []string{"yarn --ignore-engines"}, Root: "web"},
+		{Image: "node", Commands: []string{"yarn build"}, Root: "web"},
+	}
+
+	expected := []apiclient.CacheDirectory{
+		{Key: "node_modules:node_modules", Path: "node_modules", KeyFiles: []string{"package-lock.json", "yarn.lock"}},
+		{Key: "node_modules:web/node_modules", Path: "web/node_modules", KeyFiles: []string{"web/package-lock.json", "web/yarn.lock"}},
+	}
+	if diff := cmp.Diff(expected, cacheDirectories(dockerSteps)); diff != "" {
+		t.Errorf("unexpected cache directories (-want +got):\n%s", diff)
+	}
+}
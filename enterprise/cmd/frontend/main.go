@@ -85,7 +85,7 @@ func enterpriseSetupHook(db database.DB, conf conftypes.UnifiedWatchable, outOfB
 	}
 
 	// Initialize enterprise-specific services with the code-intel services.
-	if err := executor.Init(ctx, db, conf, outOfBandMigrationRunner, &enterpriseServices, observationContext, services.InternalUploadHandler); err != nil {
+	if err := executor.Init(ctx, db, conf, outOfBandMigrationRunner, &enterpriseServices, observationContext, services.InternalUploadHandler, services.ArtifactStore); err != nil {
 		log.Fatal(fmt.Sprintf("failed to initialize executor: %s", err))
 	}
 
@@ -22,6 +22,8 @@ import (
 
 var schemeToExternalService = map[string]string{
 	"semanticdb": extsvc.KindJVMPackages,
+	"npm":        extsvc.KindNPMPackages,
+	"gomod":      extsvc.KindGoModules,
 }
 
 // NewDependencySyncScheduler returns a new worker instance that processes
@@ -101,6 +103,12 @@ func (h *dependencySyncSchedulerHandler) Handle(ctx context.Context, record work
 		if !ok {
 			continue
 		}
+		if packageReference.Scheme == "gomod" {
+			// Go modules hosted on GitHub resolve to their GitHub repositories,
+			// which are looked up by the dependency indexing job without an
+			// external service kind.
+			kinds[""] = struct{}{}
+		}
 
 		new, err := h.insertDependencyRepo(ctx, pkg)
 		if err != nil {
@@ -184,14 +192,19 @@ func (h *dependencySyncSchedulerHandler) insertDependencyRepo(ctx context.Contex
 
 // shouldIndexDependencies returns true if the given upload should undergo dependency
 // indexing. Currently, we're only enabling dependency indexing for a repositories that
-// were indexed via lsif-go and lsif-java.
+// were indexed via lsif-go, lsif-java, lsif-tsc and lsif-node.
 func (h *dependencySyncSchedulerHandler) shouldIndexDependencies(ctx context.Context, store DBStore, uploadID int) (bool, error) {
 	upload, _, err := store.GetUploadByID(ctx, uploadID)
 	if err != nil {
 		return false, errors.Wrap(err, "dbstore.GetUploadByID")
 	}
 
-	return upload.Indexer == "lsif-go" || upload.Indexer == "lsif-java", nil
+	switch upload.Indexer {
+	case "lsif-go", "lsif-java", "lsif-tsc", "lsif-node":
+		return true, nil
+	default:
+		return false, nil
+	}
 }
 
 func kindsToArray(k map[string]struct{}) (s []string) {
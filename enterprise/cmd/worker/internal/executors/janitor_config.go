@@ -3,6 +3,7 @@ package executors
 import (
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 )
 
@@ -11,11 +12,16 @@ type janitorConfig struct {
 
 	CleanupTaskInterval    time.Duration
 	HeartbeatRecordsMaxAge time.Duration
+	ArtifactsTTL           time.Duration
 }
 
-var janitorConfigInst = &janitorConfig{}
+var (
+	janitorConfigInst     = &janitorConfig{}
+	uploadStoreConfigInst = &uploadstore.Config{}
+)
 
 func (c *janitorConfig) Load() {
 	c.CleanupTaskInterval = c.GetInterval("EXECUTORS_CLEANUP_TASK_INTERVAL", "30m", "The frequency with which to run executor cleanup tasks.")
 	c.HeartbeatRecordsMaxAge = c.GetInterval("EXECUTORS_HEARTBEAT_RECORD_MAX_AGE", "168h", "The age after which inactive executor heartbeat records are deleted.") // one week
+	c.ArtifactsTTL = c.GetInterval("EXECUTORS_ARTIFACTS_TTL", "168h", "The age after which artifacts uploaded by executors are deleted.")                          // one week
 }
@@ -2,12 +2,21 @@ package executors
 
 import (
 	"context"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
 	"github.com/sourcegraph/sourcegraph/cmd/worker/workerdb"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/uploadstore"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
 )
 
 type janitorJob struct{}
@@ -17,20 +26,53 @@ func NewJanitorJob() job.Job {
 }
 
 func (j *janitorJob) Config() []env.Config {
-	return []env.Config{janitorConfigInst}
+	return []env.Config{janitorConfigInst, uploadStoreConfigInst}
 }
 
 func (j *janitorJob) Routines(ctx context.Context) ([]goroutine.BackgroundRoutine, error) {
+	observationContext := &observation.Context{
+		Logger:     log15.Root(),
+		Tracer:     &trace.Tracer{Tracer: opentracing.GlobalTracer()},
+		Registerer: prometheus.DefaultRegisterer,
+	}
+
 	db, err := workerdb.Init()
 	if err != nil {
 		return nil, err
 	}
 
+	artifactStore, err := uploadstore.CreateLazy(context.Background(), uploadStoreConfigInst, observationContext)
+	if err != nil {
+		return nil, err
+	}
+
 	routines := []goroutine.BackgroundRoutine{
 		goroutine.NewPeriodicGoroutine(context.Background(), janitorConfigInst.CleanupTaskInterval, goroutine.HandlerFunc(func(ctx context.Context) error {
 			return database.NewDB(db).Executors().DeleteInactiveHeartbeats(ctx, janitorConfigInst.HeartbeatRecordsMaxAge)
 		})),
+		goroutine.NewPeriodicGoroutine(context.Background(), janitorConfigInst.CleanupTaskInterval, goroutine.HandlerFunc(func(ctx context.Context) error {
+			return deleteExpiredArtifacts(ctx, database.NewDB(db).Executors(), artifactStore, janitorConfigInst.ArtifactsTTL)
+		})),
 	}
 
 	return routines, nil
 }
+
+// deleteExpiredArtifacts deletes the artifact records that are older than the given TTL along
+// with their objects in the given store. Objects that fail to be deleted are left to the
+// lifecycle configuration of the bucket.
+func deleteExpiredArtifacts(ctx context.Context, executorStore database.ExecutorStore, artifactStore uploadstore.Store, ttl time.Duration) error {
+	objectKeys, err := executorStore.DeleteExpiredArtifacts(ctx, ttl)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, objectKey := range objectKeys {
+		if err := artifactStore.Delete(ctx, objectKey); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,102 @@
+package bitbucketcloud
+
+import (
+	"fmt"
+	"net/url"
+
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// NewAuthzProviders returns the set of Bitbucket Cloud authz providers derived from the connections.
+// It also returns any validation problems with the config, separating these into "serious problems" and
+// "warnings". "Serious problems" are those that should make Sourcegraph set authz.allowAccessByDefault
+// to false. "Warnings" are all other validation problems.
+func NewAuthzProviders(
+	conns []*types.BitbucketCloudConnection,
+	authProviders []schema.AuthProviders,
+) (ps []authz.Provider, problems []string, warnings []string) {
+	// Auth providers (i.e. login mechanisms)
+	bbcloudAuthProviders := make(map[string]*schema.BitbucketCloudAuthProvider)
+	for _, p := range authProviders {
+		if p.Bitbucketcloud == nil {
+			continue
+		}
+
+		rawURL := p.Bitbucketcloud.Url
+		if rawURL == "" {
+			rawURL = "https://bitbucket.org/"
+		}
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			// error reporting for this should happen elsewhere
+			continue
+		}
+		bbcloudAuthProviders[extsvc.NewCodeHost(u, extsvc.TypeBitbucketCloud).ServiceID] = p.Bitbucketcloud
+	}
+
+	for _, c := range conns {
+		if c.Authorization == nil {
+			continue
+		}
+
+		p, err := newAuthzProvider(c)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+
+		// Permissions of users are fetched with the token they obtained when signing in
+		// with Bitbucket Cloud. Without a corresponding auth provider, repos with
+		// restricted permissions will not be visible to non-admins.
+		if authProvider, exists := bbcloudAuthProviders[p.ServiceID()]; !exists {
+			warnings = append(warnings,
+				fmt.Sprintf("Bitbucket Cloud config for %[1]s has `authorization` enabled, "+
+					"but no authentication provider matching %[1]q was found. "+
+					"Check the [**site configuration**](/site-admin/configuration) to "+
+					"verify an entry in [`auth.providers`](https://docs.sourcegraph.com/admin/auth) exists for %[1]s.",
+					p.ServiceID()))
+		} else {
+			p.oauth2Config = &oauth2.Config{
+				ClientID:     authProvider.ClientKey,
+				ClientSecret: authProvider.ClientSecret,
+				Endpoint:     bitbucketcloud.OAuthEndpoint(p.codeHost.BaseURL),
+			}
+		}
+
+		for _, problem := range p.Validate() {
+			warnings = append(warnings, fmt.Sprintf("Bitbucket Cloud config for %s was invalid: %s", p.ServiceID(), problem))
+		}
+
+		ps = append(ps, p)
+	}
+
+	return ps, problems, warnings
+}
+
+func newAuthzProvider(c *types.BitbucketCloudConnection) (*Provider, error) {
+	baseURL, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse URL for Bitbucket Cloud instance %q: %s", c.Url, err)
+	}
+
+	rawAPIURL := c.ApiURL
+	if rawAPIURL == "" {
+		rawAPIURL = "https://api.bitbucket.org"
+	}
+	apiURL, err := url.Parse(rawAPIURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse API URL for Bitbucket Cloud instance %q: %s", c.Url, err)
+	}
+
+	cli := bitbucketcloud.NewClient(extsvc.NormalizeBaseURL(apiURL), nil)
+	cli.Username = c.Username
+	cli.AppPassword = c.AppPassword
+
+	return NewProvider(cli, c.URN, baseURL), nil
+}
@@ -0,0 +1,195 @@
+// Package bitbucketcloud contains an authorization provider for Bitbucket Cloud.
+package bitbucketcloud
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// Provider is an implementation of AuthzProvider that provides repository permissions as
+// determined from Bitbucket Cloud. Permissions of users are fetched with the OAuth tokens
+// they obtained when signing in with Bitbucket Cloud, and permissions of repositories are
+// fetched with the credentials of a workspace administrator.
+type Provider struct {
+	urn      string
+	client   *bitbucketcloud.Client
+	codeHost *extsvc.CodeHost
+
+	// oauth2Config is used to refresh expired OAuth tokens of users. It is nil if no
+	// Bitbucket Cloud auth provider is configured.
+	oauth2Config *oauth2.Config
+}
+
+var _ authz.Provider = (*Provider)(nil)
+
+// NewProvider returns a new Bitbucket Cloud authorization provider that uses the given
+// bitbucketcloud.Client to fetch the permissions of repositories. The client's user must
+// be an administrator of the workspaces of the repositories.
+func NewProvider(cli *bitbucketcloud.Client, urn string, baseURL *url.URL) *Provider {
+	return &Provider{
+		urn:      urn,
+		client:   cli,
+		codeHost: extsvc.NewCodeHost(baseURL, extsvc.TypeBitbucketCloud),
+	}
+}
+
+// Validate validates that the Provider has access to the Bitbucket Cloud API with the
+// credentials it was configured with.
+func (p *Provider) Validate() []string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := p.client.CurrentUser(ctx); err != nil {
+		return []string{err.Error()}
+	}
+
+	return nil
+}
+
+func (p *Provider) URN() string {
+	return p.urn
+}
+
+// ServiceID returns the absolute URL that identifies the Bitbucket Cloud instance this
+// provider is configured with.
+func (p *Provider) ServiceID() string { return p.codeHost.ServiceID }
+
+// ServiceType returns the type of this Provider, namely, "bitbucketCloud".
+func (p *Provider) ServiceType() string { return p.codeHost.ServiceType }
+
+// FetchAccount always returns nil, as Bitbucket Cloud accounts are created when users
+// sign in with Bitbucket Cloud.
+func (p *Provider) FetchAccount(context.Context, *types.User, []*extsvc.Account, []string) (*extsvc.Account, error) {
+	return nil, nil
+}
+
+// FetchUserPerms returns a list of repository IDs (on code host) that the given account
+// has read access on the code host. The repository ID has the same value as it would be
+// used as api.ExternalRepoSpec.ID. The returned list includes the repositories the user
+// has access to directly, through a group, or as a member of a workspace.
+//
+// This method may return partial but valid results in case of error, and it is up to
+// callers to decide whether to discard.
+//
+// API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-users/#api-user-permissions-repositories-get
+func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account, opts authz.FetchPermsOptions) (*authz.ExternalUserPermissions, error) {
+	switch {
+	case account == nil:
+		return nil, errors.New("no account provided")
+	case !extsvc.IsHostOfAccount(p.codeHost, account):
+		return nil, errors.Errorf("not a code host of the account: want %q but have %q",
+			p.codeHost.ServiceID, account.AccountSpec.ServiceID)
+	}
+
+	_, tok, err := bitbucketcloud.GetExternalAccountData(&account.AccountData)
+	if err != nil {
+		return nil, errors.Wrap(err, "get external account data")
+	} else if tok == nil {
+		return nil, errors.New("no token found in the external account data")
+	}
+
+	// Access tokens of Bitbucket Cloud expire after a few hours, so they are refreshed
+	// before every sync if necessary.
+	if !tok.Valid() && tok.RefreshToken != "" && p.oauth2Config != nil {
+		if tok, err = p.oauth2Config.TokenSource(ctx, tok).Token(); err != nil {
+			return nil, errors.Wrap(err, "refresh token")
+		}
+	}
+
+	return p.fetchUserPermsByToken(ctx, tok.AccessToken)
+}
+
+// FetchUserPermsByToken is the same as FetchUserPerms, but it only requires a
+// token.
+func (p *Provider) FetchUserPermsByToken(ctx context.Context, token string, opts authz.FetchPermsOptions) (*authz.ExternalUserPermissions, error) {
+	return p.fetchUserPermsByToken(ctx, token)
+}
+
+func (p *Provider) fetchUserPermsByToken(ctx context.Context, token string) (*authz.ExternalUserPermissions, error) {
+	cli, err := p.client.WithAuthenticator(&auth.OAuthBearerToken{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	var extIDs []extsvc.RepoID
+	var next *bitbucketcloud.PageToken
+	for {
+		var perms []*bitbucketcloud.RepoPermission
+		if perms, next, err = cli.CurrentUserRepoPermissions(ctx, next); err != nil {
+			return &authz.ExternalUserPermissions{Exacts: extIDs}, err
+		}
+
+		for _, perm := range perms {
+			if perm.Repository != nil {
+				extIDs = append(extIDs, extsvc.RepoID(perm.Repository.UUID))
+			}
+		}
+
+		if !next.HasMore() {
+			break
+		}
+	}
+
+	return &authz.ExternalUserPermissions{
+		Exacts: extIDs,
+	}, nil
+}
+
+// FetchRepoPerms returns a list of user IDs (on code host) who have read access to
+// the given repository on the code host. The user ID has the same value as it would
+// be used as extsvc.Account.AccountID. The returned list includes the users that have
+// access to the repository directly, through a group, or as a member of the workspace.
+//
+// This method may return partial but valid results in case of error, and it is up to
+// callers to decide whether to discard.
+//
+// API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-permissions-repositories-repo-slug-get
+func (p *Provider) FetchRepoPerms(ctx context.Context, repo *extsvc.Repository, opts authz.FetchPermsOptions) ([]extsvc.AccountID, error) {
+	switch {
+	case repo == nil:
+		return nil, errors.New("no repository provided")
+	case !extsvc.IsHostOfRepo(p.codeHost, &repo.ExternalRepoSpec):
+		return nil, errors.Errorf("not a code host of the repository: want %q but have %q",
+			p.codeHost.ServiceID, repo.ServiceID)
+	}
+
+	// The URI of a repository is of the form "bitbucket.org/workspace/slug".
+	parts := strings.Split(repo.URI, "/")
+	if len(parts) != 3 {
+		return nil, errors.Errorf("unexpected repository URI %q", repo.URI)
+	}
+	workspace, slug := parts[1], parts[2]
+
+	var userIDs []extsvc.AccountID
+	var next *bitbucketcloud.PageToken
+	for {
+		var perms []*bitbucketcloud.RepoPermission
+		var err error
+		if perms, next, err = p.client.WorkspaceRepoPermissions(ctx, workspace, slug, next); err != nil {
+			return userIDs, err
+		}
+
+		for _, perm := range perms {
+			if perm.User != nil {
+				userIDs = append(userIDs, extsvc.AccountID(perm.User.UUID))
+			}
+		}
+
+		if !next.HasMore() {
+			break
+		}
+	}
+
+	return userIDs, nil
+}
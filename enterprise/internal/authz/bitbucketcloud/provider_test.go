@@ -0,0 +1,183 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func newTestProvider(t *testing.T, handler http.HandlerFunc) (*Provider, *httptest.Server) {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	apiURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cli := bitbucketcloud.NewClient(apiURL, srv.Client())
+	cli.Username = "admin"
+	cli.AppPassword = "password"
+
+	return NewProvider(cli, "extsvc:bitbucketcloud:1", &url.URL{Scheme: "https", Host: "bitbucket.org"}), srv
+}
+
+func newTestAccount(t *testing.T, serviceID string, token *oauth2.Token) *extsvc.Account {
+	t.Helper()
+
+	account := &extsvc.Account{
+		AccountSpec: extsvc.AccountSpec{
+			ServiceType: extsvc.TypeBitbucketCloud,
+			ServiceID:   serviceID,
+			AccountID:   "{alice}",
+		},
+	}
+	bitbucketcloud.SetExternalAccountData(&account.AccountData, &bitbucketcloud.Account{UUID: "{alice}", Nickname: "alice"}, token)
+	return account
+}
+
+func TestNewAuthzProviders(t *testing.T) {
+	conn := &types.BitbucketCloudConnection{
+		URN: "extsvc:bitbucketcloud:1",
+		BitbucketCloudConnection: &schema.BitbucketCloudConnection{
+			Url:           "https://bitbucket.org",
+			ApiURL:        "http://127.0.0.1:0",
+			Authorization: &schema.BitbucketCloudAuthorization{},
+		},
+	}
+
+	t.Run("no authorization", func(t *testing.T) {
+		ps, problems, _ := NewAuthzProviders([]*types.BitbucketCloudConnection{{
+			BitbucketCloudConnection: &schema.BitbucketCloudConnection{Url: "https://bitbucket.org"},
+		}}, nil)
+		if len(ps) != 0 || len(problems) != 0 {
+			t.Fatalf("unexpected providers or problems: %v %v", ps, problems)
+		}
+	})
+
+	t.Run("no auth provider", func(t *testing.T) {
+		ps, problems, warnings := NewAuthzProviders([]*types.BitbucketCloudConnection{conn}, nil)
+		if len(ps) != 1 || len(problems) != 0 {
+			t.Fatalf("unexpected providers or problems: %v %v", ps, problems)
+		}
+		// One warning for the missing auth provider and one for the unreachable API.
+		if len(warnings) != 2 {
+			t.Fatalf("unexpected warnings: %v", warnings)
+		}
+		if ps[0].(*Provider).oauth2Config != nil {
+			t.Fatal("unexpected OAuth config")
+		}
+	})
+
+	t.Run("matching auth provider", func(t *testing.T) {
+		ps, _, warnings := NewAuthzProviders([]*types.BitbucketCloudConnection{conn}, []schema.AuthProviders{{
+			Bitbucketcloud: &schema.BitbucketCloudAuthProvider{
+				Type:         "bitbucketcloud",
+				ClientKey:    "key",
+				ClientSecret: "secret",
+			},
+		}})
+		if len(ps) != 1 || len(warnings) != 1 {
+			t.Fatalf("unexpected providers or warnings: %v %v", ps, warnings)
+		}
+
+		config := ps[0].(*Provider).oauth2Config
+		if config == nil || config.ClientID != "key" || config.Endpoint.TokenURL != "https://bitbucket.org/site/oauth2/access_token" {
+			t.Fatalf("unexpected OAuth config: %+v", config)
+		}
+	})
+}
+
+func TestProvider_FetchUserPerms(t *testing.T) {
+	p, srv := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/user/permissions/repositories":
+			if have := r.Header.Get("Authorization"); have != "Bearer refreshed-token" {
+				t.Errorf("unexpected authorization header: %q", have)
+			}
+			fmt.Fprint(w, `{"values": [{"permission": "read", "repository": {"full_name": "sglocal/mux", "uuid": "{1}"}}, {"permission": "admin", "repository": {"full_name": "sglocal/private", "uuid": "{2}"}}]}`)
+		case "/site/oauth2/access_token":
+			if err := r.ParseForm(); err != nil {
+				t.Fatal(err)
+			}
+			if have := r.Form.Get("refresh_token"); have != "refresh-token" {
+				t.Errorf("unexpected refresh token: %q", have)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"access_token": "refreshed-token", "token_type": "bearer", "expires_in": 7200, "refresh_token": "refresh-token"}`)
+		default:
+			t.Errorf("unexpected request path: %s", r.URL.Path)
+		}
+	})
+	p.oauth2Config = &oauth2.Config{
+		ClientID:     "key",
+		ClientSecret: "secret",
+		Endpoint:     oauth2.Endpoint{TokenURL: srv.URL + "/site/oauth2/access_token"},
+	}
+
+	t.Run("wrong code host", func(t *testing.T) {
+		account := newTestAccount(t, "https://gitlab.com/", &oauth2.Token{AccessToken: "token"})
+		if _, err := p.FetchUserPerms(context.Background(), account, authz.FetchPermsOptions{}); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		account := newTestAccount(t, "https://bitbucket.org/", &oauth2.Token{
+			AccessToken:  "expired-token",
+			RefreshToken: "refresh-token",
+			Expiry:       time.Now().Add(-time.Hour),
+		})
+
+		perms, err := p.FetchUserPerms(context.Background(), account, authz.FetchPermsOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []extsvc.RepoID{"{1}", "{2}"}
+		if diff := cmp.Diff(want, perms.Exacts); diff != "" {
+			t.Fatalf("unexpected permissions (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestProvider_FetchRepoPerms(t *testing.T) {
+	p, _ := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/workspaces/sglocal/permissions/repositories/mux" {
+			t.Errorf("unexpected request path: %s", r.URL.Path)
+		}
+		if username, password, _ := r.BasicAuth(); username != "admin" || password != "password" {
+			t.Errorf("unexpected credentials: %s:%s", username, password)
+		}
+		fmt.Fprint(w, `{"values": [{"permission": "admin", "user": {"uuid": "{alice}"}}, {"permission": "read", "user": {"uuid": "{bob}"}}]}`)
+	})
+
+	repo := &extsvc.Repository{URI: "bitbucket.org/sglocal/mux"}
+	repo.ServiceType = extsvc.TypeBitbucketCloud
+	repo.ServiceID = "https://bitbucket.org/"
+	repo.ID = "{1}"
+
+	userIDs, err := p.FetchRepoPerms(context.Background(), repo, authz.FetchPermsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]extsvc.AccountID{"{alice}", "{bob}"}, userIDs); diff != "" {
+		t.Fatalf("unexpected user IDs (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,104 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func newTestProvider(t *testing.T, handler http.HandlerFunc) *Provider {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	cli, err := gitea.NewClient(&schema.GiteaConnection{Url: srv.URL, Token: "secret"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewProvider(cli, "extsvc:gitea:1")
+	p.pageSize = 2
+	return p
+}
+
+func TestProvider_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		isAdmin bool
+		want    int
+	}{
+		{name: "admin", isAdmin: true, want: 0},
+		{name: "not admin", isAdmin: false, want: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"id":1,"login":"admin","is_admin":%t}`, tc.isAdmin)
+			})
+			if have := p.Validate(); len(have) != tc.want {
+				t.Fatalf("unexpected problems: %v", have)
+			}
+		})
+	}
+}
+
+func TestProvider_FetchUserPerms(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/users/alice":
+			fmt.Fprint(w, `{"id":42,"login":"alice"}`)
+		case "/api/v1/user/repos":
+			if have, want := r.Header.Get("Sudo"), "alice"; have != want {
+				t.Errorf("unexpected sudo header: have %q, want %q", have, want)
+			}
+			switch r.URL.Query().Get("page") {
+			case "1":
+				fmt.Fprint(w, `[{"id":1,"private":true},{"id":2,"private":false}]`)
+			case "2":
+				fmt.Fprint(w, `[{"id":3,"private":true}]`)
+			default:
+				fmt.Fprint(w, `[]`)
+			}
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+	})
+
+	ctx := context.Background()
+	account, err := p.FetchAccount(ctx, &types.User{ID: 7, Username: "alice"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := account.AccountID, "42"; have != want {
+		t.Errorf("unexpected account ID: have %q, want %q", have, want)
+	}
+
+	perms, err := p.FetchUserPerms(ctx, account, authz.FetchPermsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]extsvc.RepoID{"1", "3"}, perms.Exacts); diff != "" {
+		t.Fatalf("unexpected repo IDs (-want +got):\n%s", diff)
+	}
+
+	t.Run("wrong code host", func(t *testing.T) {
+		data := json.RawMessage(`{}`)
+		_, err := p.FetchUserPerms(ctx, &extsvc.Account{
+			AccountSpec: extsvc.AccountSpec{ServiceType: extsvc.TypeGitea, ServiceID: "https://other.example.com/"},
+			AccountData: extsvc.AccountData{Data: &data},
+		}, authz.FetchPermsOptions{})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -41,7 +42,7 @@ func NewAuthzProviders(
 
 	for _, c := range conns {
 		// Initialize authz (permissions) provider.
-		p, err := newAuthzProvider(c.URN, c.Authorization, c.Url, c.Token)
+		p, err := newAuthzProvider(c.URN, c.GitHubConnection)
 		if err != nil {
 			problems = append(problems, err.Error())
 		} else if p == nil {
@@ -83,14 +84,25 @@ func NewAuthzProviders(
 
 // newAuthzProvider instantiates a provider, or returns nil if authorization is disabled.
 // Errors returned are "serious problems".
-func newAuthzProvider(urn string, a *schema.GitHubAuthorization, instanceURL, token string) (*Provider, error) {
+func newAuthzProvider(urn string, c *schema.GitHubConnection) (*Provider, error) {
+	a := c.Authorization
 	if a == nil {
 		return nil, nil
 	}
 
-	ghURL, err := url.Parse(instanceURL)
+	ghURL, err := url.Parse(c.Url)
 	if err != nil {
-		return nil, errors.Errorf("Could not parse URL for GitHub instance %q: %s", instanceURL, err)
+		return nil, errors.Errorf("Could not parse URL for GitHub instance %q: %s", c.Url, err)
+	}
+
+	apiURL, err := github.ConnectionAPIURL(c)
+	if err != nil {
+		return nil, errors.Errorf("Could not determine API URL for GitHub instance %q: %s", c.Url, err)
+	}
+	_, externalServiceID := extsvc.DecodeURN(urn)
+	baseAuth, err := github.NewConnectionAuthenticator(externalServiceID, apiURL, c, nil)
+	if err != nil {
+		return nil, errors.Errorf("Could not authenticate with GitHub instance %q: %s", c.Url, err)
 	}
 
 	// Disable by default for now
@@ -101,15 +113,15 @@ func newAuthzProvider(urn string, a *schema.GitHubAuthorization, instanceURL, to
 	ttl := time.Duration(a.GroupsCacheTTL) * time.Hour
 
 	return NewProvider(urn, ProviderOptions{
-		GitHubURL:      ghURL,
-		BaseToken:      token,
-		GroupsCacheTTL: ttl,
+		GitHubURL:         ghURL,
+		BaseAuthenticator: baseAuth,
+		GroupsCacheTTL:    ttl,
 	}), nil
 }
 
 // ValidateAuthz validates the authorization fields of the given GitHub external
 // service config.
 func ValidateAuthz(cfg *schema.GitHubConnection) error {
-	_, err := newAuthzProvider("", cfg.Authorization, cfg.Url, cfg.Token)
+	_, err := newAuthzProvider("", cfg)
 	return err
 }
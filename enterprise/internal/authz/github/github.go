@@ -25,6 +25,9 @@ type Provider struct {
 	codeHost *extsvc.CodeHost
 	// groupsCache may be nil if group caching is disabled (negative TTL)
 	groupsCache *cachedGroups
+	// githubAppInstallation is true if the base client authenticates as a GitHub App
+	// installation, whose access is governed by app permissions instead of OAuth scopes.
+	githubAppInstallation bool
 }
 
 type ProviderOptions struct {
@@ -32,15 +35,22 @@ type ProviderOptions struct {
 	GitHubClient *github.V3Client
 	GitHubURL    *url.URL
 
-	BaseToken      string
-	GroupsCacheTTL time.Duration
+	BaseToken string
+	// BaseAuthenticator is used instead of BaseToken if set, e.g. to authenticate
+	// as a GitHub App installation.
+	BaseAuthenticator auth.Authenticator
+	GroupsCacheTTL    time.Duration
 }
 
 func NewProvider(urn string, opts ProviderOptions) *Provider {
+	if opts.BaseAuthenticator == nil {
+		opts.BaseAuthenticator = &auth.OAuthBearerToken{Token: opts.BaseToken}
+	}
 	if opts.GitHubClient == nil {
 		apiURL, _ := github.APIRoot(opts.GitHubURL)
-		opts.GitHubClient = github.NewV3Client(apiURL, &auth.OAuthBearerToken{Token: opts.BaseToken}, nil)
+		opts.GitHubClient = github.NewV3Client(apiURL, opts.BaseAuthenticator, nil)
 	}
+	_, githubAppInstallation := opts.BaseAuthenticator.(*github.InstallationAuthenticator)
 
 	codeHost := extsvc.NewCodeHost(opts.GitHubURL, extsvc.TypeGitHub)
 
@@ -58,6 +68,8 @@ func NewProvider(urn string, opts ProviderOptions) *Provider {
 		codeHost:    codeHost,
 		groupsCache: cg,
 		client:      &ClientAdapter{V3Client: opts.GitHubClient},
+
+		githubAppInstallation: githubAppInstallation,
 	}
 }
 
@@ -121,6 +133,11 @@ type requiredAuthScope struct {
 func (p *Provider) requiredAuthScopes() []requiredAuthScope {
 	scopes := []requiredAuthScope{}
 
+	if p.githubAppInstallation {
+		// Installation access tokens have no OAuth scopes.
+		return scopes
+	}
+
 	if p.groupsCache != nil {
 		// Needs extra scope to pull group permissions
 		scopes = append(scopes, requiredAuthScope{
@@ -0,0 +1,212 @@
+// Package pathrules implements sub-repository permissions that site admins
+// configure with path rules in the site configuration, independently of the
+// code host a repository comes from.
+package pathrules
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gobwas/glob"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// NewSubRepoPermissionsGetter returns a SubRepoPermissionsGetter that merges
+// the sub-repository permissions stored in the database with the path rules
+// of the site configuration.
+func NewSubRepoPermissionsGetter(db database.DB) authz.SubRepoPermissionsGetter {
+	return &getter{db: db, rules: rulesFromConfig, clock: time.Now}
+}
+
+// repoCacheTTL is how long the repositories a rule applies to are cached. New
+// repositories matching a rule may be unrestricted for that long.
+const repoCacheTTL = time.Minute
+
+type getter struct {
+	db    database.DB
+	rules func() []*schema.SubRepoPermissionsPathRule
+	clock func() time.Time
+
+	mu    sync.Mutex
+	repos map[string]cachedRepos // keyed by the repos pattern of the rule
+}
+
+type cachedRepos struct {
+	repos     []types.MinimalRepo
+	timestamp time.Time
+}
+
+func rulesFromConfig() []*schema.SubRepoPermissionsPathRule {
+	if c := conf.Get(); c.ExperimentalFeatures != nil && c.ExperimentalFeatures.SubRepoPermissions != nil {
+		return c.ExperimentalFeatures.SubRepoPermissions.PathRules
+	}
+	return nil
+}
+
+// GetByUser returns the sub-repository permissions of the user. Each path rule
+// that does not allow the user adds the rule's paths to the excluded paths of
+// all repositories the rule applies to.
+func (g *getter) GetByUser(ctx context.Context, userID int32) (map[api.RepoName]authz.SubRepoPermissions, error) {
+	perms, err := g.db.SubRepoPerms().GetByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := g.rules()
+	if len(rules) == 0 {
+		return perms, nil
+	}
+	if perms == nil {
+		perms = make(map[api.RepoName]authz.SubRepoPermissions)
+	}
+
+	user, err := g.db.Users().GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting user")
+	}
+	orgs, err := g.db.Orgs().GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting user organizations")
+	}
+
+	// Rules restrict access rather than grant it, so they must apply to every
+	// matching repository regardless of which repositories the current actor
+	// can see.
+	ctx = actor.WithInternalActor(ctx)
+
+	for _, rule := range rules {
+		if allowed(rule, user, orgs) {
+			continue
+		}
+
+		repos, err := g.reposMatching(ctx, rules, rule.Repos)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repo := range repos {
+			p, ok := perms[repo.Name]
+			if !ok {
+				// Without any other rules for the repository everything but the
+				// excluded paths must remain accessible.
+				p.PathIncludes = []string{glob.QuoteMeta(string(repo.Name)) + "/**"}
+			}
+			p.PathExcludes = append(p.PathExcludes, repoPatterns(repo.Name, rule.Paths)...)
+			perms[repo.Name] = p
+		}
+	}
+
+	return perms, nil
+}
+
+// reposMatching returns the repositories matching the repos pattern of a rule.
+// They are shared by all users and cached for repoCacheTTL, so that the
+// repositories are not listed every time the rules of a user are computed.
+func (g *getter) reposMatching(ctx context.Context, rules []*schema.SubRepoPermissionsPathRule, pattern string) ([]types.MinimalRepo, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if cached, ok := g.repos[pattern]; ok && g.clock().Sub(cached.timestamp) <= repoCacheTTL {
+		return cached.repos, nil
+	}
+
+	repos, err := g.db.Repos().ListMinimalRepos(ctx, database.ReposListOptions{
+		IncludePatterns: []string{pattern},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing repositories matching %q", pattern)
+	}
+
+	// Drop the repositories of rules that have been removed from the site
+	// configuration.
+	cache := make(map[string]cachedRepos, len(rules))
+	for _, rule := range rules {
+		if cached, ok := g.repos[rule.Repos]; ok {
+			cache[rule.Repos] = cached
+		}
+	}
+	cache[pattern] = cachedRepos{repos: repos, timestamp: g.clock()}
+	g.repos = cache
+
+	return repos, nil
+}
+
+// allowed returns true if the user is listed in the rule or is a member of one
+// of its organizations.
+func allowed(rule *schema.SubRepoPermissionsPathRule, user *types.User, orgs []*types.Org) bool {
+	for _, username := range rule.AllowUsers {
+		if username == user.Username {
+			return true
+		}
+	}
+	for _, name := range rule.AllowOrgs {
+		for _, org := range orgs {
+			if name == org.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// repoPatterns converts path patterns that are relative to the repository root
+// to patterns that include the repository name, which is how the rules of
+// authz.SubRepoPermissions are matched.
+func repoPatterns(repo api.RepoName, paths []string) []string {
+	prefix := glob.QuoteMeta(string(repo)) + "/"
+
+	patterns := make([]string, 0, len(paths))
+	for _, p := range paths {
+		p = strings.TrimPrefix(p, "/")
+
+		// "**/" also matches files at the root of the repository.
+		if rest := strings.TrimPrefix(p, "**/"); rest != p {
+			patterns = append(patterns, repoPatterns(repo, []string{rest})...)
+		}
+
+		// A trailing slash restricts the directory itself and everything
+		// below it.
+		if strings.HasSuffix(p, "/") {
+			patterns = append(patterns, prefix+strings.TrimSuffix(p, "/"), prefix+p+"**")
+			continue
+		}
+		patterns = append(patterns, prefix+p)
+	}
+	return patterns
+}
+
+// Validate reports problems with the path rules of the site configuration.
+func Validate(cfg conftypes.SiteConfigQuerier) (problems conf.Problems) {
+	c := cfg.SiteConfig().ExperimentalFeatures
+	if c == nil || c.SubRepoPermissions == nil {
+		return nil
+	}
+
+	for i, rule := range c.SubRepoPermissions.PathRules {
+		if _, err := regexp.Compile(rule.Repos); err != nil {
+			problems = append(problems, conf.NewSiteProblem(fmt.Sprintf("experimentalFeatures.subRepoPermissions.pathRules[%d]: invalid repos pattern: %s", i, err)))
+		}
+		for _, p := range rule.Paths {
+			for _, pattern := range repoPatterns("repo", []string{p}) {
+				if _, err := glob.Compile(pattern, '/'); err != nil {
+					problems = append(problems, conf.NewSiteProblem(fmt.Sprintf("experimentalFeatures.subRepoPermissions.pathRules[%d]: invalid path pattern %q: %s", i, p, err)))
+					break
+				}
+			}
+		}
+	}
+	return problems
+}
@@ -0,0 +1,224 @@
+package pathrules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmock"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestGetByUser(t *testing.T) {
+	subRepoPerms := dbmock.NewMockSubRepoPermsStore()
+	subRepoPerms.GetByUserFunc.SetDefaultReturn(map[api.RepoName]authz.SubRepoPermissions{
+		"perforce/depot": {
+			PathIncludes: []string{"perforce/depot/**"},
+		},
+	}, nil)
+
+	users := dbmock.NewMockUserStore()
+	users.GetByIDFunc.SetDefaultHook(func(ctx context.Context, id int32) (*types.User, error) {
+		return map[int32]*types.User{
+			1: {ID: 1, Username: "alice"},
+			2: {ID: 2, Username: "bob"},
+			3: {ID: 3, Username: "carol"},
+		}[id], nil
+	})
+
+	orgs := dbmock.NewMockOrgStore()
+	orgs.GetByUserIDFunc.SetDefaultHook(func(ctx context.Context, userID int32) ([]*types.Org, error) {
+		if userID == 2 {
+			return []*types.Org{{Name: "security"}}, nil
+		}
+		return nil, nil
+	})
+
+	repos := dbmock.NewMockRepoStore()
+	repos.ListMinimalReposFunc.SetDefaultHook(func(ctx context.Context, opt database.ReposListOptions) ([]types.MinimalRepo, error) {
+		if diff := cmp.Diff([]string{"^github\\.com/acme/|^perforce/"}, opt.IncludePatterns); diff != "" {
+			t.Errorf("unexpected include patterns (-want +got):\n%s", diff)
+		}
+		return []types.MinimalRepo{{Name: "github.com/acme/api"}, {Name: "perforce/depot"}}, nil
+	})
+
+	db := dbmock.NewMockDB()
+	db.SubRepoPermsFunc.SetDefaultReturn(subRepoPerms)
+	db.UsersFunc.SetDefaultReturn(users)
+	db.OrgsFunc.SetDefaultReturn(orgs)
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	g := &getter{db: db, clock: time.Now, rules: func() []*schema.SubRepoPermissionsPathRule {
+		return []*schema.SubRepoPermissionsPathRule{{
+			Repos:      "^github\\.com/acme/|^perforce/",
+			Paths:      []string{"secrets/", "**/*.pem"},
+			AllowUsers: []string{"alice"},
+			AllowOrgs:  []string{"security"},
+		}}
+	}}
+
+	for _, tc := range []struct {
+		name   string
+		userID int32
+		want   map[api.RepoName]authz.SubRepoPermissions
+	}{
+		{
+			name:   "allowed user",
+			userID: 1,
+			want: map[api.RepoName]authz.SubRepoPermissions{
+				"perforce/depot": {PathIncludes: []string{"perforce/depot/**"}},
+			},
+		},
+		{
+			name:   "allowed organization member",
+			userID: 2,
+			want: map[api.RepoName]authz.SubRepoPermissions{
+				"perforce/depot": {PathIncludes: []string{"perforce/depot/**"}},
+			},
+		},
+		{
+			name:   "restricted user",
+			userID: 3,
+			want: map[api.RepoName]authz.SubRepoPermissions{
+				"github.com/acme/api": {
+					PathIncludes: []string{"github.com/acme/api/**"},
+					PathExcludes: []string{
+						"github.com/acme/api/secrets",
+						"github.com/acme/api/secrets/**",
+						"github.com/acme/api/*.pem",
+						"github.com/acme/api/**/*.pem",
+					},
+				},
+				"perforce/depot": {
+					PathIncludes: []string{"perforce/depot/**"},
+					PathExcludes: []string{
+						"perforce/depot/secrets",
+						"perforce/depot/secrets/**",
+						"perforce/depot/*.pem",
+						"perforce/depot/**/*.pem",
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := g.GetByUser(context.Background(), tc.userID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf("unexpected permissions (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetByUserCachesRepos(t *testing.T) {
+	users := dbmock.NewMockUserStore()
+	users.GetByIDFunc.SetDefaultReturn(&types.User{ID: 1, Username: "alice"}, nil)
+
+	repos := dbmock.NewMockRepoStore()
+	repos.ListMinimalReposFunc.SetDefaultHook(func(ctx context.Context, opt database.ReposListOptions) ([]types.MinimalRepo, error) {
+		return []types.MinimalRepo{{Name: api.RepoName(opt.IncludePatterns[0])}}, nil
+	})
+
+	db := dbmock.NewMockDB()
+	db.SubRepoPermsFunc.SetDefaultReturn(dbmock.NewMockSubRepoPermsStore())
+	db.UsersFunc.SetDefaultReturn(users)
+	db.OrgsFunc.SetDefaultReturn(dbmock.NewMockOrgStore())
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	now := time.Now()
+	rules := []*schema.SubRepoPermissionsPathRule{{Repos: "a", Paths: []string{"secrets/"}}}
+	g := &getter{
+		db:    db,
+		rules: func() []*schema.SubRepoPermissionsPathRule { return rules },
+		clock: func() time.Time { return now },
+	}
+
+	getByUser := func(wantCalls int) {
+		t.Helper()
+		if _, err := g.GetByUser(context.Background(), 1); err != nil {
+			t.Fatal(err)
+		}
+		if calls := len(repos.ListMinimalReposFunc.History()); calls != wantCalls {
+			t.Fatalf("want %d calls to ListMinimalRepos, have %d", wantCalls, calls)
+		}
+	}
+
+	getByUser(1)
+	getByUser(1)
+
+	// Repositories are listed again once the cache expires.
+	now = now.Add(repoCacheTTL + time.Second)
+	getByUser(2)
+
+	// Rules with a different repos pattern do not share cached repositories.
+	rules = []*schema.SubRepoPermissionsPathRule{{Repos: "b", Paths: []string{"secrets/"}}}
+	getByUser(3)
+	if _, ok := g.repos["a"]; ok {
+		t.Fatal("repositories of removed rule are still cached")
+	}
+}
+
+func TestSubRepoPermsClientWithPathRules(t *testing.T) {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		ExperimentalFeatures: &schema.ExperimentalFeatures{
+			SubRepoPermissions: &schema.SubRepoPermissions{Enabled: true},
+		},
+	}})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	getter := authz.NewMockSubRepoPermissionsGetter()
+	getter.GetByUserFunc.SetDefaultReturn(map[api.RepoName]authz.SubRepoPermissions{
+		"github.com/acme/api": {
+			PathIncludes: []string{"github.com/acme/api/**"},
+			PathExcludes: repoPatterns("github.com/acme/api", []string{"secrets/", "**/*.pem"}),
+		},
+	}, nil)
+	client, err := authz.NewSubRepoPermsClient(getter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, want := range map[string]authz.Perms{
+		"README.md":            authz.Read,
+		"secrets":              authz.None,
+		"secrets/token":        authz.None,
+		"config/secrets.yaml":  authz.Read,
+		"server.pem":           authz.None,
+		"certs/server.pem":     authz.None,
+		"certs/server.pem.txt": authz.Read,
+	} {
+		have, err := client.Permissions(context.Background(), 1, authz.RepoContent{Repo: "github.com/acme/api", Path: path})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have != want {
+			t.Errorf("%s: want %v, have %v", path, want, have)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	problems := Validate(conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		ExperimentalFeatures: &schema.ExperimentalFeatures{
+			SubRepoPermissions: &schema.SubRepoPermissions{
+				PathRules: []*schema.SubRepoPermissionsPathRule{
+					{Repos: "^github\\.com/", Paths: []string{"secrets/"}},
+					{Repos: "(", Paths: []string{"[a-"}},
+				},
+			},
+		},
+	}})
+	if len(problems) != 2 {
+		t.Fatalf("want 2 problems, have %v", problems.Messages())
+	}
+}
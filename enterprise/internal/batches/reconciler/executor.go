@@ -167,6 +167,9 @@ func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (err erro
 		Body:      e.spec.Spec.Body,
 		BaseRef:   e.spec.Spec.BaseRef,
 		HeadRef:   e.spec.Spec.HeadRef,
+		Labels:    e.spec.Spec.Labels,
+		Reviewers: e.spec.Spec.Reviewers,
+		Assignees: e.spec.Spec.Assignees,
 		Repo:      e.repo,
 		Changeset: e.ch,
 	}
@@ -266,6 +269,9 @@ func (e *executor) updateChangeset(ctx context.Context) (err error) {
 		Body:      e.spec.Spec.Body,
 		BaseRef:   e.spec.Spec.BaseRef,
 		HeadRef:   e.spec.Spec.HeadRef,
+		Labels:    e.spec.Spec.Labels,
+		Reviewers: e.spec.Spec.Reviewers,
+		Assignees: e.spec.Spec.Assignees,
 		Repo:      e.repo,
 		Changeset: e.ch,
 	}
@@ -358,6 +364,9 @@ func (e *executor) undraftChangeset(ctx context.Context) (err error) {
 		Body:      e.spec.Spec.Body,
 		BaseRef:   e.spec.Spec.BaseRef,
 		HeadRef:   e.spec.Spec.HeadRef,
+		Labels:    e.spec.Spec.Labels,
+		Reviewers: e.spec.Spec.Reviewers,
+		Assignees: e.spec.Spec.Assignees,
 		Repo:      e.repo,
 		Changeset: e.ch,
 	}
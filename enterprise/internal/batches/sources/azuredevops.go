@@ -0,0 +1,261 @@
+package sources
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type AzureDevOpsSource struct {
+	client *azuredevops.Client
+	au     auth.Authenticator
+
+	// org is an organization of the code host connection, used to validate
+	// credentials: Azure DevOps has no API endpoint outside of organizations.
+	org string
+}
+
+var (
+	_ ChangesetSource      = AzureDevOpsSource{}
+	_ DraftChangesetSource = AzureDevOpsSource{}
+)
+
+// NewAzureDevOpsSource returns a new AzureDevOpsSource from the given external service.
+func NewAzureDevOpsSource(svc *types.ExternalService, cf *httpcli.Factory) (*AzureDevOpsSource, error) {
+	var c schema.AzureDevOpsConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	return newAzureDevOpsSource(&c, cf)
+}
+
+func newAzureDevOpsSource(c *schema.AzureDevOpsConnection, cf *httpcli.Factory) (*AzureDevOpsSource, error) {
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	cli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := azuredevops.NewClient(c, cli)
+	if err != nil {
+		return nil, err
+	}
+
+	var org string
+	if len(c.Orgs) > 0 {
+		org = c.Orgs[0]
+	} else if len(c.Projects) > 0 {
+		org = strings.SplitN(c.Projects[0], "/", 2)[0]
+	}
+
+	return &AzureDevOpsSource{
+		client: client,
+		au:     &auth.BasicAuth{Username: c.Username, Password: c.Token},
+		org:    org,
+	}, nil
+}
+
+func (s AzureDevOpsSource) GitserverPushConfig(ctx context.Context, store database.ExternalServiceStore, repo *types.Repo) (*protocol.PushConfig, error) {
+	return gitserverPushConfig(ctx, store, repo, s.au)
+}
+
+func (s AzureDevOpsSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	switch a.(type) {
+	case *auth.BasicAuth,
+		*auth.BasicAuthWithSSH:
+		break
+
+	default:
+		return nil, newUnsupportedAuthenticatorError("AzureDevOpsSource", a)
+	}
+
+	client, err := s.client.WithAuthenticator(a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AzureDevOpsSource{
+		client: client,
+		au:     a,
+		org:    s.org,
+	}, nil
+}
+
+func (s AzureDevOpsSource) ValidateAuthenticator(ctx context.Context) error {
+	if s.org == "" {
+		return errors.New("no Azure DevOps organization configured to validate the credentials against")
+	}
+	_, err := s.client.AuthenticatedUser(ctx, s.org)
+	return err
+}
+
+// CreateChangeset creates the given *Changeset in the code host.
+func (s AzureDevOpsSource) CreateChangeset(ctx context.Context, c *Changeset) (bool, error) {
+	return s.createChangeset(ctx, c, false)
+}
+
+// CreateDraftChangeset creates the given changeset on the code host in draft mode.
+func (s AzureDevOpsSource) CreateDraftChangeset(ctx context.Context, c *Changeset) (bool, error) {
+	return s.createChangeset(ctx, c, true)
+}
+
+func (s AzureDevOpsSource) createChangeset(ctx context.Context, c *Changeset, draft bool) (bool, error) {
+	repo := c.Repo.Metadata.(*azuredevops.Repository)
+
+	var exists bool
+	pr, err := s.client.CreatePullRequest(ctx, repo, azuredevops.PullRequestInput{
+		Title:         c.Title,
+		Description:   c.Body,
+		SourceRefName: git.EnsureRefPrefix(c.HeadRef),
+		TargetRefName: git.EnsureRefPrefix(c.BaseRef),
+		IsDraft:       draft,
+	})
+	if err != nil {
+		if !errors.Is(err, azuredevops.ErrPullRequestAlreadyExists) {
+			return exists, err
+		}
+		pr, err = s.client.FindActivePullRequest(ctx, repo, git.EnsureRefPrefix(c.HeadRef), git.EnsureRefPrefix(c.BaseRef))
+		if err != nil {
+			return exists, errors.Wrap(err, "fetching existing PR")
+		}
+		exists = true
+	}
+
+	if err := s.setChangesetMetadata(ctx, repo, pr, c); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// UndraftChangeset marks the given draft pull request as ready for review.
+func (s AzureDevOpsSource) UndraftChangeset(ctx context.Context, c *Changeset) error {
+	isDraft := false
+	return s.updatePullRequest(ctx, c, azuredevops.PullRequestUpdateInput{IsDraft: &isDraft})
+}
+
+// CloseChangeset abandons the given *Changeset on the code host and updates
+// the Metadata column in the *batches.Changeset to the newly abandoned pull
+// request.
+func (s AzureDevOpsSource) CloseChangeset(ctx context.Context, c *Changeset) error {
+	status := azuredevops.PullRequestStateAbandoned
+	return s.updatePullRequest(ctx, c, azuredevops.PullRequestUpdateInput{Status: &status})
+}
+
+// LoadChangeset loads the latest state of the given Changeset from the codehost.
+func (s AzureDevOpsSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	repo := cs.Repo.Metadata.(*azuredevops.Repository)
+	id, err := strconv.ParseInt(cs.ExternalID, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "parsing changeset external ID")
+	}
+
+	pr, err := s.client.GetPullRequest(ctx, repo, id)
+	if err != nil {
+		if azuredevops.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return err
+	}
+
+	return s.setChangesetMetadata(ctx, repo, pr, cs)
+}
+
+// UpdateChangeset can update Changesets.
+func (s AzureDevOpsSource) UpdateChangeset(ctx context.Context, c *Changeset) error {
+	targetRefName := git.EnsureRefPrefix(c.BaseRef)
+	return s.updatePullRequest(ctx, c, azuredevops.PullRequestUpdateInput{
+		Title:         &c.Title,
+		Description:   &c.Body,
+		TargetRefName: &targetRefName,
+	})
+}
+
+// ReopenChangeset reactivates the given abandoned pull request.
+func (s AzureDevOpsSource) ReopenChangeset(ctx context.Context, c *Changeset) error {
+	status := azuredevops.PullRequestStateActive
+	return s.updatePullRequest(ctx, c, azuredevops.PullRequestUpdateInput{Status: &status})
+}
+
+// CreateComment posts a comment on the Changeset.
+func (s AzureDevOpsSource) CreateComment(ctx context.Context, c *Changeset, text string) error {
+	repo := c.Repo.Metadata.(*azuredevops.Repository)
+	pr, ok := c.Changeset.Metadata.(*azuredevops.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an Azure DevOps pull request")
+	}
+
+	return s.client.CreatePullRequestComment(ctx, repo, pr.ID, text)
+}
+
+// MergeChangeset merges a Changeset on the code host, if in a mergeable state.
+// If squash is true, a squash-then-merge merge will be performed.
+func (s AzureDevOpsSource) MergeChangeset(ctx context.Context, c *Changeset, squash bool) error {
+	repo := c.Repo.Metadata.(*azuredevops.Repository)
+	pr, ok := c.Changeset.Metadata.(*azuredevops.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an Azure DevOps pull request")
+	}
+
+	strategy := azuredevops.MergeStrategyNoFastForward
+	if squash {
+		strategy = azuredevops.MergeStrategySquash
+	}
+
+	updated, err := s.client.CompletePullRequest(ctx, repo, pr, strategy)
+	if err != nil {
+		if errors.Is(err, azuredevops.ErrNotMergeable) {
+			return &ChangesetNotMergeableError{ErrorMsg: err.Error()}
+		}
+		return err
+	}
+
+	return s.setChangesetMetadata(ctx, repo, updated, c)
+}
+
+func (s AzureDevOpsSource) updatePullRequest(ctx context.Context, c *Changeset, input azuredevops.PullRequestUpdateInput) error {
+	repo := c.Repo.Metadata.(*azuredevops.Repository)
+	pr, ok := c.Changeset.Metadata.(*azuredevops.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an Azure DevOps pull request")
+	}
+
+	updated, err := s.client.UpdatePullRequest(ctx, repo, pr.ID, input)
+	if err != nil {
+		return err
+	}
+
+	return s.setChangesetMetadata(ctx, repo, updated, c)
+}
+
+func (s AzureDevOpsSource) setChangesetMetadata(ctx context.Context, repo *azuredevops.Repository, pr *azuredevops.PullRequest, c *Changeset) error {
+	statuses, err := s.client.GetPullRequestStatuses(ctx, repo, pr.ID)
+	if err != nil {
+		return errors.Wrap(err, "loading pr statuses")
+	}
+	pr.Statuses = statuses
+
+	// The repository embedded in pull requests returned by the API lacks the
+	// organization and web URL, so we use the one we synced instead.
+	pr.Repository = *repo
+
+	if err := c.SetMetadata(pr); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+	return nil
+}
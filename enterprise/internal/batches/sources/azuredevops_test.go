@@ -0,0 +1,276 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func newAzureDevOpsTestSource(t *testing.T, handler http.HandlerFunc) *AzureDevOpsSource {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	src, err := newAzureDevOpsSource(&schema.AzureDevOpsConnection{
+		Url:   srv.URL,
+		Token: "secret",
+		Orgs:  []string{"myorg"},
+	}, httpcli.NewFactory(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+func newAzureDevOpsTestChangeset(externalID string) *Changeset {
+	return &Changeset{
+		Title:   "Title",
+		Body:    "Body",
+		HeadRef: "refs/heads/feature",
+		BaseRef: "refs/heads/main",
+		Repo: &types.Repo{
+			Metadata: &azuredevops.Repository{
+				ID:      "r1",
+				Name:    "repo",
+				Org:     "myorg",
+				Project: azuredevops.Project{ID: "p1", Name: "project"},
+				WebURL:  "https://dev.azure.com/myorg/project/_git/repo",
+			},
+		},
+		Changeset: &btypes.Changeset{ExternalID: externalID},
+	}
+}
+
+const azureDevOpsTestPullRequest = `{
+	"pullRequestId": 42,
+	"title": "Title",
+	"status": "active",
+	"sourceRefName": "refs/heads/feature",
+	"targetRefName": "refs/heads/main",
+	"lastMergeSourceCommit": {"commitId": "abc"},
+	"lastMergeTargetCommit": {"commitId": "def"},
+	"repository": {"id": "r1", "name": "repo"}
+}`
+
+func TestAzureDevOpsSource_CreateChangeset(t *testing.T) {
+	const prsPath = "/myorg/project/_apis/git/repositories/r1/pullrequests"
+
+	t.Run("created", func(t *testing.T) {
+		src := newAzureDevOpsTestSource(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case prsPath:
+				var input azuredevops.PullRequestInput
+				if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+					t.Fatal(err)
+				}
+				if !input.IsDraft {
+					t.Error("expected draft pull request")
+				}
+				fmt.Fprint(w, azureDevOpsTestPullRequest)
+			case prsPath + "/42/statuses":
+				fmt.Fprint(w, `{"value": [{"id": 1, "state": "succeeded", "context": {"name": "ci"}}]}`)
+			default:
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}
+		})
+
+		cs := newAzureDevOpsTestChangeset("")
+		exists, err := src.CreateDraftChangeset(context.Background(), cs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exists {
+			t.Error("unexpected existing changeset")
+		}
+
+		if have, want := cs.ExternalID, "42"; have != want {
+			t.Errorf("unexpected external ID: have=%q want=%q", have, want)
+		}
+		if have, want := cs.ExternalBranch, "refs/heads/feature"; have != want {
+			t.Errorf("unexpected external branch: have=%q want=%q", have, want)
+		}
+		pr := cs.Changeset.Metadata.(*azuredevops.PullRequest)
+		if len(pr.Statuses) != 1 {
+			t.Errorf("unexpected statuses: %+v", pr.Statuses)
+		}
+		if have, want := pr.WebURL(), "https://dev.azure.com/myorg/project/_git/repo/pullrequest/42"; have != want {
+			t.Errorf("unexpected URL: have=%q want=%q", have, want)
+		}
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		src := newAzureDevOpsTestSource(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == prsPath && r.Method == "POST":
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprint(w, `{"typeKey": "GitPullRequestExistsException"}`)
+			case r.URL.Path == prsPath:
+				fmt.Fprintf(w, `{"value": [%s]}`, azureDevOpsTestPullRequest)
+			case r.URL.Path == prsPath+"/42/statuses":
+				fmt.Fprint(w, `{"value": []}`)
+			default:
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}
+		})
+
+		cs := newAzureDevOpsTestChangeset("")
+		exists, err := src.CreateChangeset(context.Background(), cs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !exists {
+			t.Error("expected existing changeset")
+		}
+		if have, want := cs.ExternalID, "42"; have != want {
+			t.Errorf("unexpected external ID: have=%q want=%q", have, want)
+		}
+	})
+}
+
+func TestAzureDevOpsSource_LoadChangeset(t *testing.T) {
+	src := newAzureDevOpsTestSource(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/myorg/project/_apis/git/repositories/r1/pullrequests/42":
+			fmt.Fprint(w, azureDevOpsTestPullRequest)
+		case "/myorg/project/_apis/git/repositories/r1/pullrequests/42/statuses":
+			fmt.Fprint(w, `{"value": []}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	t.Run("found", func(t *testing.T) {
+		cs := newAzureDevOpsTestChangeset("42")
+		if err := src.LoadChangeset(context.Background(), cs); err != nil {
+			t.Fatal(err)
+		}
+		title, err := cs.Changeset.Title()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := title, "Title"; have != want {
+			t.Errorf("unexpected title: have=%q want=%q", have, want)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		cs := newAzureDevOpsTestChangeset("999")
+		err := src.LoadChangeset(context.Background(), cs)
+		if !errors.HasType(err, ChangesetNotFoundError{}) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestAzureDevOpsSource_CloseChangeset(t *testing.T) {
+	src := newAzureDevOpsTestSource(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/myorg/project/_apis/git/repositories/r1/pullrequests/42":
+			var input azuredevops.PullRequestUpdateInput
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				t.Fatal(err)
+			}
+			if input.Status == nil || *input.Status != azuredevops.PullRequestStateAbandoned {
+				t.Errorf("unexpected input: %+v", input)
+			}
+			fmt.Fprint(w, `{"pullRequestId": 42, "status": "abandoned"}`)
+		case "/myorg/project/_apis/git/repositories/r1/pullrequests/42/statuses":
+			fmt.Fprint(w, `{"value": []}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	cs := newAzureDevOpsTestChangeset("42")
+	cs.Changeset.Metadata = &azuredevops.PullRequest{ID: 42}
+	if err := src.CloseChangeset(context.Background(), cs); err != nil {
+		t.Fatal(err)
+	}
+	if have, want := cs.Changeset.Metadata.(*azuredevops.PullRequest).Status, azuredevops.PullRequestStateAbandoned; have != want {
+		t.Errorf("unexpected status: have=%q want=%q", have, want)
+	}
+}
+
+func TestAzureDevOpsSource_MergeChangeset(t *testing.T) {
+	src := newAzureDevOpsTestSource(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "TF401027: policies must be satisfied"}`)
+	})
+
+	cs := newAzureDevOpsTestChangeset("42")
+	cs.Changeset.Metadata = &azuredevops.PullRequest{ID: 42, LastMergeSourceCommit: &azuredevops.Commit{CommitID: "abc"}}
+
+	err := src.MergeChangeset(context.Background(), cs, true)
+	var e *ChangesetNotMergeableError
+	if !errors.As(err, &e) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestAzureDevOpsSource_WithAuthenticator(t *testing.T) {
+	svc := &types.ExternalService{
+		Kind: extsvc.KindAzureDevOps,
+		Config: marshalJSON(t, &schema.AzureDevOpsConnection{
+			Url:   "https://dev.azure.com",
+			Token: "secret",
+		}),
+	}
+
+	adoSrc, err := NewAzureDevOpsSource(svc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("supported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"BasicAuth":        &auth.BasicAuth{},
+			"BasicAuthWithSSH": &auth.BasicAuthWithSSH{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				src, err := adoSrc.WithAuthenticator(tc)
+				if err != nil {
+					t.Errorf("unexpected non-nil error: %v", err)
+				}
+
+				if as, ok := src.(*AzureDevOpsSource); !ok {
+					t.Error("cannot coerce Source into AzureDevOpsSource")
+				} else if as.au != tc {
+					t.Errorf("incorrect authenticator: have=%v want=%v", as.au, tc)
+				}
+			})
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"nil":              nil,
+			"OAuthBearerToken": &auth.OAuthBearerToken{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				src, err := adoSrc.WithAuthenticator(tc)
+				if err == nil {
+					t.Error("unexpected nil error")
+				} else if !errors.HasType(err, UnsupportedAuthenticatorError{}) {
+					t.Errorf("unexpected error of type %T: %v", err, err)
+				}
+				if src != nil {
+					t.Errorf("expected nil Source: %v", src)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,198 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func newBitbucketCloudTestSource(t *testing.T, handler http.HandlerFunc) *BitbucketCloudSource {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	src, err := newBitbucketCloudSource(&schema.BitbucketCloudConnection{
+		ApiURL:      srv.URL,
+		Url:         "https://bitbucket.org",
+		Username:    "user",
+		AppPassword: "password",
+	}, httpcli.NewFactory(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+func newBitbucketCloudTestChangeset(externalID string) *Changeset {
+	return &Changeset{
+		Title:   "Title",
+		Body:    "Body",
+		HeadRef: "refs/heads/feature",
+		BaseRef: "refs/heads/main",
+		Repo: &types.Repo{
+			Metadata: &bitbucketcloud.Repo{FullName: "sglocal/mux", UUID: "{repo}"},
+		},
+		Changeset: &btypes.Changeset{ExternalID: externalID},
+	}
+}
+
+const bitbucketCloudTestPullRequest = `{
+	"id": 42,
+	"title": "Title",
+	"state": "OPEN",
+	"source": {"branch": {"name": "feature"}, "commit": {"hash": "abc"}},
+	"destination": {"branch": {"name": "main"}, "commit": {"hash": "def"}},
+	"links": {"html": {"href": "https://bitbucket.org/sglocal/mux/pull-requests/42"}}
+}`
+
+func TestBitbucketCloudSource_CreateChangeset(t *testing.T) {
+	src := newBitbucketCloudTestSource(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/repositories/sglocal/mux/pullrequests":
+			fmt.Fprint(w, bitbucketCloudTestPullRequest)
+		case "/2.0/repositories/sglocal/mux/pullrequests/42/statuses":
+			fmt.Fprint(w, `{"values": [{"key": "ci", "state": "SUCCESSFUL", "commit": {"hash": "abc"}}]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	cs := newBitbucketCloudTestChangeset("")
+	exists, err := src.CreateChangeset(context.Background(), cs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Error("expected Bitbucket Cloud changesets to always be reported as existing")
+	}
+
+	if have, want := cs.ExternalID, "42"; have != want {
+		t.Errorf("unexpected external ID: have=%q want=%q", have, want)
+	}
+	if have, want := cs.ExternalBranch, "refs/heads/feature"; have != want {
+		t.Errorf("unexpected external branch: have=%q want=%q", have, want)
+	}
+	pr := cs.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+	if len(pr.Statuses) != 1 {
+		t.Errorf("unexpected statuses: %+v", pr.Statuses)
+	}
+}
+
+func TestBitbucketCloudSource_LoadChangeset(t *testing.T) {
+	src := newBitbucketCloudTestSource(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/repositories/sglocal/mux/pullrequests/42":
+			fmt.Fprint(w, bitbucketCloudTestPullRequest)
+		case "/2.0/repositories/sglocal/mux/pullrequests/42/statuses":
+			fmt.Fprint(w, `{"values": []}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	t.Run("found", func(t *testing.T) {
+		cs := newBitbucketCloudTestChangeset("42")
+		if err := src.LoadChangeset(context.Background(), cs); err != nil {
+			t.Fatal(err)
+		}
+		title, err := cs.Changeset.Title()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := title, "Title"; have != want {
+			t.Errorf("unexpected title: have=%q want=%q", have, want)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		cs := newBitbucketCloudTestChangeset("999")
+		err := src.LoadChangeset(context.Background(), cs)
+		if !errors.HasType(err, ChangesetNotFoundError{}) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestBitbucketCloudSource_MergeChangeset(t *testing.T) {
+	src := newBitbucketCloudTestSource(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"type": "error", "error": {"message": "merge conflict"}}`)
+	})
+
+	cs := newBitbucketCloudTestChangeset("42")
+	cs.Changeset.Metadata = &bitbucketcloud.PullRequest{ID: 42}
+
+	err := src.MergeChangeset(context.Background(), cs, true)
+	var e *ChangesetNotMergeableError
+	if !errors.As(err, &e) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestBitbucketCloudSource_WithAuthenticator(t *testing.T) {
+	svc := &types.ExternalService{
+		Kind: extsvc.KindBitbucketCloud,
+		Config: marshalJSON(t, &schema.BitbucketCloudConnection{
+			Url:         "https://bitbucket.org",
+			Username:    "user",
+			AppPassword: "password",
+		}),
+	}
+
+	bbcSrc, err := NewBitbucketCloudSource(svc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("supported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"BasicAuth":        &auth.BasicAuth{},
+			"BasicAuthWithSSH": &auth.BasicAuthWithSSH{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				src, err := bbcSrc.WithAuthenticator(tc)
+				if err != nil {
+					t.Errorf("unexpected non-nil error: %v", err)
+				}
+
+				if bs, ok := src.(*BitbucketCloudSource); !ok {
+					t.Error("cannot coerce Source into BitbucketCloudSource")
+				} else if bs.au != tc {
+					t.Errorf("incorrect authenticator: have=%v want=%v", bs.au, tc)
+				}
+			})
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"nil":              nil,
+			"OAuthBearerToken": &auth.OAuthBearerToken{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				src, err := bbcSrc.WithAuthenticator(tc)
+				if err == nil {
+					t.Error("unexpected nil error")
+				} else if !errors.HasType(err, UnsupportedAuthenticatorError{}) {
+					t.Errorf("unexpected error of type %T: %v", err, err)
+				}
+				if src != nil {
+					t.Errorf("expected nil Source: %v", src)
+				}
+			})
+		}
+	})
+}
@@ -104,6 +104,9 @@ func (s BitbucketServerSource) CreateChangeset(ctx context.Context, c *Changeset
 	repo := c.Repo.Metadata.(*bitbucketserver.Repo)
 
 	pr := &bitbucketserver.PullRequest{Title: c.Title, Description: c.Body}
+	for _, name := range c.Reviewers {
+		pr.Reviewers = append(pr.Reviewers, bitbucketserver.Reviewer{User: &bitbucketserver.User{Name: name}})
+	}
 
 	pr.ToRef.Repository.Slug = repo.Slug
 	pr.ToRef.Repository.ID = repo.ID
@@ -137,6 +140,12 @@ func (s BitbucketServerSource) CreateChangeset(ctx context.Context, c *Changeset
 		return false, errors.Wrap(err, "setting changeset metadata")
 	}
 
+	// The reviewers of a pull request that already existed still need to be
+	// added.
+	if exists && len(c.Reviewers) > 0 {
+		return exists, s.UpdateChangeset(ctx, c)
+	}
+
 	return exists, nil
 }
 
@@ -215,6 +224,7 @@ func (s BitbucketServerSource) UpdateChangeset(ctx context.Context, c *Changeset
 		Title:         c.Title,
 		Description:   c.Body,
 		Version:       pr.Version,
+		Reviewers:     addReviewerNames(pr.Reviewers, c.Reviewers),
 	}
 	update.ToRef.ID = c.BaseRef
 	update.ToRef.Repository.Slug = pr.ToRef.Repository.Slug
@@ -228,6 +238,35 @@ func (s BitbucketServerSource) UpdateChangeset(ctx context.Context, c *Changeset
 	return c.Changeset.SetMetadata(updated)
 }
 
+// addReviewerNames returns the names of the given reviewers, followed by the
+// given names that aren't among them yet. If no names are given, nil is
+// returned so that the reviewers of a pull request are left untouched.
+//
+// Bitbucket Server doesn't support labels or assignees on pull requests, so
+// those are ignored.
+func addReviewerNames(reviewers []bitbucketserver.Reviewer, names []string) []string {
+	if len(names) == 0 {
+		return nil
+	}
+
+	all := make([]string, 0, len(reviewers)+len(names))
+	seen := make(map[string]struct{}, len(reviewers)+len(names))
+	for _, r := range reviewers {
+		if r.User == nil {
+			continue
+		}
+		all = append(all, r.User.Name)
+		seen[r.User.Name] = struct{}{}
+	}
+	for _, name := range names {
+		if _, ok := seen[name]; !ok {
+			all = append(all, name)
+			seen[name] = struct{}{}
+		}
+	}
+	return all
+}
+
 // ReopenChangeset reopens the *Changeset on the code host and updates the
 // Metadata column in the *batches.Changeset.
 func (s BitbucketServerSource) ReopenChangeset(ctx context.Context, c *Changeset) error {
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
 	"github.com/inconshreveable/log15"
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
@@ -490,6 +491,29 @@ func TestBitbucketServerSource_CreateComment(t *testing.T) {
 	}
 }
 
+func TestAddReviewerNames(t *testing.T) {
+	reviewers := []bitbucketserver.Reviewer{
+		{User: &bitbucketserver.User{Name: "alice"}},
+		{User: &bitbucketserver.User{Name: "bob"}},
+	}
+
+	for _, tc := range []struct {
+		name  string
+		names []string
+		want  []string
+	}{
+		{name: "no names", names: nil, want: nil},
+		{name: "new names", names: []string{"carol", "bob"}, want: []string{"alice", "bob", "carol"}},
+		{name: "existing names", names: []string{"alice"}, want: []string{"alice", "bob"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, addReviewerNames(reviewers, tc.names)); diff != "" {
+				t.Errorf("unexpected reviewers (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestBitbucketServerSource_WithAuthenticator(t *testing.T) {
 	svc := &types.ExternalService{
 		Kind: extsvc.KindBitbucketServer,
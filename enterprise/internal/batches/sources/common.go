@@ -97,6 +97,13 @@ type Changeset struct {
 	HeadRef string
 	BaseRef string
 
+	// Labels, Reviewers and Assignees are added to the changeset when it is
+	// created or updated. Sources never remove the ones that are already set
+	// on the code host.
+	Labels    []string
+	Reviewers []string
+	Assignees []string
+
 	*btypes.Changeset
 	*types.Repo
 }
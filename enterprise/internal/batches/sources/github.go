@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -21,8 +22,9 @@ import (
 )
 
 type GithubSource struct {
-	client *github.V4Client
-	au     auth.Authenticator
+	client   *github.V4Client
+	v3Client *github.V3Client
+	au       auth.Authenticator
 }
 
 func NewGithubSource(svc *types.ExternalService, cf *httpcli.Factory) (*GithubSource, error) {
@@ -30,10 +32,10 @@ func NewGithubSource(svc *types.ExternalService, cf *httpcli.Factory) (*GithubSo
 	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
 		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
 	}
-	return newGithubSource(&c, cf, nil)
+	return newGithubSource(svc.ID, &c, cf, nil)
 }
 
-func newGithubSource(c *schema.GitHubConnection, cf *httpcli.Factory, au auth.Authenticator) (*GithubSource, error) {
+func newGithubSource(externalServiceID int64, c *schema.GitHubConnection, cf *httpcli.Factory, au auth.Authenticator) (*GithubSource, error) {
 	baseURL, err := url.Parse(c.Url)
 	if err != nil {
 		return nil, err
@@ -63,12 +65,15 @@ func newGithubSource(c *schema.GitHubConnection, cf *httpcli.Factory, au auth.Au
 
 	var authr = au
 	if au == nil {
-		authr = &auth.OAuthBearerToken{Token: c.Token}
+		if authr, err = github.NewConnectionAuthenticator(externalServiceID, apiURL, c, cli); err != nil {
+			return nil, err
+		}
 	}
 
 	return &GithubSource{
-		au:     authr,
-		client: github.NewV4Client(apiURL, authr, cli),
+		au:       authr,
+		client:   github.NewV4Client(apiURL, authr, cli),
+		v3Client: github.NewV3Client(apiURL, authr, cli),
 	}, nil
 }
 
@@ -89,6 +94,7 @@ func (s GithubSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource,
 	sc := s
 	sc.au = a
 	sc.client = sc.client.WithAuthenticator(a)
+	sc.v3Client = sc.v3Client.WithAuthenticator(a)
 
 	return &sc, nil
 }
@@ -140,6 +146,10 @@ func (s GithubSource) createChangeset(ctx context.Context, c *Changeset, prInput
 		exists = true
 	}
 
+	if err := s.addLabelsReviewersAndAssignees(ctx, c, pr); err != nil {
+		return exists, err
+	}
+
 	if err := c.SetMetadata(pr); err != nil {
 		return false, errors.Wrap(err, "setting changeset metadata")
 	}
@@ -147,6 +157,89 @@ func (s GithubSource) createChangeset(ctx context.Context, c *Changeset, prInput
 	return exists, nil
 }
 
+// addLabelsReviewersAndAssignees adds the labels, reviewers and assignees of
+// the given *Changeset to the pull request, leaving the ones that are already
+// set untouched. If anything was added, the pull request is reloaded.
+func (s GithubSource) addLabelsReviewersAndAssignees(ctx context.Context, c *Changeset, pr *github.PullRequest) error {
+	if len(c.Labels) == 0 && len(c.Reviewers) == 0 && len(c.Assignees) == 0 {
+		return nil
+	}
+
+	repo := c.Repo.Metadata.(*github.Repository)
+	owner, name, err := github.SplitRepositoryNameWithOwner(repo.NameWithOwner)
+	if err != nil {
+		return errors.Wrap(err, "getting repo owner and name")
+	}
+
+	if len(c.Labels) > 0 {
+		if err := s.v3Client.AddIssueLabels(ctx, owner, name, pr.Number, c.Labels); err != nil {
+			return errors.Wrap(err, "adding labels")
+		}
+	}
+
+	reviewers, teamReviewers, err := newPullRequestReviewers(owner, c.Reviewers, pr)
+	if err != nil {
+		return err
+	}
+	if len(reviewers) > 0 || len(teamReviewers) > 0 {
+		if err := s.v3Client.RequestPullRequestReviewers(ctx, owner, name, pr.Number, reviewers, teamReviewers); err != nil {
+			return errors.Wrap(err, "requesting reviewers")
+		}
+	}
+
+	if len(c.Assignees) > 0 {
+		if err := s.v3Client.AddIssueAssignees(ctx, owner, name, pr.Number, c.Assignees); err != nil {
+			return errors.Wrap(err, "adding assignees")
+		}
+	}
+
+	pr.RepoWithOwner = repo.NameWithOwner
+	return errors.Wrap(s.client.LoadPullRequest(ctx, pr), "reloading pull request")
+}
+
+// newPullRequestReviewers returns the users and team slugs of reviewers that
+// a review of pr still has to be requested from. Reviews can be requested from
+// users and from teams, which are given as org/team-slug and must belong to the
+// owner of the repository. Reviewers that a review is already requested from
+// or that already reviewed pr are skipped, so that updating a changeset does
+// not request their review again. GitHub rejects requesting a review from the
+// author of the pull request, so they are skipped too.
+func newPullRequestReviewers(owner string, reviewers []string, pr *github.PullRequest) (users, teams []string, err error) {
+	requestedUsers := map[string]bool{strings.ToLower(pr.Author.Login): true}
+	requestedTeams := map[string]bool{}
+	reviewed := map[string]bool{}
+	for _, item := range pr.TimelineItems {
+		switch e := item.Item.(type) {
+		case *github.ReviewRequestedEvent:
+			requestedUsers[strings.ToLower(e.RequestedReviewer.Login)] = true
+			requestedTeams[strings.ToLower(e.RequestedTeam.Slug)] = true
+		case *github.ReviewRequestRemovedEvent:
+			delete(requestedUsers, strings.ToLower(e.RequestedReviewer.Login))
+			delete(requestedTeams, strings.ToLower(e.RequestedTeam.Slug))
+		case *github.PullRequestReview:
+			reviewed[strings.ToLower(e.Author.Login)] = true
+		}
+	}
+
+	for _, r := range reviewers {
+		i := strings.Index(r, "/")
+		if i < 0 {
+			if login := strings.ToLower(r); !requestedUsers[login] && !reviewed[login] {
+				users = append(users, r)
+			}
+			continue
+		}
+		org, team := r[:i], r[i+1:]
+		if team == "" || !strings.EqualFold(org, owner) {
+			return nil, nil, errors.Errorf("team reviewer %q does not belong to the repository owner %q", r, owner)
+		}
+		if !requestedTeams[strings.ToLower(team)] {
+			teams = append(teams, team)
+		}
+	}
+	return users, teams, nil
+}
+
 // CloseChangeset closes the given *Changeset on the code host and updates the
 // Metadata column in the *batches.Changeset to the newly closed pull request.
 func (s GithubSource) CloseChangeset(ctx context.Context, c *Changeset) error {
@@ -223,6 +316,10 @@ func (s GithubSource) UpdateChangeset(ctx context.Context, c *Changeset) error {
 		return err
 	}
 
+	if err := s.addLabelsReviewersAndAssignees(ctx, c, updated); err != nil {
+		return err
+	}
+
 	return c.Changeset.SetMetadata(updated)
 }
 
@@ -498,3 +498,45 @@ func TestGithubSource_WithAuthenticator(t *testing.T) {
 		}
 	})
 }
+
+func TestNewPullRequestReviewers(t *testing.T) {
+	pr := &github.PullRequest{
+		Author: github.Actor{Login: "author"},
+		TimelineItems: []github.TimelineItem{
+			{Type: "ReviewRequestedEvent", Item: &github.ReviewRequestedEvent{RequestedReviewer: github.Actor{Login: "Requested"}}},
+			{Type: "ReviewRequestedEvent", Item: &github.ReviewRequestedEvent{RequestedTeam: github.Team{Slug: "requested-team"}}},
+			{Type: "ReviewRequestedEvent", Item: &github.ReviewRequestedEvent{RequestedReviewer: github.Actor{Login: "removed"}}},
+			{Type: "ReviewRequestRemovedEvent", Item: &github.ReviewRequestRemovedEvent{RequestedReviewer: github.Actor{Login: "removed"}}},
+			{Type: "PullRequestReview", Item: &github.PullRequestReview{Author: github.Actor{Login: "reviewed"}}},
+		},
+	}
+
+	t.Run("skips requested and reviewed", func(t *testing.T) {
+		users, teams, err := newPullRequestReviewers("sourcegraph", []string{
+			"author",
+			"requested",
+			"removed",
+			"reviewed",
+			"new",
+			"sourcegraph/requested-team",
+			"Sourcegraph/new-team",
+		}, pr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := strings.Join(users, ","), "removed,new"; have != want {
+			t.Errorf("unexpected users: have %q, want %q", have, want)
+		}
+		if have, want := strings.Join(teams, ","), "new-team"; have != want {
+			t.Errorf("unexpected teams: have %q, want %q", have, want)
+		}
+	})
+
+	t.Run("rejects teams of other orgs", func(t *testing.T) {
+		for _, r := range []string{"other/team", "sourcegraph/"} {
+			if _, _, err := newPullRequestReviewers("sourcegraph", []string{r}, pr); err == nil {
+				t.Errorf("expected an error for %q", r)
+			}
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 
@@ -110,11 +111,23 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 	source := git.AbbreviateRef(c.HeadRef)
 	target := git.AbbreviateRef(c.BaseRef)
 
+	assigneeIDs, err := s.addUserIDs(ctx, nil, c.Assignees)
+	if err != nil {
+		return exists, errors.Wrap(err, "looking up assignees")
+	}
+	reviewerIDs, err := s.addUserIDs(ctx, nil, c.Reviewers)
+	if err != nil {
+		return exists, errors.Wrap(err, "looking up reviewers")
+	}
+
 	mr, err := s.client.CreateMergeRequest(ctx, project, gitlab.CreateMergeRequestOpts{
 		SourceBranch: source,
 		TargetBranch: target,
 		Title:        c.Title,
 		Description:  c.Body,
+		Labels:       strings.Join(c.Labels, ","),
+		AssigneeIDs:  assigneeIDs,
+		ReviewerIDs:  reviewerIDs,
 	})
 	if err != nil {
 		if err == gitlab.ErrMergeRequestAlreadyExists {
@@ -137,6 +150,12 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 	if err := c.SetMetadata(mr); err != nil {
 		return exists, errors.Wrap(err, "setting changeset metadata")
 	}
+
+	// The labels, assignees and reviewers of a merge request that already
+	// existed still need to be added.
+	if exists && (len(c.Labels) > 0 || len(c.Assignees) > 0 || len(c.Reviewers) > 0) {
+		return exists, s.UpdateChangeset(ctx, c)
+	}
 	return exists, nil
 }
 
@@ -399,10 +418,22 @@ func (s *GitLabSource) UpdateChangeset(ctx context.Context, c *Changeset) error
 		title = gitlab.SetWIP(c.Title)
 	}
 
+	assigneeIDs, err := s.addUserIDs(ctx, mr.Assignees, c.Assignees)
+	if err != nil {
+		return errors.Wrap(err, "looking up assignees")
+	}
+	reviewerIDs, err := s.addUserIDs(ctx, mr.Reviewers, c.Reviewers)
+	if err != nil {
+		return errors.Wrap(err, "looking up reviewers")
+	}
+
 	updated, err := s.client.UpdateMergeRequest(ctx, project, mr, gitlab.UpdateMergeRequestOpts{
 		Title:        title,
 		Description:  c.Body,
 		TargetBranch: git.AbbreviateRef(c.BaseRef),
+		AddLabels:    strings.Join(c.Labels, ","),
+		AssigneeIDs:  assigneeIDs,
+		ReviewerIDs:  reviewerIDs,
 	})
 	if err != nil {
 		return errors.Wrap(err, "updating GitLab merge request")
@@ -416,6 +447,40 @@ func (s *GitLabSource) UpdateChangeset(ctx context.Context, c *Changeset) error
 	return c.Changeset.SetMetadata(updated)
 }
 
+// addUserIDs returns the IDs of the given users, followed by the IDs of the
+// users with the given usernames that aren't among them yet. If no usernames
+// are given, nil is returned so that the users of a merge request are left
+// untouched.
+func (s *GitLabSource) addUserIDs(ctx context.Context, users []gitlab.User, usernames []string) ([]int32, error) {
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int32, 0, len(users)+len(usernames))
+	seen := make(map[int32]struct{}, len(users)+len(usernames))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+		seen[u.ID] = struct{}{}
+	}
+
+	for _, username := range usernames {
+		q := make(url.Values)
+		q.Add("username", username)
+		found, _, err := s.client.ListUsers(ctx, "users?"+q.Encode())
+		if err != nil {
+			return nil, err
+		}
+		if len(found) == 0 {
+			return nil, errors.Errorf("GitLab user %q not found", username)
+		}
+		if _, ok := seen[found[0].ID]; !ok {
+			ids = append(ids, found[0].ID)
+			seen[found[0].ID] = struct{}{}
+		}
+	}
+	return ids, nil
+}
+
 // UndraftChangeset marks the changeset as *not* work in progress anymore.
 func (s *GitLabSource) UndraftChangeset(ctx context.Context, c *Changeset) error {
 	mr, ok := c.Changeset.Metadata.(*gitlab.MergeRequest)
@@ -640,6 +640,79 @@ func TestGitLabSource_ChangesetSource(t *testing.T) {
 		}
 	})
 
+	t.Run("labels, reviewers and assignees", func(t *testing.T) {
+		t.Run("new merge request", func(t *testing.T) {
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Labels = []string{"automation", "security"}
+			p.changeset.Assignees = []string{"alice"}
+			p.changeset.Reviewers = []string{"bob", "alice"}
+			p.mockListUsers(map[string]int32{"alice": 1, "bob": 2})
+
+			gitlab.MockCreateMergeRequest = func(client *gitlab.Client, ctx context.Context, project *gitlab.Project, opts gitlab.CreateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+				if have, want := opts.Labels, "automation,security"; have != want {
+					t.Errorf("unexpected labels: have=%q want=%q", have, want)
+				}
+				if diff := cmp.Diff([]int32{1}, opts.AssigneeIDs); diff != "" {
+					t.Errorf("unexpected assignees (-want +got):\n%s", diff)
+				}
+				if diff := cmp.Diff([]int32{2, 1}, opts.ReviewerIDs); diff != "" {
+					t.Errorf("unexpected reviewers (-want +got):\n%s", diff)
+				}
+				return p.mr, nil
+			}
+			p.mockGetMergeRequestNotes(p.mr.IID, nil, 20, nil)
+			p.mockGetMergeRequestResourceStateEvents(p.mr.IID, nil, 20, nil)
+			p.mockGetMergeRequestPipelines(p.mr.IID, nil, 20, nil)
+
+			if _, err := p.source.CreateChangeset(p.ctx, p.changeset); err != nil {
+				t.Errorf("unexpected non-nil err: %+v", err)
+			}
+		})
+
+		t.Run("existing merge request", func(t *testing.T) {
+			in := &gitlab.MergeRequest{IID: 2, Assignees: []gitlab.User{{ID: 3}}, Reviewers: []gitlab.User{{ID: 2}}}
+			out := &gitlab.MergeRequest{}
+
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Changeset.Metadata = in
+			p.changeset.Labels = []string{"automation"}
+			p.changeset.Assignees = []string{"alice"}
+			p.changeset.Reviewers = []string{"bob"}
+			p.mockListUsers(map[string]int32{"alice": 1, "bob": 2})
+
+			gitlab.MockUpdateMergeRequest = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest, opts gitlab.UpdateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+				if have, want := opts.AddLabels, "automation"; have != want {
+					t.Errorf("unexpected labels: have=%q want=%q", have, want)
+				}
+				// Existing assignees and reviewers are kept.
+				if diff := cmp.Diff([]int32{3, 1}, opts.AssigneeIDs); diff != "" {
+					t.Errorf("unexpected assignees (-want +got):\n%s", diff)
+				}
+				if diff := cmp.Diff([]int32{2}, opts.ReviewerIDs); diff != "" {
+					t.Errorf("unexpected reviewers (-want +got):\n%s", diff)
+				}
+				return out, nil
+			}
+			p.mockGetMergeRequestNotes(in.IID, nil, 20, nil)
+			p.mockGetMergeRequestResourceStateEvents(in.IID, nil, 20, nil)
+			p.mockGetMergeRequestPipelines(in.IID, nil, 20, nil)
+
+			if err := p.source.UpdateChangeset(p.ctx, p.changeset); err != nil {
+				t.Errorf("unexpected non-nil error: %+v", err)
+			}
+		})
+
+		t.Run("unknown user", func(t *testing.T) {
+			p := newGitLabChangesetSourceTestProvider(t)
+			p.changeset.Reviewers = []string{"nobody"}
+			p.mockListUsers(map[string]int32{})
+
+			if _, err := p.source.CreateChangeset(p.ctx, p.changeset); err == nil {
+				t.Error("unexpected nil error")
+			}
+		})
+	})
+
 	t.Run("UndraftChangeset", func(t *testing.T) {
 		in := &gitlab.MergeRequest{IID: 2, WorkInProgress: true}
 		out := &gitlab.MergeRequest{}
@@ -1006,6 +1079,22 @@ func (p *gitLabChangesetSourceTestProvider) mockCreateComment(expected string, m
 	}
 }
 
+// mockListUsers mocks gitlab.ListUsers calls that look users up by username,
+// returning the user with the ID in the given map, if any.
+func (p *gitLabChangesetSourceTestProvider) mockListUsers(ids map[string]int32) {
+	gitlab.MockListUsers = func(client *gitlab.Client, ctx context.Context, urlStr string) ([]*gitlab.User, *string, error) {
+		u, err := url.Parse(urlStr)
+		if err != nil {
+			p.t.Fatal(err)
+		}
+		username := u.Query().Get("username")
+		if id, ok := ids[username]; ok {
+			return []*gitlab.User{{ID: id, Username: username}}, nil, nil
+		}
+		return []*gitlab.User{}, nil, nil
+	}
+}
+
 func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockCreateMergeRequest = nil
 	gitlab.MockGetMergeRequest = nil
@@ -1015,6 +1104,7 @@ func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockGetOpenMergeRequestByRefs = nil
 	gitlab.MockUpdateMergeRequest = nil
 	gitlab.MockCreateMergeRequestNote = nil
+	gitlab.MockListUsers = nil
 }
 
 // panicDoer provides a httpcli.Doer implementation that panics if any attempt
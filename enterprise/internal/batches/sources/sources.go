@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/repos"
@@ -166,6 +167,18 @@ func gitserverPushConfig(ctx context.Context, store database.ExternalServiceStor
 			return nil, err
 		}
 
+	case *github.InstallationAuthenticator:
+		if extSvcType != extsvc.TypeGitHub {
+			return nil, ErrNoPushCredentials{CredentialsType: fmt.Sprintf("%T", au)}
+		}
+		// Installation access tokens expire, so a current one is requested for
+		// each push.
+		token, err := av.Token(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting GitHub App installation access token")
+		}
+		u.User = url.UserPassword("x-access-token", token)
+
 	case *auth.BasicAuthWithSSH:
 		if err := setBasicAuth(u, extSvcType, av.Username, av.Password); err != nil {
 			return nil, err
@@ -232,7 +245,8 @@ func WithSiteAuthenticator(ctx context.Context, tx SourcerStore, css ChangesetSo
 }
 
 // loadExternalService looks up all external services that are connected to the given repo.
-// The first external service to have a token configured will be returned then.
+// The first external service to have a token or, for GitHub, App installation
+// credentials configured will be returned then.
 // If no external service matching the above criteria is found, an error is returned.
 func loadExternalService(ctx context.Context, s database.ExternalServiceStore, opts database.ExternalServicesListOptions) (*types.ExternalService, error) {
 	es, err := s.List(ctx, opts)
@@ -254,13 +268,21 @@ func loadExternalService(ctx context.Context, s database.ExternalServiceStore, o
 
 		switch cfg := cfg.(type) {
 		case *schema.GitHubConnection:
-			if cfg.Token != "" {
+			if cfg.Token != "" || cfg.GithubAppInstallation != nil {
 				return e, nil
 			}
 		case *schema.BitbucketServerConnection:
 			if cfg.Token != "" {
 				return e, nil
 			}
+		case *schema.BitbucketCloudConnection:
+			if cfg.AppPassword != "" {
+				return e, nil
+			}
+		case *schema.AzureDevOpsConnection:
+			if cfg.Token != "" {
+				return e, nil
+			}
 		case *schema.GitLabConnection:
 			if cfg.Token != "" {
 				return e, nil
@@ -282,6 +304,10 @@ func buildChangesetSource(store SourcerStore, cf *httpcli.Factory, externalServi
 		return NewGitLabSource(externalService, cf)
 	case extsvc.KindBitbucketServer:
 		return NewBitbucketServerSource(externalService, cf)
+	case extsvc.KindBitbucketCloud:
+		return NewBitbucketCloudSource(externalService, cf)
+	case extsvc.KindAzureDevOps:
+		return NewAzureDevOpsSource(externalService, cf)
 	default:
 		return nil, errors.Errorf("unsupported external service type %q", extsvc.KindToType(externalService.Kind))
 	}
@@ -334,6 +360,12 @@ func setOAuthTokenAuth(u *vcs.URL, extSvcType, token string) error {
 	case extsvc.TypeBitbucketServer:
 		return errors.New("require username/token to push commits to BitbucketServer")
 
+	case extsvc.TypeBitbucketCloud:
+		return errors.New("require username/app password to push commits to Bitbucket Cloud")
+
+	case extsvc.TypeAzureDevOps:
+		return errors.New("require username/personal access token to push commits to Azure DevOps")
+
 	default:
 		panic(fmt.Sprintf("setOAuthTokenAuth: invalid external service type %q", extSvcType))
 	}
@@ -347,7 +379,7 @@ func setBasicAuth(u *vcs.URL, extSvcType, username, password string) error {
 	case extsvc.TypeGitHub, extsvc.TypeGitLab:
 		return errors.New("need token to push commits to " + extSvcType)
 
-	case extsvc.TypeBitbucketServer:
+	case extsvc.TypeBitbucketServer, extsvc.TypeBitbucketCloud, extsvc.TypeAzureDevOps:
 		u.User = url.UserPassword(username, password)
 
 	default:
@@ -378,7 +410,7 @@ func extractCloneURL(ctx context.Context, s database.ExternalServiceStore, repo
 	for _, svc := range svcs {
 		// build the clone url using the external service config instead of using
 		// the source CloneURL field
-		cloneURL, err := repos.CloneURL(svc.Kind, svc.Config, repo)
+		cloneURL, err := repos.CloneURL(svc.ID, svc.Kind, svc.Config, repo)
 		if err != nil {
 			return "", err
 		}
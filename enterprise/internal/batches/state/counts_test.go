@@ -9,6 +9,7 @@ import (
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -1072,6 +1073,46 @@ func TestCalcCounts(t *testing.T) {
 				{Time: daysAgo(0), Total: 1, Open: 1, OpenPending: 0, OpenApproved: 1},
 			},
 		},
+		{
+			codehosts: "bitbucketcloud",
+			name:      "single changeset open, changes requested, unapproved",
+			changesets: []*btypes.Changeset{
+				bbcChangeset(1, daysAgo(3)),
+			},
+			start: daysAgo(4),
+			events: []*btypes.ChangesetEvent{
+				bbcParticipant(1, daysAgo(2), "user1", btypes.ChangesetEventKindBitbucketCloudChangesRequested),
+				bbcUnapproved(1, daysAgo(1), "user1"),
+			},
+			want: []*ChangesetCounts{
+				{Time: daysAgo(4), Total: 0, Open: 0},
+				{Time: daysAgo(3), Total: 1, Open: 1, OpenPending: 1},
+				{Time: daysAgo(2), Total: 1, Open: 1, OpenPending: 0, OpenChangesRequested: 1},
+				{Time: daysAgo(1), Total: 1, Open: 1, OpenPending: 0, OpenChangesRequested: 1},
+				{Time: daysAgo(0), Total: 1, Open: 1, OpenPending: 0, OpenChangesRequested: 1},
+			},
+		},
+		{
+			codehosts: "bitbucketcloud",
+			name:      "single changeset open, approved, changes request removed, unapproved",
+			changesets: []*btypes.Changeset{
+				bbcChangeset(1, daysAgo(4)),
+			},
+			start: daysAgo(5),
+			events: []*btypes.ChangesetEvent{
+				bbcParticipant(1, daysAgo(3), "user1", btypes.ChangesetEventKindBitbucketCloudApproved),
+				bbcChangesRequestRemoved(1, daysAgo(2), "user1"),
+				bbcUnapproved(1, daysAgo(1), "user1"),
+			},
+			want: []*ChangesetCounts{
+				{Time: daysAgo(5), Total: 0, Open: 0},
+				{Time: daysAgo(4), Total: 1, Open: 1, OpenPending: 1},
+				{Time: daysAgo(3), Total: 1, Open: 1, OpenPending: 0, OpenApproved: 1},
+				{Time: daysAgo(2), Total: 1, Open: 1, OpenPending: 0, OpenApproved: 1},
+				{Time: daysAgo(1), Total: 1, Open: 1, OpenPending: 1, OpenApproved: 0},
+				{Time: daysAgo(0), Total: 1, Open: 1, OpenPending: 1, OpenApproved: 0},
+			},
+		},
 		{
 			codehosts: "github and bitbucketserver",
 			name:      "multiple changesets on different code hosts in different review stages before merge",
@@ -1475,6 +1516,13 @@ func bbsChangeset(id int64, t time.Time) *btypes.Changeset {
 	}
 }
 
+func bbcChangeset(id int64, t time.Time) *btypes.Changeset {
+	return &btypes.Changeset{
+		ID:       id,
+		Metadata: &bitbucketcloud.PullRequest{CreatedOn: t},
+	}
+}
+
 func glChangeset(id int64, t time.Time) *btypes.Changeset {
 	return &btypes.Changeset{
 		ID:       id,
@@ -1664,3 +1712,40 @@ func bbsParticipantEvent(id int64, t time.Time, username string, kind btypes.Cha
 		},
 	}
 }
+
+func bbcParticipant(id int64, t time.Time, uuid string, kind btypes.ChangesetEventKind) *btypes.ChangesetEvent {
+	return &btypes.ChangesetEvent{
+		ChangesetID: id,
+		Kind:        kind,
+		Metadata: &bitbucketcloud.Participant{
+			User:           bitbucketcloud.Account{UUID: uuid},
+			ParticipatedOn: t,
+		},
+	}
+}
+
+func bbcUnapproved(id int64, t time.Time, uuid string) *btypes.ChangesetEvent {
+	return &btypes.ChangesetEvent{
+		ChangesetID: id,
+		Kind:        btypes.ChangesetEventKindBitbucketCloudUnapproved,
+		Metadata: &bitbucketcloud.PullRequestUnapprovedEvent{
+			Approval: bitbucketcloud.Approval{
+				Date: t,
+				User: bitbucketcloud.Account{UUID: uuid},
+			},
+		},
+	}
+}
+
+func bbcChangesRequestRemoved(id int64, t time.Time, uuid string) *btypes.ChangesetEvent {
+	return &btypes.ChangesetEvent{
+		ChangesetID: id,
+		Kind:        btypes.ChangesetEventKindBitbucketCloudChangesRequestRemoved,
+		Metadata: &bitbucketcloud.PullRequestChangesRequestRemovedEvent{
+			ChangesRequest: bitbucketcloud.Approval{
+				Date: t,
+				User: bitbucketcloud.Account{UUID: uuid},
+			},
+		},
+	}
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -103,6 +105,12 @@ func computeCheckState(c *btypes.Changeset, events ChangesetEvents) btypes.Chang
 	case *bitbucketserver.PullRequest:
 		return computeBitbucketBuildStatus(c.UpdatedAt, m, events)
 
+	case *bitbucketcloud.PullRequest:
+		return computeBitbucketCloudBuildStatus(c.UpdatedAt, m, events)
+
+	case *azuredevops.PullRequest:
+		return computeAzureDevOpsCheckState(m)
+
 	case *gitlab.MergeRequest:
 		return computeGitLabCheckState(c.UpdatedAt, m, events)
 	}
@@ -184,6 +192,36 @@ func computeBitbucketBuildStatus(lastSynced time.Time, pr *bitbucketserver.PullR
 	return combineCheckStates(states)
 }
 
+func computeBitbucketCloudBuildStatus(lastSynced time.Time, pr *bitbucketcloud.PullRequest, events []*btypes.ChangesetEvent) btypes.ChangesetCheckState {
+	stateMap := make(map[string]btypes.ChangesetCheckState)
+
+	// States from last sync
+	for _, status := range pr.Statuses {
+		stateMap[status.Key()] = parseBitbucketBuildState(string(status.State))
+	}
+
+	// Add any events we've received since our last sync
+	for _, e := range events {
+		switch m := e.Metadata.(type) {
+		case *bitbucketcloud.PullRequestStatus:
+			if m.Commit.Hash != pr.Source.Commit.Hash {
+				continue
+			}
+			if m.UpdatedOn.Before(lastSynced) {
+				continue
+			}
+			stateMap[m.Key()] = parseBitbucketBuildState(string(m.State))
+		}
+	}
+
+	states := make([]btypes.ChangesetCheckState, 0, len(stateMap))
+	for _, v := range stateMap {
+		states = append(states, v)
+	}
+
+	return combineCheckStates(states)
+}
+
 func parseBitbucketBuildState(s string) btypes.ChangesetCheckState {
 	switch s {
 	case "FAILED":
@@ -197,6 +235,38 @@ func parseBitbucketBuildState(s string) btypes.ChangesetCheckState {
 	}
 }
 
+func computeAzureDevOpsCheckState(pr *azuredevops.PullRequest) btypes.ChangesetCheckState {
+	// Statuses posted again with the same context replace the previous one,
+	// so we only consider the most recent status per context.
+	latest := make(map[string]*azuredevops.PullRequestStatus)
+	for _, status := range pr.Statuses {
+		if l, ok := latest[status.Key()]; ok && l.UpdatedDate.After(status.UpdatedDate) {
+			continue
+		}
+		latest[status.Key()] = status
+	}
+
+	states := make([]btypes.ChangesetCheckState, 0, len(latest))
+	for _, status := range latest {
+		states = append(states, parseAzureDevOpsStatusState(status.State))
+	}
+
+	return combineCheckStates(states)
+}
+
+func parseAzureDevOpsStatusState(s azuredevops.PullRequestStatusState) btypes.ChangesetCheckState {
+	switch s {
+	case azuredevops.PullRequestStatusStateSucceeded:
+		return btypes.ChangesetCheckStatePassed
+	case azuredevops.PullRequestStatusStateFailed, azuredevops.PullRequestStatusStateError:
+		return btypes.ChangesetCheckStateFailed
+	case azuredevops.PullRequestStatusStatePending, azuredevops.PullRequestStatusStateNotSet:
+		return btypes.ChangesetCheckStatePending
+	default:
+		return btypes.ChangesetCheckStateUnknown
+	}
+}
+
 func computeGitHubCheckState(lastSynced time.Time, pr *github.PullRequest, events []*btypes.ChangesetEvent) btypes.ChangesetCheckState {
 	// We should only consider the latest commit. This could be from a sync or a webhook that
 	// has occurred later
@@ -435,6 +505,32 @@ func computeSingleChangesetExternalState(c *btypes.Changeset) (s btypes.Changese
 		} else {
 			s = btypes.ChangesetExternalState(m.State)
 		}
+	case *bitbucketcloud.PullRequest:
+		switch m.State {
+		case bitbucketcloud.PullRequestStateDeclined, bitbucketcloud.PullRequestStateSuperseded:
+			s = btypes.ChangesetExternalStateClosed
+		case bitbucketcloud.PullRequestStateMerged:
+			s = btypes.ChangesetExternalStateMerged
+		case bitbucketcloud.PullRequestStateOpen:
+			s = btypes.ChangesetExternalStateOpen
+		default:
+			return "", errors.Errorf("unknown Bitbucket Cloud pull request state: %s", m.State)
+		}
+	case *azuredevops.PullRequest:
+		switch m.Status {
+		case azuredevops.PullRequestStateAbandoned:
+			s = btypes.ChangesetExternalStateClosed
+		case azuredevops.PullRequestStateCompleted:
+			s = btypes.ChangesetExternalStateMerged
+		case azuredevops.PullRequestStateActive:
+			if m.IsDraft {
+				s = btypes.ChangesetExternalStateDraft
+			} else {
+				s = btypes.ChangesetExternalStateOpen
+			}
+		default:
+			return "", errors.Errorf("unknown Azure DevOps pull request status: %s", m.Status)
+		}
 	case *gitlab.MergeRequest:
 		switch m.State {
 		case gitlab.MergeRequestStateClosed, gitlab.MergeRequestStateLocked:
@@ -486,6 +582,26 @@ func computeSingleChangesetReviewState(c *btypes.Changeset) (s btypes.ChangesetR
 			}
 		}
 
+	case *bitbucketcloud.PullRequest:
+		for _, p := range m.Participants {
+			switch p.State {
+			case bitbucketcloud.ParticipantStateApproved:
+				states[btypes.ChangesetReviewStateApproved] = true
+			case bitbucketcloud.ParticipantStateChangesRequested:
+				states[btypes.ChangesetReviewStateChangesRequested] = true
+			}
+		}
+
+	case *azuredevops.PullRequest:
+		for _, r := range m.Reviewers {
+			switch {
+			case r.Vote >= azuredevops.VoteApprovedWithSuggestions:
+				states[btypes.ChangesetReviewStateApproved] = true
+			case r.Vote <= azuredevops.VoteWaitingForAuthor:
+				states[btypes.ChangesetReviewStateChangesRequested] = true
+			}
+		}
+
 	case *gitlab.MergeRequest:
 		// GitLab has an elaborate approvers workflow, but this doesn't map
 		// terribly closely to the GitHub/Bitbucket workflow: most notably,
@@ -9,6 +9,8 @@ import (
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -276,6 +278,147 @@ func TestComputeBitbucketBuildStatus(t *testing.T) {
 	}
 }
 
+func TestComputeBitbucketCloudBuildStatus(t *testing.T) {
+	t.Parallel()
+
+	now := timeutil.Now()
+	sha := "abcdef"
+	lastSynced := now.Add(-1 * time.Minute)
+
+	statusEvent := func(commit, key string, state bitbucketcloud.PullRequestStatusState, updatedOn time.Time) *btypes.ChangesetEvent {
+		status := &bitbucketcloud.PullRequestStatus{
+			StatusKey: key,
+			State:     state,
+			UpdatedOn: updatedOn,
+		}
+		status.Commit.Hash = commit
+		return &btypes.ChangesetEvent{
+			Kind:     btypes.ChangesetEventKindBitbucketCloudCommitStatus,
+			Metadata: status,
+		}
+	}
+
+	pr := &bitbucketcloud.PullRequest{}
+	pr.Source.Commit.Hash = sha
+	pr.Statuses = []*bitbucketcloud.PullRequestStatus{
+		{StatusKey: "ctx1", State: bitbucketcloud.PullRequestStatusStateSuccessful},
+	}
+	pr.Statuses[0].Commit.Hash = sha
+
+	tests := []struct {
+		name   string
+		events []*btypes.ChangesetEvent
+		want   btypes.ChangesetCheckState
+	}{
+		{
+			name:   "synced statuses only",
+			events: nil,
+			want:   btypes.ChangesetCheckStatePassed,
+		},
+		{
+			name: "newer event overrides synced status",
+			events: []*btypes.ChangesetEvent{
+				statusEvent(sha, "ctx1", bitbucketcloud.PullRequestStatusStateFailed, now),
+			},
+			want: btypes.ChangesetCheckStateFailed,
+		},
+		{
+			name: "events older than the last sync are ignored",
+			events: []*btypes.ChangesetEvent{
+				statusEvent(sha, "ctx1", bitbucketcloud.PullRequestStatusStateFailed, lastSynced.Add(-1*time.Minute)),
+			},
+			want: btypes.ChangesetCheckStatePassed,
+		},
+		{
+			name: "events for other commits are ignored",
+			events: []*btypes.ChangesetEvent{
+				statusEvent("other", "ctx2", bitbucketcloud.PullRequestStatusStateInProgress, now),
+			},
+			want: btypes.ChangesetCheckStatePassed,
+		},
+		{
+			name: "pending + success",
+			events: []*btypes.ChangesetEvent{
+				statusEvent(sha, "ctx2", bitbucketcloud.PullRequestStatusStateInProgress, now),
+			},
+			want: btypes.ChangesetCheckStatePending,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			have := computeBitbucketCloudBuildStatus(lastSynced, pr, tc.events)
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf(diff)
+			}
+		})
+	}
+}
+
+func TestComputeAzureDevOpsCheckState(t *testing.T) {
+	t.Parallel()
+
+	now := timeutil.Now()
+	status := func(name string, state azuredevops.PullRequestStatusState, updated time.Time) *azuredevops.PullRequestStatus {
+		return &azuredevops.PullRequestStatus{
+			State:       state,
+			Context:     azuredevops.StatusContext{Name: name},
+			UpdatedDate: updated,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		statuses []*azuredevops.PullRequestStatus
+		want     btypes.ChangesetCheckState
+	}{
+		{
+			name: "no statuses",
+			want: btypes.ChangesetCheckStateUnknown,
+		},
+		{
+			name: "single success",
+			statuses: []*azuredevops.PullRequestStatus{
+				status("ci", azuredevops.PullRequestStatusStateSucceeded, now),
+			},
+			want: btypes.ChangesetCheckStatePassed,
+		},
+		{
+			name: "pending + success",
+			statuses: []*azuredevops.PullRequestStatus{
+				status("ci", azuredevops.PullRequestStatusStateSucceeded, now),
+				status("lint", azuredevops.PullRequestStatusStatePending, now),
+			},
+			want: btypes.ChangesetCheckStatePending,
+		},
+		{
+			name: "error + success",
+			statuses: []*azuredevops.PullRequestStatus{
+				status("ci", azuredevops.PullRequestStatusStateSucceeded, now),
+				status("lint", azuredevops.PullRequestStatusStateError, now),
+			},
+			want: btypes.ChangesetCheckStateFailed,
+		},
+		{
+			name: "newer status for the same context wins",
+			statuses: []*azuredevops.PullRequestStatus{
+				status("ci", azuredevops.PullRequestStatusStateFailed, now.Add(-1*time.Minute)),
+				status("ci", azuredevops.PullRequestStatusStateSucceeded, now),
+			},
+			want: btypes.ChangesetCheckStatePassed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			have := computeAzureDevOpsCheckState(&azuredevops.PullRequest{Statuses: tc.statuses})
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf(diff)
+			}
+		})
+	}
+}
+
 func TestComputeGitLabCheckState(t *testing.T) {
 	t.Parallel()
 
@@ -464,6 +607,44 @@ func TestComputeReviewState(t *testing.T) {
 			},
 			want: btypes.ChangesetReviewStateChangesRequested,
 		},
+		{
+			name:      "bitbucketcloud - no events",
+			changeset: bitbucketCloudChangeset(daysAgo(10), bitbucketcloud.PullRequestStateOpen, bitbucketcloud.ParticipantStateChangesRequested),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStateChangesRequested,
+		},
+		{
+			name:      "bitbucketcloud - changeset older than events",
+			changeset: bitbucketCloudChangeset(daysAgo(10), bitbucketcloud.PullRequestStateOpen, bitbucketcloud.ParticipantStateChangesRequested),
+			history: []changesetStatesAtTime{
+				{t: daysAgo(0), reviewState: btypes.ChangesetReviewStateApproved},
+			},
+			want: btypes.ChangesetReviewStateApproved,
+		},
+		{
+			name:      "bitbucketcloud - no reviews",
+			changeset: bitbucketCloudChangeset(daysAgo(0), bitbucketcloud.PullRequestStateOpen, bitbucketcloud.ParticipantStateNull),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStatePending,
+		},
+		{
+			name:      "azuredevops - waiting for author",
+			changeset: azureDevOpsChangeset(daysAgo(0), azuredevops.PullRequestStateActive, false, azuredevops.VoteApproved, azuredevops.VoteWaitingForAuthor),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStateChangesRequested,
+		},
+		{
+			name:      "azuredevops - approved with suggestions",
+			changeset: azureDevOpsChangeset(daysAgo(0), azuredevops.PullRequestStateActive, false, azuredevops.VoteApprovedWithSuggestions, azuredevops.VoteNone),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStateApproved,
+		},
+		{
+			name:      "azuredevops - no votes",
+			changeset: azureDevOpsChangeset(daysAgo(0), azuredevops.PullRequestStateActive, false, azuredevops.VoteNone),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStatePending,
+		},
 		{
 			name:      "gitlab - no events, no approvals",
 			changeset: gitLabChangeset(daysAgo(0), gitlab.MergeRequestStateOpened, []*gitlab.Note{}),
@@ -629,6 +810,26 @@ func TestComputeExternalState(t *testing.T) {
 			history:   []changesetStatesAtTime{},
 			want:      btypes.ChangesetExternalStateOpen,
 		},
+		{
+			name:      "bitbucketcloud - declined",
+			changeset: bitbucketCloudChangeset(daysAgo(10), bitbucketcloud.PullRequestStateDeclined, bitbucketcloud.ParticipantStateNull),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateClosed,
+		},
+		{
+			name:      "bitbucketcloud - superseded",
+			changeset: bitbucketCloudChangeset(daysAgo(10), bitbucketcloud.PullRequestStateSuperseded, bitbucketcloud.ParticipantStateNull),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateClosed,
+		},
+		{
+			name:      "bitbucketcloud - changeset newer than events",
+			changeset: bitbucketCloudChangeset(daysAgo(0), bitbucketcloud.PullRequestStateMerged, bitbucketcloud.ParticipantStateApproved),
+			history: []changesetStatesAtTime{
+				{t: daysAgo(10), externalState: btypes.ChangesetExternalStateOpen},
+			},
+			want: btypes.ChangesetExternalStateMerged,
+		},
 		{
 			name:      "bitbucketserver - changeset older than events",
 			changeset: bitbucketChangeset(daysAgo(10), "OPEN", "NEEDS_WORK"),
@@ -653,6 +854,30 @@ func TestComputeExternalState(t *testing.T) {
 			},
 			want: btypes.ChangesetExternalStateDeleted,
 		},
+		{
+			name:      "azuredevops - active",
+			changeset: azureDevOpsChangeset(daysAgo(0), azuredevops.PullRequestStateActive, false),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateOpen,
+		},
+		{
+			name:      "azuredevops - draft",
+			changeset: azureDevOpsChangeset(daysAgo(0), azuredevops.PullRequestStateActive, true),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateDraft,
+		},
+		{
+			name:      "azuredevops - abandoned",
+			changeset: azureDevOpsChangeset(daysAgo(0), azuredevops.PullRequestStateAbandoned, false),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateClosed,
+		},
+		{
+			name:      "azuredevops - completed",
+			changeset: azureDevOpsChangeset(daysAgo(0), azuredevops.PullRequestStateCompleted, false),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateMerged,
+		},
 		{
 			name:      "gitlab - no events, opened",
 			changeset: gitLabChangeset(daysAgo(0), gitlab.MergeRequestStateOpened, nil),
@@ -846,6 +1071,31 @@ func bitbucketChangeset(updatedAt time.Time, state, reviewStatus string) *btypes
 	}
 }
 
+func bitbucketCloudChangeset(updatedAt time.Time, state bitbucketcloud.PullRequestState, participantState bitbucketcloud.ParticipantState) *btypes.Changeset {
+	return &btypes.Changeset{
+		ExternalServiceType: extsvc.TypeBitbucketCloud,
+		UpdatedAt:           updatedAt,
+		Metadata: &bitbucketcloud.PullRequest{
+			State: state,
+			Participants: []bitbucketcloud.Participant{
+				{State: participantState},
+			},
+		},
+	}
+}
+
+func azureDevOpsChangeset(updatedAt time.Time, status azuredevops.PullRequestState, draft bool, votes ...int) *btypes.Changeset {
+	pr := &azuredevops.PullRequest{Status: status, IsDraft: draft}
+	for _, v := range votes {
+		pr.Reviewers = append(pr.Reviewers, azuredevops.Reviewer{Vote: v})
+	}
+	return &btypes.Changeset{
+		ExternalServiceType: extsvc.TypeAzureDevOps,
+		UpdatedAt:           updatedAt,
+		Metadata:            pr,
+	}
+}
+
 func githubChangeset(updatedAt time.Time, state string) *btypes.Changeset {
 	return &btypes.Changeset{
 		ExternalServiceType: extsvc.TypeGitHub,
@@ -40,6 +40,10 @@ type TestSpecOpts struct {
 
 	BaseRev string
 	BaseRef string
+
+	Labels    []string
+	Reviewers []string
+	Assignees []string
 }
 
 var TestChangsetSpecDiffStat = &diff.Stat{Added: 10, Changed: 5, Deleted: 2}
@@ -70,6 +74,10 @@ func BuildChangesetSpec(t *testing.T, opts TestSpecOpts) *btypes.ChangesetSpec {
 			Title: opts.Title,
 			Body:  opts.Body,
 
+			Labels:    opts.Labels,
+			Reviewers: opts.Reviewers,
+			Assignees: opts.Assignees,
+
 			Commits: []batcheslib.GitCommitDescription{
 				{
 					Message:     opts.CommitMessage,
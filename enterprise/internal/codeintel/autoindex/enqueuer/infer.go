@@ -3,6 +3,7 @@ package enqueuer
 import (
 	"strings"
 
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
@@ -10,7 +11,9 @@ import (
 func InferRepositoryAndRevision(pkg precise.Package) (repoName, gitTagOrCommit string, ok bool) {
 	for _, fn := range []func(pkg precise.Package) (string, string, bool){
 		inferGoRepositoryAndRevision,
+		inferGoModuleRepositoryAndRevision,
 		inferJVMRepositoryAndRevision,
+		inferNPMRepositoryAndRevision,
 	} {
 		if repoName, gitTagOrCommit, ok := fn(pkg); ok {
 			return repoName, gitTagOrCommit, true
@@ -42,9 +45,33 @@ func inferGoRepositoryAndRevision(pkg precise.Package) (string, string, bool) {
 	return strings.Join(repoParts, "/"), version, true
 }
 
+// inferGoModuleRepositoryAndRevision infers the repositories created by the Go
+// modules code host for modules that aren't hosted on GitHub.
+func inferGoModuleRepositoryAndRevision(pkg precise.Package) (string, string, bool) {
+	if pkg.Scheme != "gomod" {
+		return "", "", false
+	}
+	dependency, err := reposource.ParseGoDependency(strings.TrimPrefix(pkg.Name, GitHubScheme) + "@" + pkg.Version)
+	if err != nil {
+		return "", "", false
+	}
+	return string(dependency.RepoName()), dependency.GitTagFromVersion(), true
+}
+
 func inferJVMRepositoryAndRevision(pkg precise.Package) (string, string, bool) {
 	if pkg.Scheme != "semanticdb" {
 		return "", "", false
 	}
 	return pkg.Name, "v" + pkg.Version, true
 }
+
+func inferNPMRepositoryAndRevision(pkg precise.Package) (string, string, bool) {
+	if pkg.Scheme != "npm" {
+		return "", "", false
+	}
+	npmPackage, err := reposource.ParseNPMPackageFromPackageSyntax(pkg.Name)
+	if err != nil {
+		return "", "", false
+	}
+	return string(npmPackage.RepoName()), "v" + pkg.Version, true
+}
@@ -40,6 +40,55 @@ func TestInferRepositoryAndRevision(t *testing.T) {
 				repoName: "github.com/sourcegraph/sourcegraph",
 				revision: "de0123456789",
 			},
+			{
+				pkg: precise.Package{
+					Scheme:  "gomod",
+					Name:    "https://golang.org/x/mod",
+					Version: "v0.5.1",
+				},
+				repoName: "go/golang.org/x/mod",
+				revision: "v0.5.1",
+			},
+		}
+
+		for _, testCase := range testCases {
+			repoName, revision, ok := InferRepositoryAndRevision(testCase.pkg)
+			if !ok {
+				t.Fatalf("expected repository to be inferred")
+			}
+
+			if repoName != testCase.repoName {
+				t.Errorf("unexpected repo name. want=%q have=%q", testCase.repoName, repoName)
+			}
+			if revision != testCase.revision {
+				t.Errorf("unexpected revision. want=%q have=%q", testCase.revision, revision)
+			}
+		}
+	})
+	t.Run("npm", func(t *testing.T) {
+		testCases := []struct {
+			pkg      precise.Package
+			repoName string
+			revision string
+		}{
+			{
+				pkg: precise.Package{
+					Scheme:  "npm",
+					Name:    "@types/node",
+					Version: "16.11.0",
+				},
+				repoName: "npm/types/node",
+				revision: "v16.11.0",
+			},
+			{
+				pkg: precise.Package{
+					Scheme:  "npm",
+					Name:    "react",
+					Version: "17.0.2",
+				},
+				repoName: "npm/react",
+				revision: "v17.0.2",
+			},
 		}
 
 		for _, testCase := range testCases {
@@ -0,0 +1,294 @@
+package uploadstore
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// filesystemExpirationInterval is the interval between scans for objects that
+// have outlived the configured TTL.
+const filesystemExpirationInterval = time.Hour
+
+type filesystemStore struct {
+	dir        string
+	ttl        time.Duration
+	operations *operations
+	now        func() time.Time
+	expireOnce sync.Once
+}
+
+var _ Store = &filesystemStore{}
+
+type FilesystemConfig struct {
+	Root string
+}
+
+func (c *FilesystemConfig) load(parent *env.BaseConfig) {
+	c.Root = parent.Get("PRECISE_CODE_INTEL_UPLOAD_FILESYSTEM_ROOT", "/data/uploadstore", "The directory in which uploads are stored when using the filesystem backend. It must be shared by all services that access uploads.")
+}
+
+// newFilesystemFromConfig creates a new store backed by a directory on the local
+// filesystem. Each bucket is stored as a subdirectory of the configured root.
+func newFilesystemFromConfig(ctx context.Context, config *Config, operations *operations) (Store, error) {
+	if config.Filesystem.Root == "" {
+		return nil, errors.New("no root directory configured for filesystem upload store")
+	}
+
+	return newFilesystemWithClock(filepath.Join(config.Filesystem.Root, config.Bucket), config.TTL, operations, time.Now), nil
+}
+
+func newFilesystemWithClock(dir string, ttl time.Duration, operations *operations, now func() time.Time) *filesystemStore {
+	return &filesystemStore{
+		dir:        dir,
+		ttl:        ttl,
+		operations: operations,
+		now:        now,
+	}
+}
+
+// Init creates the target directory and starts a background routine that removes
+// objects older than the configured TTL. Unlike the blob store backends, there is
+// no lifecycle configuration to fall back on, so the directory is always managed.
+func (s *filesystemStore) Init(ctx context.Context) error {
+	if err := os.MkdirAll(s.dir, os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to create directory")
+	}
+
+	if s.ttl > 0 {
+		s.expireOnce.Do(func() {
+			handler := goroutine.NewHandlerWithErrorMessage("codeintel_uploadstore_filesystem_expirer", s.expire)
+			go goroutine.NewPeriodicGoroutine(context.Background(), filesystemExpirationInterval, handler).Start()
+		})
+	}
+
+	return nil
+}
+
+// Get returns the content of the object at the given key. The returned reader is
+// an *os.File, so callers may type assert to io.ReaderAt or io.Seeker to read an
+// arbitrary byte range without streaming the entire object.
+func (s *filesystemStore) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	ctx, endObservation := s.operations.get.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+
+	return f, nil
+}
+
+func (s *filesystemStore) Upload(ctx context.Context, key string, r io.Reader) (_ int64, err error) {
+	ctx, endObservation := s.operations.upload.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := s.writeAtomically(path, func(w io.Writer) (int64, error) {
+		return io.Copy(w, r)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to upload object")
+	}
+
+	return n, nil
+}
+
+func (s *filesystemStore) Compose(ctx context.Context, destination string, sources ...string) (_ int64, err error) {
+	ctx, endObservation := s.operations.compose.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("destination", destination),
+		log.String("sources", strings.Join(sources, ", ")),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	destinationPath, err := s.path(destination)
+	if err != nil {
+		return 0, err
+	}
+
+	sourcePaths := make([]string, 0, len(sources))
+	for _, source := range sources {
+		sourcePath, err := s.path(source)
+		if err != nil {
+			return 0, err
+		}
+		sourcePaths = append(sourcePaths, sourcePath)
+	}
+
+	defer func() {
+		if err == nil {
+			// Delete sources on success
+			if err := s.deleteSources(sourcePaths); err != nil {
+				log15.Error("Failed to delete source objects", "error", err)
+			}
+		}
+	}()
+
+	n, err := s.writeAtomically(destinationPath, func(w io.Writer) (int64, error) {
+		var total int64
+		for _, sourcePath := range sourcePaths {
+			n, err := copyFile(w, sourcePath)
+			total += n
+			if err != nil {
+				return total, err
+			}
+		}
+
+		return total, nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to compose objects")
+	}
+
+	return n, nil
+}
+
+func (s *filesystemStore) Delete(ctx context.Context, key string) (err error) {
+	ctx, endObservation := s.operations.delete.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to delete object")
+	}
+
+	return nil
+}
+
+// path returns the location of the given key on disk. Keys that would resolve to
+// a location outside of the store's directory are rejected.
+func (s *filesystemStore) path(key string) (string, error) {
+	if key == "" || filepath.IsAbs(key) {
+		return "", errors.Errorf("invalid key %q", key)
+	}
+
+	cleaned := filepath.Clean(filepath.FromSlash(key))
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", errors.Errorf("invalid key %q", key)
+	}
+
+	return filepath.Join(s.dir, cleaned), nil
+}
+
+// writeAtomically invokes the given function with a writer to a temporary file in
+// the store's directory, then moves the file to the given path. Concurrent readers
+// never observe a partially written object.
+func (s *filesystemStore) writeAtomically(path string, write func(w io.Writer) (int64, error)) (_ int64, err error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return 0, err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	n, err := write(tmp)
+	if closeErr := tmp.Close(); closeErr != nil {
+		err = multierror.Append(err, errors.Wrap(closeErr, "failed to close file"))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (s *filesystemStore) deleteSources(sourcePaths []string) error {
+	return goroutine.RunWorkersOverStrings(sourcePaths, func(index int, sourcePath string) error {
+		if err := os.Remove(sourcePath); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "failed to delete source object")
+		}
+
+		return nil
+	})
+}
+
+// expire removes all objects that were last written before the configured TTL.
+func (s *filesystemStore) expire(ctx context.Context) error {
+	threshold := s.now().Add(-s.ttl)
+
+	return filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if info.ModTime().Before(threshold) {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return errors.Wrap(err, "failed to delete expired object")
+			}
+		}
+
+		return nil
+	})
+}
+
+func copyFile(w io.Writer, path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	return io.Copy(w, f)
+}
@@ -0,0 +1,219 @@
+package uploadstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestFilesystemInit(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "test-bucket")
+
+	client := rawFilesystemClient(dir, time.Now)
+	if err := client.Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing client: %s", err)
+	}
+
+	if info, err := os.Stat(dir); err != nil {
+		t.Fatalf("unexpected error checking directory: %s", err)
+	} else if !info.IsDir() {
+		t.Fatalf("expected %s to be a directory", dir)
+	}
+}
+
+func TestFilesystemUploadAndGet(t *testing.T) {
+	client := testFilesystemClient(t)
+
+	n, err := client.Upload(context.Background(), "test-key", bytes.NewReader([]byte("TEST PAYLOAD")))
+	if err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+	if n != 12 {
+		t.Errorf("unexpected size. want=%d have=%d", 12, n)
+	}
+
+	rc, err := client.Get(context.Background(), "test-key")
+	if err != nil {
+		t.Fatalf("unexpected error getting object: %s", err)
+	}
+	defer rc.Close()
+
+	contents, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("unexpected error reading object: %s", err)
+	}
+	if string(contents) != "TEST PAYLOAD" {
+		t.Errorf("unexpected contents. want=%s have=%s", "TEST PAYLOAD", contents)
+	}
+}
+
+func TestFilesystemGetRange(t *testing.T) {
+	client := testFilesystemClient(t)
+
+	if _, err := client.Upload(context.Background(), "test-key", bytes.NewReader([]byte("TEST PAYLOAD"))); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	rc, err := client.Get(context.Background(), "test-key")
+	if err != nil {
+		t.Fatalf("unexpected error getting object: %s", err)
+	}
+	defer rc.Close()
+
+	readerAt, ok := rc.(io.ReaderAt)
+	if !ok {
+		t.Fatalf("expected reader to implement io.ReaderAt")
+	}
+
+	buf := make([]byte, 7)
+	if _, err := readerAt.ReadAt(buf, 5); err != nil {
+		t.Fatalf("unexpected error reading range: %s", err)
+	}
+	if string(buf) != "PAYLOAD" {
+		t.Errorf("unexpected contents. want=%s have=%s", "PAYLOAD", buf)
+	}
+}
+
+func TestFilesystemGetMissing(t *testing.T) {
+	client := testFilesystemClient(t)
+
+	if _, err := client.Get(context.Background(), "missing-key"); err == nil {
+		t.Fatalf("expected an error getting a missing object")
+	}
+}
+
+func TestFilesystemInvalidKeys(t *testing.T) {
+	client := testFilesystemClient(t)
+
+	for _, key := range []string{"", ".", "..", "../escape", "a/../../escape", "/absolute"} {
+		if _, err := client.Upload(context.Background(), key, strings.NewReader("payload")); err == nil {
+			t.Errorf("expected an error uploading to key %q", key)
+		}
+	}
+}
+
+func TestFilesystemCompose(t *testing.T) {
+	client := testFilesystemClient(t)
+
+	for i, payload := range []string{"foo", "bar", "baz"} {
+		if _, err := client.Upload(context.Background(), "test-src"+string(rune('1'+i)), strings.NewReader(payload)); err != nil {
+			t.Fatalf("unexpected error uploading object: %s", err)
+		}
+	}
+
+	n, err := client.Compose(context.Background(), "test-key", "test-src1", "test-src2", "test-src3")
+	if err != nil {
+		t.Fatalf("unexpected error composing objects: %s", err)
+	}
+	if n != 9 {
+		t.Errorf("unexpected size. want=%d have=%d", 9, n)
+	}
+
+	rc, err := client.Get(context.Background(), "test-key")
+	if err != nil {
+		t.Fatalf("unexpected error getting object: %s", err)
+	}
+	defer rc.Close()
+
+	contents, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("unexpected error reading object: %s", err)
+	}
+	if string(contents) != "foobarbaz" {
+		t.Errorf("unexpected contents. want=%s have=%s", "foobarbaz", contents)
+	}
+
+	for _, source := range []string{"test-src1", "test-src2", "test-src3"} {
+		if _, err := client.Get(context.Background(), source); err == nil {
+			t.Errorf("expected source object %s to be deleted", source)
+		}
+	}
+}
+
+func TestFilesystemComposeMissingSource(t *testing.T) {
+	client := testFilesystemClient(t)
+
+	if _, err := client.Upload(context.Background(), "test-src1", strings.NewReader("foo")); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	if _, err := client.Compose(context.Background(), "test-key", "test-src1", "test-src2"); err == nil {
+		t.Fatalf("expected an error composing a missing source")
+	}
+
+	if _, err := client.Get(context.Background(), "test-key"); err == nil {
+		t.Errorf("expected destination object not to be written")
+	}
+	if _, err := client.Get(context.Background(), "test-src1"); err != nil {
+		t.Errorf("expected source object to be retained: %s", err)
+	}
+}
+
+func TestFilesystemDelete(t *testing.T) {
+	client := testFilesystemClient(t)
+
+	if _, err := client.Upload(context.Background(), "test-key", strings.NewReader("payload")); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	if err := client.Delete(context.Background(), "test-key"); err != nil {
+		t.Fatalf("unexpected error deleting object: %s", err)
+	}
+	if _, err := client.Get(context.Background(), "test-key"); err == nil {
+		t.Errorf("expected object to be deleted")
+	}
+
+	// Deleting a missing object is not an error
+	if err := client.Delete(context.Background(), "test-key"); err != nil {
+		t.Fatalf("unexpected error deleting missing object: %s", err)
+	}
+}
+
+func TestFilesystemExpire(t *testing.T) {
+	now := time.Now()
+	client := rawFilesystemClient(filepath.Join(t.TempDir(), "test-bucket"), func() time.Time { return now })
+	if err := client.Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing client: %s", err)
+	}
+	// Set after Init so that the periodic expirer is not started
+	client.ttl = 24 * time.Hour
+
+	for _, key := range []string{"old", "nested/old", "new"} {
+		if _, err := client.Upload(context.Background(), key, strings.NewReader("payload")); err != nil {
+			t.Fatalf("unexpected error uploading object: %s", err)
+		}
+	}
+
+	old := now.Add(-client.ttl - time.Minute)
+	for _, key := range []string{"old", "nested/old"} {
+		if err := os.Chtimes(filepath.Join(client.dir, key), old, old); err != nil {
+			t.Fatalf("unexpected error changing file times: %s", err)
+		}
+	}
+
+	if err := client.expire(context.Background()); err != nil {
+		t.Fatalf("unexpected error expiring objects: %s", err)
+	}
+
+	for key, wantExists := range map[string]bool{"old": false, "nested/old": false, "new": true} {
+		_, err := os.Stat(filepath.Join(client.dir, key))
+		if exists := err == nil; exists != wantExists {
+			t.Errorf("unexpected existence of %s. want=%v have=%v", key, wantExists, exists)
+		}
+	}
+}
+
+func testFilesystemClient(t *testing.T) Store {
+	return newLazyStore(rawFilesystemClient(filepath.Join(t.TempDir(), "test-bucket"), time.Now))
+}
+
+func rawFilesystemClient(dir string, now func() time.Time) *filesystemStore {
+	return newFilesystemWithClock(dir, 0, newOperations(&observation.TestContext), now)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
@@ -38,6 +39,14 @@ type ActionJobMetadata struct {
 	MonitorID   int64
 	NumResults  *int
 
+	// NumAdded and NumRemoved are set if the trigger job is a run of a
+	// scheduled query. Added and Removed hold the keys of the results that
+	// were added and removed since the previous run.
+	NumAdded   *int
+	NumRemoved *int
+	Added      []string
+	Removed    []string
+
 	// The query with after: filter.
 	Query string
 }
@@ -76,8 +85,8 @@ type ListActionJobsOpts struct {
 	// the given webhook action. Refers to cm_webhooks(id)
 	WebhookID *int
 
-	// WebhookID, if set, will filter to only actions jobs that are executing
-	// the given slack webhook action. Refers to cm_slack_webhooks(id)
+	// SlackWebhookID, if set, will filter to only actions jobs that are
+	// executing the given slack webhook action. Refers to cm_slack_webhooks(id)
 	SlackWebhookID *int
 
 	// First, if defined, limits the operation to only the first n results
@@ -162,33 +171,58 @@ func (s *codeMonitorStore) CountActionJobs(ctx context.Context, opts ListActionJ
 	return count, err
 }
 
-const enqueueActionEmailFmtStr = `
+const enqueueActionFmtStr = `
 WITH due AS (
 	SELECT id
-	FROM cm_emails
-	WHERE monitor = %s 
+	FROM %s
+	WHERE monitor = %s
 		AND enabled = true
 ),
 busy AS (
-	SELECT DISTINCT email as id FROM cm_action_jobs
+	SELECT DISTINCT %s as id FROM cm_action_jobs
 	WHERE state = 'queued'
 	OR state = 'processing'
 )
-INSERT INTO cm_action_jobs (email, trigger_event)
+INSERT INTO cm_action_jobs (%s, trigger_event)
 SELECT id, %s::integer from due EXCEPT SELECT id, %s::integer from busy ORDER BY id
 RETURNING %s
 `
 
+// actionJobTypes maps each action table to the column of cm_action_jobs
+// that references it.
+var actionJobTypes = []struct {
+	table  string
+	column string
+}{
+	{table: "cm_emails", column: "email"},
+	{table: "cm_webhooks", column: "webhook"},
+	{table: "cm_slack_webhooks", column: "slack_webhook"},
+}
+
 // TODO(camdencheek): could/should we enqueue based on monitor ID rather than query ID? Would avoid joins above.
 func (s *codeMonitorStore) EnqueueActionJobsForMonitor(ctx context.Context, monitorID int64, triggerJobID int32) ([]*ActionJob, error) {
-	// TODO(camdencheek): Enqueue actions other than emails here
-	q := sqlf.Sprintf(
-		enqueueActionEmailFmtStr,
-		monitorID,
-		triggerJobID,
-		triggerJobID,
-		sqlf.Join(ActionJobColumns, ","),
-	)
+	var jobs []*ActionJob
+	for _, t := range actionJobTypes {
+		q := sqlf.Sprintf(
+			enqueueActionFmtStr,
+			sqlf.Sprintf(t.table),
+			monitorID,
+			sqlf.Sprintf(t.column),
+			sqlf.Sprintf(t.column),
+			triggerJobID,
+			triggerJobID,
+			sqlf.Join(ActionJobColumns, ","),
+		)
+		enqueued, err := s.enqueueActionJobs(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, enqueued...)
+	}
+	return jobs, nil
+}
+
+func (s *codeMonitorStore) enqueueActionJobs(ctx context.Context, q *sqlf.Query) ([]*ActionJob, error) {
 	rows, err := s.Query(ctx, q)
 	if err != nil {
 		return nil, err
@@ -202,7 +236,11 @@ SELECT
 	cm.description,
 	ctj.query_string,
 	cm.id AS monitorID,
-	ctj.num_results
+	ctj.num_results,
+	ctj.num_added,
+	ctj.num_removed,
+	ctj.added_results,
+	ctj.removed_results
 FROM cm_action_jobs caj
 INNER JOIN cm_trigger_jobs ctj on caj.trigger_event = ctj.id
 INNER JOIN cm_queries cq on cq.id = ctj.query
@@ -213,7 +251,7 @@ WHERE caj.id = %s
 func (s *codeMonitorStore) GetActionJobMetadata(ctx context.Context, jobID int32) (*ActionJobMetadata, error) {
 	row := s.Store.QueryRow(ctx, sqlf.Sprintf(getActionJobMetadataFmtStr, jobID))
 	m := &ActionJobMetadata{}
-	return m, row.Scan(&m.Description, &m.Query, &m.MonitorID, &m.NumResults)
+	return m, row.Scan(&m.Description, &m.Query, &m.MonitorID, &m.NumResults, &m.NumAdded, &m.NumRemoved, pq.Array(&m.Added), pq.Array(&m.Removed))
 }
 
 const actionJobForIDFmtStr = `
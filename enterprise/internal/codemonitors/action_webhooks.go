@@ -0,0 +1,284 @@
+package codemonitors
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// WebhookAction is a webhook action of a code monitor. Generic webhooks are
+// stored in cm_webhooks and Slack webhooks in cm_slack_webhooks. Both tables
+// share the same schema.
+type WebhookAction struct {
+	ID        int64
+	Monitor   int64
+	Enabled   bool
+	URL       string
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+}
+
+type WebhookActionArgs struct {
+	Enabled bool
+	URL     string
+}
+
+// SlackWebhookAction is a Slack webhook action of a code monitor.
+type SlackWebhookAction = WebhookAction
+
+type SlackWebhookActionArgs = WebhookActionArgs
+
+// webhookTable describes one of the tables that hold webhook actions.
+type webhookTable struct {
+	name  string
+	label string
+}
+
+var (
+	webhooksTable      = webhookTable{name: "cm_webhooks", label: "webhook"}
+	slackWebhooksTable = webhookTable{name: "cm_slack_webhooks", label: "Slack webhook"}
+)
+
+// UpdateWebhookAction updates the webhook action with the given ID. It returns an
+// error if the action is not defined on the given monitor.
+func (s *codeMonitorStore) UpdateWebhookAction(ctx context.Context, monitorID, id int64, args *WebhookActionArgs) (*WebhookAction, error) {
+	return s.updateWebhookAction(ctx, webhooksTable, monitorID, id, args)
+}
+
+// UpdateSlackWebhookAction updates the Slack webhook action with the given ID.
+// It returns an error if the action is not defined on the given monitor.
+func (s *codeMonitorStore) UpdateSlackWebhookAction(ctx context.Context, monitorID, id int64, args *SlackWebhookActionArgs) (*SlackWebhookAction, error) {
+	return s.updateWebhookAction(ctx, slackWebhooksTable, monitorID, id, args)
+}
+
+const updateActionWebhookFmtStr = `
+UPDATE %s
+SET enabled = %s,
+	url = %s,
+	changed_by = %s,
+	changed_at = %s
+WHERE id = %s
+	AND monitor = %s
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) updateWebhookAction(ctx context.Context, t webhookTable, monitorID, id int64, args *WebhookActionArgs) (*WebhookAction, error) {
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		updateActionWebhookFmtStr,
+		sqlf.Sprintf(t.name),
+		args.Enabled,
+		args.URL,
+		a.UID,
+		s.Now(),
+		id,
+		monitorID,
+		sqlf.Join(webhooksColumns, ", "),
+	)
+
+	row := s.QueryRow(ctx, q)
+	w, err := scanWebhook(row)
+	if err == sql.ErrNoRows {
+		return nil, errors.Errorf("%s action %d not found on monitor %d", t.label, id, monitorID)
+	}
+	return w, err
+}
+
+func (s *codeMonitorStore) CreateWebhookAction(ctx context.Context, monitorID int64, args *WebhookActionArgs) (*WebhookAction, error) {
+	return s.createWebhookAction(ctx, webhooksTable, monitorID, args)
+}
+
+func (s *codeMonitorStore) CreateSlackWebhookAction(ctx context.Context, monitorID int64, args *SlackWebhookActionArgs) (*SlackWebhookAction, error) {
+	return s.createWebhookAction(ctx, slackWebhooksTable, monitorID, args)
+}
+
+const createActionWebhookFmtStr = `
+INSERT INTO %s
+(monitor, enabled, url, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) createWebhookAction(ctx context.Context, t webhookTable, monitorID int64, args *WebhookActionArgs) (*WebhookAction, error) {
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		createActionWebhookFmtStr,
+		sqlf.Sprintf(t.name),
+		monitorID,
+		args.Enabled,
+		args.URL,
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(webhooksColumns, ", "),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanWebhook(row)
+}
+
+func (s *codeMonitorStore) DeleteWebhookActions(ctx context.Context, actionIDs []int64, monitorID int64) error {
+	return s.deleteWebhookActions(ctx, webhooksTable, actionIDs, monitorID)
+}
+
+func (s *codeMonitorStore) DeleteSlackWebhookActions(ctx context.Context, actionIDs []int64, monitorID int64) error {
+	return s.deleteWebhookActions(ctx, slackWebhooksTable, actionIDs, monitorID)
+}
+
+const deleteActionWebhookFmtStr = `
+DELETE FROM %s
+WHERE id in (%s)
+	AND MONITOR = %s
+`
+
+func (s *codeMonitorStore) deleteWebhookActions(ctx context.Context, t webhookTable, actionIDs []int64, monitorID int64) error {
+	if len(actionIDs) == 0 {
+		return nil
+	}
+
+	deleteIDs := make([]*sqlf.Query, 0, len(actionIDs))
+	for _, ids := range actionIDs {
+		deleteIDs = append(deleteIDs, sqlf.Sprintf("%d", ids))
+	}
+	q := sqlf.Sprintf(
+		deleteActionWebhookFmtStr,
+		sqlf.Sprintf(t.name),
+		sqlf.Join(deleteIDs, ", "),
+		monitorID,
+	)
+
+	return s.Exec(ctx, q)
+}
+
+func (s *codeMonitorStore) CountWebhookActions(ctx context.Context, monitorID int64) (int32, error) {
+	return s.countWebhookActions(ctx, webhooksTable, monitorID)
+}
+
+func (s *codeMonitorStore) CountSlackWebhookActions(ctx context.Context, monitorID int64) (int32, error) {
+	return s.countWebhookActions(ctx, slackWebhooksTable, monitorID)
+}
+
+const totalCountActionWebhooksFmtStr = `
+SELECT COUNT(*)
+FROM %s
+WHERE monitor = %s;
+`
+
+func (s *codeMonitorStore) countWebhookActions(ctx context.Context, t webhookTable, monitorID int64) (int32, error) {
+	var count int32
+	err := s.QueryRow(ctx, sqlf.Sprintf(totalCountActionWebhooksFmtStr, sqlf.Sprintf(t.name), monitorID)).Scan(&count)
+	return count, err
+}
+
+func (s *codeMonitorStore) GetWebhookAction(ctx context.Context, webhookID int64) (*WebhookAction, error) {
+	return s.getWebhookAction(ctx, webhooksTable, webhookID)
+}
+
+func (s *codeMonitorStore) GetSlackWebhookAction(ctx context.Context, slackWebhookID int64) (*SlackWebhookAction, error) {
+	return s.getWebhookAction(ctx, slackWebhooksTable, slackWebhookID)
+}
+
+const actionWebhookByIDFmtStr = `
+SELECT %s -- WebhooksColumns
+FROM %s
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) getWebhookAction(ctx context.Context, t webhookTable, id int64) (*WebhookAction, error) {
+	q := sqlf.Sprintf(
+		actionWebhookByIDFmtStr,
+		sqlf.Join(webhooksColumns, ","),
+		sqlf.Sprintf(t.name),
+		id,
+	)
+	row := s.QueryRow(ctx, q)
+	return scanWebhook(row)
+}
+
+// ListWebhookActions lists webhooks from cm_webhooks with the given opts
+func (s *codeMonitorStore) ListWebhookActions(ctx context.Context, opts ListActionsOpts) ([]*WebhookAction, error) {
+	return s.listWebhookActions(ctx, webhooksTable, opts)
+}
+
+// ListSlackWebhookActions lists Slack webhooks from cm_slack_webhooks with the given opts
+func (s *codeMonitorStore) ListSlackWebhookActions(ctx context.Context, opts ListActionsOpts) ([]*SlackWebhookAction, error) {
+	return s.listWebhookActions(ctx, slackWebhooksTable, opts)
+}
+
+const listWebhookActionsFmtStr = `
+SELECT %s -- WebhooksColumns
+FROM %s
+WHERE %s
+ORDER BY id ASC
+LIMIT %s;
+`
+
+func (s *codeMonitorStore) listWebhookActions(ctx context.Context, t webhookTable, opts ListActionsOpts) ([]*WebhookAction, error) {
+	q := sqlf.Sprintf(
+		listWebhookActionsFmtStr,
+		sqlf.Join(webhooksColumns, ","),
+		sqlf.Sprintf(t.name),
+		opts.Conds(),
+		opts.Limit(),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanWebhooks(rows)
+}
+
+// webhooksColumns is the set of columns shared by the cm_webhooks and
+// cm_slack_webhooks tables. The columns are not qualified with a table name so
+// that they can be used with either table.
+// This must be kept in sync with scanWebhook
+var webhooksColumns = []*sqlf.Query{
+	sqlf.Sprintf("id"),
+	sqlf.Sprintf("monitor"),
+	sqlf.Sprintf("enabled"),
+	sqlf.Sprintf("url"),
+	sqlf.Sprintf("created_by"),
+	sqlf.Sprintf("created_at"),
+	sqlf.Sprintf("changed_by"),
+	sqlf.Sprintf("changed_at"),
+}
+
+func scanWebhooks(rows *sql.Rows) ([]*WebhookAction, error) {
+	var ws []*WebhookAction
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		ws = append(ws, w)
+	}
+	return ws, rows.Err()
+}
+
+// scanWebhook scans a WebhookAction from a *sql.Row or *sql.Rows.
+// It must be kept in sync with webhooksColumns.
+func scanWebhook(scanner dbutil.Scanner) (*WebhookAction, error) {
+	w := &WebhookAction{}
+	err := scanner.Scan(
+		&w.ID,
+		&w.Monitor,
+		&w.Enabled,
+		&w.URL,
+		&w.CreatedBy,
+		&w.CreatedAt,
+		&w.ChangedBy,
+		&w.ChangedAt,
+	)
+	return w, err
+}
@@ -0,0 +1,88 @@
+package codemonitors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookActions(t *testing.T) {
+	ctx, db, s := newTestStore(t)
+	_, _, _, userCTX := newTestUser(ctx, t, db)
+	fixtures, err := s.insertTestMonitor(userCTX, t)
+	require.NoError(t, err)
+	monitorID := fixtures.monitor.ID
+
+	w, err := s.CreateWebhookAction(userCTX, monitorID, &WebhookActionArgs{
+		Enabled: true,
+		URL:     "https://example.com/webhook",
+	})
+	require.NoError(t, err)
+
+	sw, err := s.CreateSlackWebhookAction(userCTX, monitorID, &SlackWebhookActionArgs{
+		Enabled: true,
+		URL:     "https://hooks.slack.com/services/test",
+	})
+	require.NoError(t, err)
+
+	updated, err := s.UpdateWebhookAction(userCTX, monitorID, w.ID, &WebhookActionArgs{
+		Enabled: true,
+		URL:     "https://example.com/updated",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/updated", updated.URL)
+
+	// Actions can only be updated through the monitor they are defined on.
+	_, err = s.UpdateWebhookAction(userCTX, monitorID+1, w.ID, &WebhookActionArgs{
+		Enabled: false,
+		URL:     "https://attacker.example.com",
+	})
+	require.Error(t, err)
+	_, err = s.UpdateSlackWebhookAction(userCTX, monitorID+1, sw.ID, &SlackWebhookActionArgs{
+		Enabled: false,
+		URL:     "https://attacker.example.com",
+	})
+	require.Error(t, err)
+
+	got, err := s.GetSlackWebhookAction(ctx, sw.ID)
+	require.NoError(t, err)
+	require.Equal(t, sw, got)
+
+	ws, err := s.ListWebhookActions(ctx, ListActionsOpts{MonitorID: intPtr(int(monitorID))})
+	require.NoError(t, err)
+	require.Equal(t, []*WebhookAction{updated}, ws)
+
+	sws, err := s.ListSlackWebhookActions(ctx, ListActionsOpts{MonitorID: intPtr(int(monitorID)), First: intPtr(1)})
+	require.NoError(t, err)
+	require.Equal(t, []*SlackWebhookAction{sw}, sws)
+
+	sws, err = s.ListSlackWebhookActions(ctx, ListActionsOpts{MonitorID: intPtr(int(monitorID)), After: intPtr(int(sw.ID))})
+	require.NoError(t, err)
+	require.Empty(t, sws)
+
+	count, err := s.CountSlackWebhookActions(ctx, monitorID)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), count)
+
+	triggerJobs, err := s.EnqueueQueryTriggerJobs(ctx)
+	require.NoError(t, err)
+	require.Len(t, triggerJobs, 1)
+
+	// Two emails from the fixtures, one webhook, and one Slack webhook.
+	actionJobs, err := s.EnqueueActionJobsForMonitor(ctx, monitorID, triggerJobs[0].ID)
+	require.NoError(t, err)
+	require.Len(t, actionJobs, 4)
+	require.Equal(t, w.ID, *actionJobs[2].Webhook)
+	require.Equal(t, sw.ID, *actionJobs[3].SlackWebhook)
+
+	err = s.DeleteWebhookActions(ctx, []int64{w.ID}, monitorID)
+	require.NoError(t, err)
+	err = s.DeleteSlackWebhookActions(ctx, []int64{sw.ID}, monitorID)
+	require.NoError(t, err)
+
+	count, err = s.CountWebhookActions(ctx, monitorID)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), count)
+}
+
+func intPtr(i int) *int { return &i }
@@ -0,0 +1,238 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	cm "github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
+)
+
+// digestResultLimit is the number of results a scheduled query fetches unless
+// the query specifies count: itself. Runs with more results fail, because the
+// missing results would show up as removed in the digest.
+const digestResultLimit = 10000
+
+// runScheduledQuery runs a scheduled query and compares its results against the
+// results of the previous run. Actions are enqueued if any result was added or
+// removed. The first run only records the results to compare the next run
+// against.
+func runScheduledQuery(ctx context.Context, s cm.CodeMonitorStore, triggerJob *cm.TriggerJob, q *cm.QueryTrigger, m *cm.Monitor) error {
+	schedule, err := cm.ParseSchedule(*q.Schedule)
+	if err != nil {
+		return err
+	}
+
+	queryString := newQueryWithCount(q.QueryString)
+	results, err := search(ctx, queryString, m.UserID)
+	if err != nil {
+		return err
+	}
+	// Incomplete results would show up as removed in the digest, so we rather
+	// fail the job and let the worker retry it.
+	if err := checkComplete(results); err != nil {
+		return err
+	}
+
+	keys := resultKeys(results.Data.Search.Results.Results)
+
+	previous, ok, err := s.GetPreviousTriggerJobResults(ctx, q.ID, triggerJob.ID)
+	if err != nil {
+		return errors.Errorf("store.GetPreviousTriggerJobResults: %w", err)
+	}
+	var added, removed []string
+	if ok {
+		added, removed = diffResultKeys(previous, keys)
+	}
+
+	// The next run and the digest are persisted before the actions are
+	// enqueued. s is a transaction, so either all of them are stored or none,
+	// and a retried job cannot send the same digest twice.
+	now := s.Clock()()
+	next, err := schedule.Next(now)
+	if err != nil {
+		return err
+	}
+	err = s.SetQueryTriggerNextRun(ctx, q.ID, next, now.UTC())
+	if err != nil {
+		return errors.Errorf("store.SetQueryTriggerNextRun: %w", err)
+	}
+	err = s.UpdateTriggerJobWithDigest(ctx, triggerJob.ID, queryString, keys, added, removed)
+	if err != nil {
+		return errors.Errorf("store.UpdateTriggerJobWithDigest: %w", err)
+	}
+	if len(added)+len(removed) > 0 {
+		_, err := s.EnqueueActionJobsForMonitor(ctx, m.ID, triggerJob.ID)
+		if err != nil {
+			return errors.Errorf("store.EnqueueActionJobsForMonitor: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkComplete returns an error if the search did not return all results,
+// either because repositories timed out or are cloning, or because there are
+// more results than the result limit.
+func checkComplete(results *gqlSearchResponse) error {
+	if n := len(results.Data.Search.Results.Timedout) + len(results.Data.Search.Results.Cloning); n > 0 {
+		return errors.Errorf("search was incomplete: %d repositories timed out or are cloning", n)
+	}
+	if results.Data.Search.Results.LimitHit {
+		return errors.New("search was incomplete: there are more results than the result limit, narrow the query or specify a higher count:")
+	}
+	return nil
+}
+
+var countFieldPattern = regexp.MustCompile(`(?i)(^|\s)count:`)
+
+// newQueryWithCount returns the query with a count: filter, so that the digest
+// covers all results rather than the first page.
+func newQueryWithCount(query string) string {
+	if countFieldPattern.MatchString(query) {
+		return query
+	}
+	return fmt.Sprintf("%s count:%d", query, digestResultLimit)
+}
+
+// resultKeys returns a sorted, deduplicated list of keys identifying the
+// results of a search. Each key starts with the escaped path of the result
+// relative to the external URL (see resultKeyPath), optionally followed by a
+// space and details of the match. Line matches are identified by their content
+// rather than their line number, so that unrelated edits to a file do not show
+// up in the digest.
+func resultKeys(results []interface{}) []string {
+	seen := make(map[string]struct{})
+	add := func(key string) {
+		seen[key] = struct{}{}
+	}
+
+	for _, r := range results {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch m["__typename"] {
+		case "FileMatch":
+			file := fmt.Sprintf("%s/-/blob/%s", escapePath(nestedString(m, "repository", "name")), escapePath(nestedString(m, "file", "path")))
+			lineMatches, _ := m["lineMatches"].([]interface{})
+			symbols, _ := m["symbols"].([]interface{})
+			for _, lm := range lineMatches {
+				lm, _ := lm.(map[string]interface{})
+				add(fmt.Sprintf("%s %s", file, strings.TrimSpace(nestedString(lm, "preview"))))
+			}
+			for _, sym := range symbols {
+				sym, _ := sym.(map[string]interface{})
+				add(fmt.Sprintf("%s %s %s", file, nestedString(sym, "kind"), nestedString(sym, "name")))
+			}
+			if len(lineMatches) == 0 && len(symbols) == 0 {
+				add(file)
+			}
+		case "CommitSearchResult":
+			add(fmt.Sprintf("%s/-/commit/%s", escapePath(nestedString(m, "commit", "repository", "name")), escapePath(nestedString(m, "commit", "oid"))))
+		case "Repository":
+			add(escapePath(nestedString(m, "name")))
+		}
+	}
+
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// escapePath escapes each segment of p, so that the escaped path does not
+// contain any spaces.
+func escapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// resultKeyPath returns the unescaped path of the result identified by key,
+// relative to the external URL.
+func resultKeyPath(key string) string {
+	if i := strings.IndexByte(key, ' '); i >= 0 {
+		key = key[:i]
+	}
+	p, err := url.PathUnescape(key)
+	if err != nil {
+		return key
+	}
+	return p
+}
+
+// digestMaxLinks is the maximum number of added and removed results each that
+// a digest links to.
+const digestMaxLinks = 10
+
+// newDigest returns the digest of a scheduled query run from the keys of the
+// added and removed results. Several keys may refer to the same file, which is
+// only linked once.
+func newDigest(added, removed []string) *email.Digest {
+	linkPaths := func(keys []string) (paths []string, numMore int) {
+		seen := make(map[string]struct{}, len(keys))
+		for _, key := range keys {
+			p := resultKeyPath(key)
+			if _, ok := seen[p]; ok {
+				continue
+			}
+			seen[p] = struct{}{}
+			if len(paths) == digestMaxLinks {
+				numMore++
+				continue
+			}
+			paths = append(paths, p)
+		}
+		return paths, numMore
+	}
+
+	d := &email.Digest{NumAdded: len(added), NumRemoved: len(removed)}
+	d.AddedPaths, d.NumMoreAdded = linkPaths(added)
+	d.RemovedPaths, d.NumMoreRemoved = linkPaths(removed)
+	return d
+}
+
+// nestedString returns the string at the given path of nested JSON objects, or
+// the empty string if there is none.
+func nestedString(m map[string]interface{}, path ...string) string {
+	for i, key := range path {
+		if i == len(path)-1 {
+			s, _ := m[key].(string)
+			return s
+		}
+		m, _ = m[key].(map[string]interface{})
+	}
+	return ""
+}
+
+// diffResultKeys returns the keys in current that are not in previous, and the
+// keys in previous that are not in current.
+func diffResultKeys(previous, current []string) (added, removed []string) {
+	previousSet := make(map[string]struct{}, len(previous))
+	for _, key := range previous {
+		previousSet[key] = struct{}{}
+	}
+	currentSet := make(map[string]struct{}, len(current))
+	for _, key := range current {
+		currentSet[key] = struct{}{}
+		if _, ok := previousSet[key]; !ok {
+			added = append(added, key)
+		}
+	}
+	for _, key := range previous {
+		if _, ok := currentSet[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,133 @@
+package background
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResultKeys(t *testing.T) {
+	var results []interface{}
+	err := json.Unmarshal([]byte(`[
+		{
+			"__typename": "FileMatch",
+			"repository": {"name": "github.com/sourcegraph/sourcegraph"},
+			"file": {"path": "README.md"},
+			"lineMatches": [
+				{"preview": "  TODO: write docs", "lineNumber": 3},
+				{"preview": "TODO: write docs", "lineNumber": 42}
+			],
+			"symbols": []
+		},
+		{
+			"__typename": "FileMatch",
+			"repository": {"name": "github.com/sourcegraph/sourcegraph"},
+			"file": {"path": "main.go"},
+			"lineMatches": [],
+			"symbols": [{"name": "main", "kind": "FUNCTION"}]
+		},
+		{
+			"__typename": "FileMatch",
+			"repository": {"name": "github.com/sourcegraph/sourcegraph"},
+			"file": {"path": "go.mod"},
+			"lineMatches": [],
+			"symbols": []
+		},
+		{
+			"__typename": "FileMatch",
+			"repository": {"name": "github.com/sourcegraph/sourcegraph"},
+			"file": {"path": "my docs/README.md"},
+			"lineMatches": [],
+			"symbols": []
+		},
+		{
+			"__typename": "CommitSearchResult",
+			"commit": {"repository": {"name": "github.com/sourcegraph/zoekt"}, "oid": "deadbeef"}
+		},
+		{
+			"__typename": "Repository",
+			"name": "github.com/sourcegraph/about"
+		}
+	]`), &results)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"github.com/sourcegraph/about",
+		"github.com/sourcegraph/sourcegraph/-/blob/README.md TODO: write docs",
+		"github.com/sourcegraph/sourcegraph/-/blob/go.mod",
+		"github.com/sourcegraph/sourcegraph/-/blob/main.go FUNCTION main",
+		"github.com/sourcegraph/sourcegraph/-/blob/my%20docs/README.md",
+		"github.com/sourcegraph/zoekt/-/commit/deadbeef",
+	}
+	if diff := cmp.Diff(want, resultKeys(results)); diff != "" {
+		t.Fatalf("unexpected keys (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiffResultKeys(t *testing.T) {
+	added, removed := diffResultKeys([]string{"a", "b", "c"}, []string{"b", "c", "d", "e"})
+	if diff := cmp.Diff([]string{"d", "e"}, added); diff != "" {
+		t.Fatalf("unexpected added keys (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"a"}, removed); diff != "" {
+		t.Fatalf("unexpected removed keys (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewDigest(t *testing.T) {
+	var added []string
+	for i := 0; i < digestMaxLinks+2; i++ {
+		added = append(added, fmt.Sprintf("github.com/sourcegraph/sourcegraph/-/blob/file%d.go", i))
+	}
+	// Line matches in the same file are linked once.
+	added = append(added,
+		"github.com/sourcegraph/sourcegraph/-/blob/file0.go TODO: one",
+		"github.com/sourcegraph/sourcegraph/-/blob/file0.go TODO: two",
+	)
+	removed := []string{"github.com/sourcegraph/sourcegraph/-/blob/my%20docs/README.md TODO: write docs"}
+
+	d := newDigest(added, removed)
+	if d.NumAdded != len(added) || d.NumRemoved != 1 {
+		t.Fatalf("got %d added and %d removed, want %d added and 1 removed", d.NumAdded, d.NumRemoved, len(added))
+	}
+	if len(d.AddedPaths) != digestMaxLinks || d.NumMoreAdded != 2 {
+		t.Fatalf("got %d added paths and %d more, want %d and 2 more", len(d.AddedPaths), d.NumMoreAdded, digestMaxLinks)
+	}
+	if diff := cmp.Diff([]string{"github.com/sourcegraph/sourcegraph/-/blob/my docs/README.md"}, d.RemovedPaths); diff != "" {
+		t.Fatalf("unexpected removed paths (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewQueryWithCount(t *testing.T) {
+	for query, want := range map[string]string{
+		"TODO":                    "TODO count:10000",
+		"TODO count:all":          "TODO count:all",
+		"count:50 TODO":           "count:50 TODO",
+		"repo:account-count TODO": "repo:account-count TODO count:10000",
+	} {
+		if got := newQueryWithCount(query); got != want {
+			t.Errorf("newQueryWithCount(%q) = %q, want %q", query, got, want)
+		}
+	}
+}
+
+func TestCheckComplete(t *testing.T) {
+	for body, wantErr := range map[string]bool{
+		`{"data": {"search": {"results": {"limitHit": false, "cloning": [], "timedout": []}}}}`:              false,
+		`{"data": {"search": {"results": {"limitHit": true, "cloning": [], "timedout": []}}}}`:               true,
+		`{"data": {"search": {"results": {"limitHit": false, "cloning": [], "timedout": [{"name": "a"}]}}}}`: true,
+		`{"data": {"search": {"results": {"limitHit": false, "cloning": [{"name": "a"}], "timedout": []}}}}`: true,
+	} {
+		var results *gqlSearchResponse
+		if err := json.Unmarshal([]byte(body), &results); err != nil {
+			t.Fatal(err)
+		}
+		if err := checkComplete(results); (err != nil) != wantErr {
+			t.Errorf("%s: unexpected error %v", body, err)
+		}
+	}
+}
@@ -34,12 +34,25 @@ const gqlSearchQuery = `query CodeMonitorSearch(
 			results {
 				__typename
 				... on FileMatch {
+					repository {
+						name
+					}
+					file {
+						path
+					}
 					limitHit
 					lineMatches {
 						preview
 						lineNumber
 						offsetAndLengths
 					}
+					symbols {
+						name
+						kind
+					}
+				}
+				... on Repository {
+					name
 				}
 				... on CommitSearchResult {
 					refs {
@@ -112,6 +125,7 @@ type gqlSearchResponse struct {
 		Search struct {
 			Results struct {
 				ApproximateResultCount string
+				LimitHit               bool
 				Cloning                []*api.Repo
 				Timedout               []*api.Repo
 				Results                []interface{}
@@ -0,0 +1,93 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
+	"github.com/sourcegraph/sourcegraph/internal/slack"
+)
+
+const utmSourceSlack = "code-monitoring-slack"
+
+func sendSlackNotification(ctx context.Context, url string, args actionArgs) error {
+	payload, err := slackPayload(ctx, args)
+	if err != nil {
+		return err
+	}
+	return postSlackWebhook(ctx, url, payload)
+}
+
+func slackPayload(ctx context.Context, args actionArgs) (*slack.Payload, error) {
+	searchURL, err := email.GetSearchURL(ctx, args.Query, utmSourceSlack)
+	if err != nil {
+		return nil, errors.Wrap(err, "get search URL")
+	}
+
+	codeMonitorURL, err := email.GetCodeMonitorURL(ctx, args.MonitorID, utmSourceSlack)
+	if err != nil {
+		return nil, errors.Wrap(err, "get code monitor URL")
+	}
+
+	detail := numberOfResultsWithDetail(args.NumResults)
+	if args.Digest != nil {
+		detail = args.Digest.Detail() + "."
+		added, removed, err := args.Digest.URLs(ctx, utmSourceSlack)
+		if err != nil {
+			return nil, errors.Wrap(err, "get result URLs")
+		}
+		detail += slackLinkList("Added results", added, args.Digest.NumMoreAdded)
+		detail += slackLinkList("Removed results", removed, args.Digest.NumMoreRemoved)
+	}
+
+	return &slack.Payload{
+		Text: fmt.Sprintf(
+			"Code monitor *%s* triggered a new event. %s\n<%s|View search on Sourcegraph> | <%s|View code monitor>",
+			args.MonitorDescription,
+			detail,
+			searchURL,
+			codeMonitorURL,
+		),
+	}, nil
+}
+
+// slackLinkList formats urls as a bulleted list of links with the given title.
+func slackLinkList(title string, urls []string, numMore int) string {
+	if len(urls) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n%s:", title)
+	for _, u := range urls {
+		fmt.Fprintf(&b, "\n• <%s>", u)
+	}
+	if numMore > 0 {
+		fmt.Fprintf(&b, "\n• and %d more", numMore)
+	}
+	return b.String()
+}
+
+// SendTestSlackWebhook posts a test message to the given Slack incoming
+// webhook so users can verify its configuration before saving a monitor.
+func SendTestSlackWebhook(ctx context.Context, description, url string) error {
+	return postSlackWebhook(ctx, url, &slack.Payload{
+		Text: fmt.Sprintf("Test message for code monitor *%s*. If you can read this, the Slack webhook is configured correctly.", description),
+	})
+}
+
+func postSlackWebhook(ctx context.Context, url string, payload *slack.Payload) error {
+	if url == "" {
+		return errors.New("slack webhook URL is empty")
+	}
+	return slack.New(url).Post(ctx, payload)
+}
+
+func numberOfResultsWithDetail(numResults int) string {
+	if numResults == 1 {
+		return fmt.Sprintf("There was %d new search result for your query.", numResults)
+	}
+	return fmt.Sprintf("There were %d new search results for your query.", numResults)
+}
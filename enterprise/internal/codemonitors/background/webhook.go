@@ -0,0 +1,107 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+const utmSourceWebhook = "code-monitoring-webhook"
+
+// webhookPayload is the JSON body posted to generic webhook actions. Like
+// emails, it deliberately does not contain the search results themselves,
+// which might contain confidential data. Digests link to the results instead.
+type webhookPayload struct {
+	MonitorDescription string `json:"monitorDescription"`
+	MonitorURL         string `json:"monitorURL"`
+	Query              string `json:"query"`
+	SearchURL          string `json:"searchURL"`
+	NumResults         int    `json:"numResults"`
+	IsTest             bool   `json:"isTest,omitempty"`
+
+	// NumAdded, NumRemoved, AddedURLs and RemovedURLs are only set for
+	// scheduled queries. The URLs are truncated to a few results each.
+	NumAdded    *int     `json:"numAdded,omitempty"`
+	NumRemoved  *int     `json:"numRemoved,omitempty"`
+	AddedURLs   []string `json:"addedURLs,omitempty"`
+	RemovedURLs []string `json:"removedURLs,omitempty"`
+}
+
+func sendWebhookNotification(ctx context.Context, url string, args actionArgs) error {
+	searchURL, err := email.GetSearchURL(ctx, args.Query, utmSourceWebhook)
+	if err != nil {
+		return errors.Wrap(err, "get search URL")
+	}
+
+	codeMonitorURL, err := email.GetCodeMonitorURL(ctx, args.MonitorID, utmSourceWebhook)
+	if err != nil {
+		return errors.Wrap(err, "get code monitor URL")
+	}
+
+	payload := webhookPayload{
+		MonitorDescription: args.MonitorDescription,
+		MonitorURL:         codeMonitorURL,
+		Query:              args.Query,
+		SearchURL:          searchURL,
+		NumResults:         args.NumResults,
+	}
+	if args.Digest != nil {
+		payload.NumAdded = &args.Digest.NumAdded
+		payload.NumRemoved = &args.Digest.NumRemoved
+		payload.AddedURLs, payload.RemovedURLs, err = args.Digest.URLs(ctx, utmSourceWebhook)
+		if err != nil {
+			return errors.Wrap(err, "get result URLs")
+		}
+	}
+	return postWebhook(ctx, url, payload)
+}
+
+// SendTestWebhook posts a test payload to the given URL so users can verify
+// the configuration of a webhook action before saving a monitor.
+func SendTestWebhook(ctx context.Context, description, url string) error {
+	return postWebhook(ctx, url, webhookPayload{
+		MonitorDescription: description,
+		IsTest:             true,
+	})
+}
+
+func postWebhook(ctx context.Context, url string, payload webhookPayload) error {
+	if url == "" {
+		return errors.New("webhook URL is empty")
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "marshal webhook payload")
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewReader(b))
+	if err != nil {
+		return errors.Wrap(err, "create webhook request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	resp, err := httpcli.ExternalDoer.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "post webhook")
+	}
+	defer resp.Body.Close()
+
+	// Non-2xx responses fail the action job so that the dbworker retries it.
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("webhook returned unexpected status %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/email"
+	"github.com/sourcegraph/sourcegraph/internal/slack"
+)
+
+func TestSendWebhookNotification(t *testing.T) {
+	email.MockExternalURL = func() *url.URL {
+		externalURL, _ := url.Parse("https://www.sourcegraph.com")
+		return externalURL
+	}
+	t.Cleanup(func() { email.MockExternalURL = nil })
+
+	args := actionArgs{
+		MonitorDescription: "test description",
+		MonitorID:          1,
+		Query:              "test patternType:literal",
+		NumResults:         3,
+	}
+
+	t.Run("webhook", func(t *testing.T) {
+		var got webhookPayload
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer s.Close()
+
+		err := sendWebhookNotification(context.Background(), s.URL, args)
+		require.NoError(t, err)
+
+		want := webhookPayload{
+			MonitorDescription: "test description",
+			MonitorURL:         "https://www.sourcegraph.com/code-monitoring/Q29kZU1vbml0b3I6MQ==?utm_source=code-monitoring-webhook",
+			Query:              "test patternType:literal",
+			SearchURL:          "https://www.sourcegraph.com/search?q=test+patternType%3Aliteral&utm_source=code-monitoring-webhook",
+			NumResults:         3,
+		}
+		require.Equal(t, want, got)
+	})
+
+	t.Run("webhook error status", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer s.Close()
+
+		err := sendWebhookNotification(context.Background(), s.URL, args)
+		require.Error(t, err)
+	})
+
+	t.Run("slack", func(t *testing.T) {
+		var got slack.Payload
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(b, &got))
+		}))
+		defer s.Close()
+
+		err := sendSlackNotification(context.Background(), s.URL, args)
+		require.NoError(t, err)
+
+		want := "Code monitor *test description* triggered a new event. There were 3 new search results for your query.\n" +
+			"<https://www.sourcegraph.com/search?q=test+patternType%3Aliteral&utm_source=code-monitoring-slack|View search on Sourcegraph> | " +
+			"<https://www.sourcegraph.com/code-monitoring/Q29kZU1vbml0b3I6MQ==?utm_source=code-monitoring-slack|View code monitor>"
+		require.Equal(t, want, got.Text)
+	})
+
+	t.Run("empty URL", func(t *testing.T) {
+		require.Error(t, sendWebhookNotification(context.Background(), "", args))
+		require.Error(t, sendSlackNotification(context.Background(), "", args))
+	})
+}
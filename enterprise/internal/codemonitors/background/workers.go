@@ -163,6 +163,10 @@ func (r *queryRunner) Handle(ctx context.Context, record workerutil.Record) (err
 		return err
 	}
 
+	if q.Schedule != nil {
+		return runScheduledQuery(ctx, s, triggerJob, q, m)
+	}
+
 	newQuery := newQueryWithAfterFilter(q)
 
 	// Search.
@@ -223,6 +227,16 @@ func (r *actionRunner) Handle(ctx context.Context, record workerutil.Record) (er
 		return errors.Errorf("store.GetActionJobMetadata: %w", err)
 	}
 
+	args := actionArgs{
+		MonitorDescription: m.Description,
+		MonitorID:          m.MonitorID,
+		Query:              m.Query,
+		NumResults:         zeroOrVal(m.NumResults),
+	}
+	if m.NumAdded != nil {
+		args.Digest = newDigest(m.Added, m.Removed)
+	}
+
 	switch {
 	case j.Email != nil:
 		e, err := s.GetEmailAction(ctx, *j.Email)
@@ -235,7 +249,12 @@ func (r *actionRunner) Handle(ctx context.Context, record workerutil.Record) (er
 			return errors.Errorf("store.AllRecipientsForEmailIDInt64: %w", err)
 		}
 
-		data, err := email.NewTemplateDataForNewSearchResults(ctx, m.Description, m.Query, e, zeroOrVal(m.NumResults))
+		var data *email.TemplateDataNewSearchResults
+		if args.Digest != nil {
+			data, err = email.NewTemplateDataForDigest(ctx, m.Description, m.Query, e, args.Digest)
+		} else {
+			data, err = email.NewTemplateDataForNewSearchResults(ctx, m.Description, m.Query, e, args.NumResults)
+		}
 		if err != nil {
 			return errors.Errorf("email.NewTemplateDataForNewSearchResults: %w", err)
 		}
@@ -253,12 +272,36 @@ func (r *actionRunner) Handle(ctx context.Context, record workerutil.Record) (er
 			}
 		}
 		return nil
+	case j.Webhook != nil:
+		w, err := s.GetWebhookAction(ctx, *j.Webhook)
+		if err != nil {
+			return errors.Errorf("store.GetWebhookAction: %w", err)
+		}
+		return sendWebhookNotification(ctx, w.URL, args)
+	case j.SlackWebhook != nil:
+		w, err := s.GetSlackWebhookAction(ctx, *j.SlackWebhook)
+		if err != nil {
+			return errors.Errorf("store.GetSlackWebhookAction: %w", err)
+		}
+		return sendSlackNotification(ctx, w.URL, args)
 	default:
-		// TODO(camdencheek): handle j.SlackWebhook != nil and j.Webhook != nil
-		return errors.New("cannot yet handle non-email jobs")
+		return errors.New("action job has no associated action")
 	}
 }
 
+// actionArgs holds the details of a trigger event that are shared by all
+// action types.
+type actionArgs struct {
+	MonitorDescription string
+	MonitorID          int64
+	Query              string
+	NumResults         int
+
+	// Digest is set if the trigger event is a run of a scheduled query, in
+	// which case it describes the changes since the previous run.
+	Digest *email.Digest
+}
+
 // newQueryWithAfterFilter constructs a new query which finds search results
 // introduced after the last time we queried.
 func newQueryWithAfterFilter(q *cm.QueryTrigger) string {
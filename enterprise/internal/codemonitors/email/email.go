@@ -38,35 +38,119 @@ type TemplateDataNewSearchResults struct {
 	Description               string
 	NumberOfResultsWithDetail string
 	IsTest                    bool
+
+	// AddedURLs and RemovedURLs link to the results of a digest.
+	AddedURLs      []string
+	RemovedURLs    []string
+	NumMoreAdded   int
+	NumMoreRemoved int
 }
 
 func NewTemplateDataForNewSearchResults(ctx context.Context, monitorDescription, queryString string, email *codemonitors.EmailAction, numResults int) (d *TemplateDataNewSearchResults, err error) {
-	var (
-		searchURL                 string
-		codeMonitorURL            string
-		priority                  string
-		numberOfResultsWithDetail string
-	)
-	searchURL, err = getSearchURL(ctx, queryString, utmSourceEmail)
+	var numberOfResultsWithDetail string
+	if numResults == 1 {
+		numberOfResultsWithDetail = fmt.Sprintf("There was %d new search result for your query", numResults)
+	} else {
+		numberOfResultsWithDetail = fmt.Sprintf("There were %d new search results for your query", numResults)
+	}
+	return newTemplateData(ctx, monitorDescription, queryString, email, numberOfResultsWithDetail)
+}
+
+// Digest describes the changes of the results of a scheduled query since its
+// previous run.
+type Digest struct {
+	NumAdded   int
+	NumRemoved int
+
+	// AddedPaths and RemovedPaths hold the paths of the added and removed
+	// results relative to the external URL. They may be truncated, in which
+	// case NumMoreAdded and NumMoreRemoved count the omitted paths.
+	AddedPaths     []string
+	RemovedPaths   []string
+	NumMoreAdded   int
+	NumMoreRemoved int
+}
+
+// Detail summarizes the digest, such as "2 search results were added and 1
+// search result was removed since the last run".
+func (d *Digest) Detail() string {
+	return DigestDetail(d.NumAdded, d.NumRemoved)
+}
+
+// URLs returns the URLs of the added and removed results, tagged with
+// utmSource.
+func (d *Digest) URLs(ctx context.Context, utmSource string) (added, removed []string, err error) {
+	toURLs := func(paths []string) ([]string, error) {
+		urls := make([]string, 0, len(paths))
+		for _, p := range paths {
+			u, err := sourcegraphURL(ctx, p, "", utmSource)
+			if err != nil {
+				return nil, err
+			}
+			urls = append(urls, u)
+		}
+		return urls, nil
+	}
+	if added, err = toURLs(d.AddedPaths); err != nil {
+		return nil, nil, err
+	}
+	if removed, err = toURLs(d.RemovedPaths); err != nil {
+		return nil, nil, err
+	}
+	return added, removed, nil
+}
+
+// NewTemplateDataForDigest returns the template data for a run of a scheduled
+// query, summarizing the results that were added and removed since the
+// previous run and linking to them.
+func NewTemplateDataForDigest(ctx context.Context, monitorDescription, queryString string, email *codemonitors.EmailAction, digest *Digest) (d *TemplateDataNewSearchResults, err error) {
+	d, err = newTemplateData(ctx, monitorDescription, queryString, email, digest.Detail())
 	if err != nil {
 		return nil, err
 	}
+	d.AddedURLs, d.RemovedURLs, err = digest.URLs(ctx, utmSourceEmail)
+	if err != nil {
+		return nil, err
+	}
+	d.NumMoreAdded, d.NumMoreRemoved = digest.NumMoreAdded, digest.NumMoreRemoved
+	return d, nil
+}
+
+// DigestDetail summarizes the changes of the results of a scheduled query, such
+// as "2 search results were added and 1 search result was removed since the
+// last run".
+func DigestDetail(numAdded, numRemoved int) string {
+	pluralize := func(n int, verb string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 search result was %s", verb)
+		}
+		return fmt.Sprintf("%d search results were %s", n, verb)
+	}
 
-	codeMonitorURL, err = getCodeMonitorURL(ctx, email.Monitor, utmSourceEmail)
+	switch {
+	case numAdded > 0 && numRemoved > 0:
+		return fmt.Sprintf("%s and %s since the last run", pluralize(numAdded, "added"), pluralize(numRemoved, "removed"))
+	case numRemoved > 0:
+		return fmt.Sprintf("%s since the last run", pluralize(numRemoved, "removed"))
+	default:
+		return fmt.Sprintf("%s since the last run", pluralize(numAdded, "added"))
+	}
+}
+
+func newTemplateData(ctx context.Context, monitorDescription, queryString string, email *codemonitors.EmailAction, numberOfResultsWithDetail string) (*TemplateDataNewSearchResults, error) {
+	searchURL, err := GetSearchURL(ctx, queryString, utmSourceEmail)
 	if err != nil {
 		return nil, err
 	}
 
-	if email.Priority == priorityCritical {
-		priority = "Critical"
-	} else {
-		priority = "New"
+	codeMonitorURL, err := GetCodeMonitorURL(ctx, email.Monitor, utmSourceEmail)
+	if err != nil {
+		return nil, err
 	}
 
-	if numResults == 1 {
-		numberOfResultsWithDetail = fmt.Sprintf("There was %d new search result for your query", numResults)
-	} else {
-		numberOfResultsWithDetail = fmt.Sprintf("There were %d new search results for your query", numResults)
+	priority := "New"
+	if email.Priority == priorityCritical {
+		priority = "Critical"
 	}
 
 	return &TemplateDataNewSearchResults{
@@ -105,11 +189,15 @@ func sendEmail(ctx context.Context, userID int32, template txtypes.Templates, da
 	return nil
 }
 
-func getSearchURL(ctx context.Context, query, utmSource string) (string, error) {
+// GetSearchURL returns the URL of the search results page for query on this
+// Sourcegraph instance, tagged with utmSource.
+func GetSearchURL(ctx context.Context, query, utmSource string) (string, error) {
 	return sourcegraphURL(ctx, "search", query, utmSource)
 }
 
-func getCodeMonitorURL(ctx context.Context, monitorID int64, utmSource string) (string, error) {
+// GetCodeMonitorURL returns the URL of the code monitor page for monitorID on
+// this Sourcegraph instance, tagged with utmSource.
+func GetCodeMonitorURL(ctx context.Context, monitorID int64, utmSource string) (string, error) {
 	return sourcegraphURL(ctx, fmt.Sprintf("code-monitoring/%s", relay.MarshalID(MonitorKind, monitorID)), "", utmSource)
 }
 
@@ -14,7 +14,15 @@ Code monitoring triggered a new event:
 
 {{.Description}}
 {{.NumberOfResultsWithDetail}}
-
+{{ if .AddedURLs }}
+Added results:
+{{ range .AddedURLs }}- {{ . }}
+{{ end }}{{ if .NumMoreAdded }}- and {{ .NumMoreAdded }} more
+{{ end }}{{ end }}{{ if .RemovedURLs }}
+Removed results:
+{{ range .RemovedURLs }}- {{ . }}
+{{ end }}{{ if .NumMoreRemoved }}- and {{ .NumMoreRemoved }} more
+{{ end }}{{ end }}
 View search on Sourcegraph {{.SearchURL}}
 
 __
@@ -44,6 +52,20 @@ Sourcegraph limits what information is contained in this notification.
         >{{.NumberOfResultsWithDetail}}</span
       >
     </p>
+	{{ if .AddedURLs }}
+	<p style="font-size: 16px; line-height: 24px">Added results:</p>
+	<ul style="font-size: 14px; line-height: 21px">
+	  {{ range .AddedURLs }}<li><a href="{{ . }}">{{ . }}</a></li>{{ end }}
+	  {{ if .NumMoreAdded }}<li>and {{ .NumMoreAdded }} more</li>{{ end }}
+	</ul>
+	{{ end }}
+	{{ if .RemovedURLs }}
+	<p style="font-size: 16px; line-height: 24px">Removed results:</p>
+	<ul style="font-size: 14px; line-height: 21px">
+	  {{ range .RemovedURLs }}<li><a href="{{ . }}">{{ . }}</a></li>{{ end }}
+	  {{ if .NumMoreRemoved }}<li>and {{ .NumMoreRemoved }} more</li>{{ end }}
+	</ul>
+	{{ end }}
 	<p style="font-size: 16px; line-height: 24px">
 	  <a href="{{.SearchURL}}" {{ if .IsTest }}style="color: #9C9FA6; font-weight: 400; text-decoration: underline; cursor: default"{{ end }}>
         View search on Sourcegraph
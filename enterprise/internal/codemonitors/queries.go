@@ -20,6 +20,10 @@ type QueryTrigger struct {
 	CreatedAt    time.Time
 	ChangedBy    int32
 	ChangedAt    time.Time
+
+	// Schedule is the cron schedule of a scheduled query, see ParseSchedule.
+	// Unscheduled queries run every few minutes and only report new commits.
+	Schedule *string
 }
 
 // queryColumns is the set of columns in cm_queries
@@ -34,16 +38,17 @@ var queryColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_queries.created_at"),
 	sqlf.Sprintf("cm_queries.changed_by"),
 	sqlf.Sprintf("cm_queries.changed_at"),
+	sqlf.Sprintf("cm_queries.schedule"),
 }
 
 const createTriggerQueryFmtStr = `
 INSERT INTO cm_queries
-(monitor, query, created_by, created_at, changed_by, changed_at, next_run, latest_result)
-VALUES (%s,%s,%s,%s,%s,%s,%s,%s)
+(monitor, query, created_by, created_at, changed_by, changed_at, next_run, latest_result, schedule)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s)
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int64, query string) (*QueryTrigger, error) {
+func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int64, query string, schedule *string) (*QueryTrigger, error) {
 	now := s.Now()
 	a := actor.FromContext(ctx)
 	q := sqlf.Sprintf(
@@ -56,6 +61,7 @@ func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int
 		now,
 		now,
 		now,
+		schedule,
 		sqlf.Join(queryColumns, ", "),
 	)
 	row := s.QueryRow(ctx, q)
@@ -67,12 +73,13 @@ UPDATE cm_queries
 SET query = %s,
 	changed_by = %s,
 	changed_at = %s,
-	latest_result = %s
+	latest_result = %s,
+	schedule = %s
 WHERE id = %s
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, query string) error {
+func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, query string, schedule *string) error {
 	now := s.Now()
 	a := actor.FromContext(ctx)
 	q := sqlf.Sprintf(
@@ -81,6 +88,7 @@ func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, que
 		a.UID,
 		now,
 		now,
+		schedule,
 		id,
 		sqlf.Join(queryColumns, ", "),
 	)
@@ -162,6 +170,7 @@ func scanTriggerQuery(scanner dbutil.Scanner) (*QueryTrigger, error) {
 		&m.CreatedAt,
 		&m.ChangedBy,
 		&m.ChangedAt,
+		&m.Schedule,
 	)
 	return m, err
 }
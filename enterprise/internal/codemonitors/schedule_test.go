@@ -0,0 +1,76 @@
+package codemonitors
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	// A Wednesday.
+	from := time.Date(2021, 9, 15, 10, 42, 30, 0, time.UTC)
+
+	for _, tc := range []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2021, 9, 15, 10, 43, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2021, 9, 15, 10, 45, 0, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2021, 9, 16, 9, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2021, 9, 16, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2021, 9, 15, 11, 0, 0, 0, time.UTC)},
+		{"30 8 * * 1-5", time.Date(2021, 9, 16, 8, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2021, 9, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 1", time.Date(2021, 9, 20, 0, 0, 0, 0, time.UTC)},
+		{"0 12 29 2 *", time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)},
+	} {
+		s, err := ParseSchedule(tc.spec)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %s", tc.spec, err)
+		}
+		got, err := s.Next(from)
+		if err != nil {
+			t.Fatalf("Next(%q): %s", tc.spec, err)
+		}
+		if !got.Equal(tc.want) {
+			t.Errorf("Next(%q) = %s, want %s", tc.spec, got, tc.want)
+		}
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@every 5m",
+		"0 0 31 2 *",
+		"0 0 30,31 2 *",
+		"0 0 31 4,6,9,11 *",
+	} {
+		if _, err := ParseSchedule(spec); err == nil {
+			t.Errorf("ParseSchedule(%q): expected error", spec)
+		}
+	}
+}
+
+func TestSchedule_NextAcrossCentury(t *testing.T) {
+	// 2100 is not a leap year, so February 29th is eight years apart.
+	s, err := ParseSchedule("0 0 29 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Next(time.Date(2096, 3, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2104, 2, 29, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Next = %s, want %s", got, want)
+	}
+}
@@ -30,8 +30,8 @@ type CodeMonitorStore interface {
 	ListMonitors(context.Context, ListMonitorsOpts) ([]*Monitor, error)
 	CountMonitors(ctx context.Context, userID int32) (int32, error)
 
-	CreateQueryTrigger(ctx context.Context, monitorID int64, query string) (*QueryTrigger, error)
-	UpdateQueryTrigger(ctx context.Context, id int64, query string) error
+	CreateQueryTrigger(ctx context.Context, monitorID int64, query string, schedule *string) (*QueryTrigger, error)
+	UpdateQueryTrigger(ctx context.Context, id int64, query string, schedule *string) error
 	GetQueryTriggerForMonitor(ctx context.Context, monitorID int64) (*QueryTrigger, error)
 	ResetQueryTriggerTimestamps(ctx context.Context, queryID int64) error
 	SetQueryTriggerNextRun(ctx context.Context, triggerQueryID int64, next time.Time, latestResults time.Time) error
@@ -42,6 +42,8 @@ type CodeMonitorStore interface {
 
 	DeleteObsoleteTriggerJobs(ctx context.Context) error
 	UpdateTriggerJobWithResults(ctx context.Context, triggerJobID int32, queryString string, numResults int) error
+	UpdateTriggerJobWithDigest(ctx context.Context, triggerJobID int32, queryString string, searchResults, added, removed []string) error
+	GetPreviousTriggerJobResults(ctx context.Context, queryID int64, triggerJobID int32) ([]string, bool, error)
 	DeleteOldTriggerJobs(ctx context.Context, retentionInDays int) error
 
 	UpdateEmailAction(_ context.Context, id int64, _ *EmailActionArgs) (*EmailAction, error)
@@ -51,6 +53,20 @@ type CodeMonitorStore interface {
 	GetEmailAction(ctx context.Context, emailID int64) (*EmailAction, error)
 	ListEmailActions(context.Context, ListActionsOpts) ([]*EmailAction, error)
 
+	UpdateWebhookAction(_ context.Context, monitorID, id int64, _ *WebhookActionArgs) (*WebhookAction, error)
+	CreateWebhookAction(ctx context.Context, monitorID int64, _ *WebhookActionArgs) (*WebhookAction, error)
+	DeleteWebhookActions(ctx context.Context, actionIDs []int64, monitorID int64) error
+	CountWebhookActions(ctx context.Context, monitorID int64) (int32, error)
+	GetWebhookAction(ctx context.Context, webhookID int64) (*WebhookAction, error)
+	ListWebhookActions(context.Context, ListActionsOpts) ([]*WebhookAction, error)
+
+	UpdateSlackWebhookAction(_ context.Context, monitorID, id int64, _ *SlackWebhookActionArgs) (*SlackWebhookAction, error)
+	CreateSlackWebhookAction(ctx context.Context, monitorID int64, _ *SlackWebhookActionArgs) (*SlackWebhookAction, error)
+	DeleteSlackWebhookActions(ctx context.Context, actionIDs []int64, monitorID int64) error
+	CountSlackWebhookActions(ctx context.Context, monitorID int64) (int32, error)
+	GetSlackWebhookAction(ctx context.Context, slackWebhookID int64) (*SlackWebhookAction, error)
+	ListSlackWebhookActions(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)
+
 	CreateRecipient(ctx context.Context, emailID int64, userID, orgID *int32) (*Recipient, error)
 	DeleteRecipients(ctx context.Context, emailID int64) error
 	ListRecipients(context.Context, ListRecipientsOpts) ([]*Recipient, error)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
@@ -22,6 +23,12 @@ type TriggerJob struct {
 	Results    *bool
 	NumResults *int32
 
+	// The result keys of a scheduled query and how they differ from the
+	// previous run. Nil for unscheduled queries.
+	SearchResults []string
+	NumAdded      *int32
+	NumRemoved    *int32
+
 	// Fields demanded for any dbworker.
 	State          string
 	FailureMessage *string
@@ -75,27 +82,103 @@ func (s *codeMonitorStore) UpdateTriggerJobWithResults(ctx context.Context, trig
 	return s.Store.Exec(ctx, sqlf.Sprintf(logSearchFmtStr, queryString, numResults > 0, numResults, triggerJobID))
 }
 
+const updateTriggerJobWithDigestFmtStr = `
+UPDATE cm_trigger_jobs
+SET query_string = %s,
+    results = %s,
+    num_results = %s,
+    search_results = %s,
+    num_added = %s,
+    num_removed = %s,
+    added_results = %s,
+    removed_results = %s
+WHERE id = %s
+`
+
+// UpdateTriggerJobWithDigest records the results of a scheduled query run and
+// the keys of the results that were added and removed since the previous run.
+// The run counts as having results if any result was added or removed.
+func (s *codeMonitorStore) UpdateTriggerJobWithDigest(ctx context.Context, triggerJobID int32, queryString string, searchResults, added, removed []string) error {
+	if searchResults == nil {
+		// Distinguish a run without results from an unscheduled run.
+		searchResults = []string{}
+	}
+	numChanged := len(added) + len(removed)
+	return s.Store.Exec(ctx, sqlf.Sprintf(
+		updateTriggerJobWithDigestFmtStr,
+		queryString,
+		numChanged > 0,
+		numChanged,
+		pq.Array(searchResults),
+		len(added),
+		len(removed),
+		pq.Array(added),
+		pq.Array(removed),
+		triggerJobID,
+	))
+}
+
+const getPreviousTriggerJobResultsFmtStr = `
+SELECT search_results
+FROM cm_trigger_jobs
+WHERE query = %s
+AND id < %s
+AND state = 'completed'
+AND search_results IS NOT NULL
+ORDER BY id DESC
+LIMIT 1
+`
+
+// GetPreviousTriggerJobResults returns the result keys of the latest completed
+// run of a scheduled query before the given trigger job. The boolean is false if
+// there is no previous run.
+func (s *codeMonitorStore) GetPreviousTriggerJobResults(ctx context.Context, queryID int64, triggerJobID int32) ([]string, bool, error) {
+	var results []string
+	err := s.Store.QueryRow(ctx, sqlf.Sprintf(getPreviousTriggerJobResultsFmtStr, queryID, triggerJobID)).Scan(pq.Array(&results))
+	if err == sql.ErrNoRows {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return results, true, nil
+}
+
+// latestTriggerJobResultsCond excludes the latest run of each scheduled query
+// from deletion, since the next run is compared against it.
+const latestTriggerJobResultsCond = `
+id NOT IN (
+    SELECT MAX(id) FROM cm_trigger_jobs
+    WHERE state = 'completed'
+    AND search_results IS NOT NULL
+    GROUP BY query
+)
+`
+
 const deleteObsoleteJobLogsFmtStr = `
 DELETE FROM cm_trigger_jobs
 WHERE results IS NOT TRUE
 AND state = 'completed'
+AND %s
 `
 
 // DeleteObsoleteTriggerJobs deletes all runs which are marked as completed and did
-// not return results.
+// not return results, except for the latest run of scheduled queries.
 func (s *codeMonitorStore) DeleteObsoleteTriggerJobs(ctx context.Context) error {
-	return s.Store.Exec(ctx, sqlf.Sprintf(deleteObsoleteJobLogsFmtStr))
+	return s.Store.Exec(ctx, sqlf.Sprintf(deleteObsoleteJobLogsFmtStr, sqlf.Sprintf(latestTriggerJobResultsCond)))
 }
 
 const deleteOldJobLogsFmtStr = `
 DELETE FROM cm_trigger_jobs
-WHERE finished_at < (NOW() - (%s * '1 day'::interval));
+WHERE finished_at < (NOW() - (%s * '1 day'::interval))
+AND %s;
 `
 
 // DeleteOldTriggerJobs deletes trigger jobs which have finished and are older than
-// 'retention' days. Due to cascading, action jobs will be deleted as well.
+// 'retention' days, except for the latest run of scheduled queries. Due to
+// cascading, action jobs will be deleted as well.
 func (s *codeMonitorStore) DeleteOldTriggerJobs(ctx context.Context, retentionInDays int) error {
-	return s.Store.Exec(ctx, sqlf.Sprintf(deleteOldJobLogsFmtStr, retentionInDays))
+	return s.Store.Exec(ctx, sqlf.Sprintf(deleteOldJobLogsFmtStr, retentionInDays, sqlf.Sprintf(latestTriggerJobResultsCond)))
 }
 
 type ListTriggerJobsOpts struct {
@@ -123,7 +206,7 @@ func (o ListTriggerJobsOpts) Limit() *sqlf.Query {
 }
 
 const getEventsForQueryIDInt64FmtStr = `
-SELECT id, query, query_string, results, num_results, state, failure_message, started_at, finished_at, process_after, num_resets, num_failures, log_contents
+SELECT id, query, query_string, results, num_results, search_results, num_added, num_removed, state, failure_message, started_at, finished_at, process_after, num_resets, num_failures, log_contents
 FROM cm_trigger_jobs
 WHERE ((state = 'completed' AND results IS TRUE) OR (state != 'completed'))
 AND %s
@@ -193,6 +276,9 @@ func scanTriggerJob(scanner dbutil.Scanner) (*TriggerJob, error) {
 		&m.QueryString,
 		&m.Results,
 		&m.NumResults,
+		pq.Array(&m.SearchResults),
+		&m.NumAdded,
+		&m.NumRemoved,
 		&m.State,
 		&m.FailureMessage,
 		&m.StartedAt,
@@ -211,6 +297,9 @@ var TriggerJobsColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_trigger_jobs.query_string"),
 	sqlf.Sprintf("cm_trigger_jobs.results"),
 	sqlf.Sprintf("cm_trigger_jobs.num_results"),
+	sqlf.Sprintf("cm_trigger_jobs.search_results"),
+	sqlf.Sprintf("cm_trigger_jobs.num_added"),
+	sqlf.Sprintf("cm_trigger_jobs.num_removed"),
 	sqlf.Sprintf("cm_trigger_jobs.state"),
 	sqlf.Sprintf("cm_trigger_jobs.failure_message"),
 	sqlf.Sprintf("cm_trigger_jobs.started_at"),
@@ -65,3 +65,44 @@ func TestDeleteOldJobLogs(t *testing.T) {
 	}
 	require.Equal(t, secondTriggerJobID, id)
 }
+
+func TestScheduledTriggerJobResults(t *testing.T) {
+	ctx, db, s := newTestStore(t)
+	_, _, _, userCTX := newTestUser(ctx, t, db)
+	fixtures, err := s.insertTestMonitor(userCTX, t)
+	require.NoError(t, err)
+	queryID := fixtures.query.ID
+
+	triggerJobs, err := s.EnqueueQueryTriggerJobs(ctx)
+	require.NoError(t, err)
+	require.Len(t, triggerJobs, 1)
+	firstTriggerJobID := triggerJobs[0].ID
+
+	// The first run has nothing to compare against.
+	_, ok, err := s.GetPreviousTriggerJobResults(ctx, queryID, firstTriggerJobID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	err = s.UpdateTriggerJobWithDigest(ctx, firstTriggerJobID, testQuery, []string{"a", "b"}, nil, nil)
+	require.NoError(t, err)
+	err = s.Exec(ctx, sqlf.Sprintf(setToCompletedFmtStr, s.Now(), s.Now(), firstTriggerJobID))
+	require.NoError(t, err)
+
+	triggerJobs, err = s.EnqueueQueryTriggerJobs(ctx)
+	require.NoError(t, err)
+	require.Len(t, triggerJobs, 1)
+	secondTriggerJobID := triggerJobs[0].ID
+
+	results, ok, err := s.GetPreviousTriggerJobResults(ctx, queryID, secondTriggerJobID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []string{"a", "b"}, results)
+
+	// The first run had no changes, but must be kept since the next run is
+	// compared against it.
+	err = s.DeleteObsoleteTriggerJobs(ctx)
+	require.NoError(t, err)
+	_, ok, err = s.GetPreviousTriggerJobResults(ctx, queryID, secondTriggerJobID)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
@@ -29,6 +29,16 @@ type Job struct {
 	// may be done inside or outside of a Firecracker virtual machine.
 	CliSteps []CliStep `json:"cliSteps"`
 
+	// CacheDirectories describe directories of the workspace that are restored from the
+	// executor host's cache before any step runs, and saved back to the cache once all
+	// steps have completed successfully.
+	CacheDirectories []CacheDirectory `json:"cacheDirectories"`
+
+	// Artifacts describe files of the workspace that are uploaded to the job queue API once
+	// all steps have completed. Artifacts are linked to the job record and expire after the
+	// TTL configured on the Sourcegraph instance.
+	Artifacts []Artifact `json:"artifacts"`
+
 	// RedactedValues is a map from strings to replace to their replacement in the command
 	// output before sending it to the underlying job store. This should contain all worker
 	// environment variables, as well as secret values passed along with the dequeued job
@@ -65,6 +75,32 @@ type CliStep struct {
 	Env []string `json:"env"`
 }
 
+type CacheDirectory struct {
+	// Key identifies the cached contents of the directory. Jobs with the same key share
+	// the cached directory, so the key should change whenever the contents should not
+	// be reused, e.g. by including a hash of the lock file of the dependencies.
+	Key string `json:"key"`
+
+	// Path is the path of the directory relative to the workspace, such as
+	// "node_modules". Tools that cache outside of the workspace by default (such as
+	// Maven in ~/.m2) must be configured to use a directory in the workspace.
+	Path string `json:"path"`
+
+	// KeyFiles are the paths of files relative to the workspace whose contents are
+	// hashed into the key, such as the lock files of the dependencies. The files are
+	// hashed before any step runs. Missing files are allowed.
+	KeyFiles []string `json:"keyFiles,omitempty"`
+}
+
+type Artifact struct {
+	// Name identifies the artifact within the job. It may only contain alphanumeric
+	// characters, dots, dashes, and underscores.
+	Name string `json:"name"`
+
+	// Path is the path of the file relative to the workspace, such as "reports/junit.xml".
+	Path string `json:"path"`
+}
+
 type DequeueRequest struct {
 	ExecutorName string `json:"executorName"`
 }
@@ -0,0 +1,97 @@
+// Package alerts evaluates the alert rules of insight series after new data points are recorded, and
+// notifies the owners of rules that start matching.
+package alerts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+)
+
+// Evaluate evaluates the alert rules of the given series against its latest data points. The owner of a
+// rule is notified when the rule starts matching, and not again until it stopped matching in between.
+func Evaluate(ctx context.Context, alertStore store.SeriesAlertStore, pointsStore store.Interface, series *types.InsightSeries) error {
+	alerts, err := alertStore.GetAlerts(ctx, store.AlertQueryArgs{SeriesID: series.SeriesID})
+	if err != nil {
+		return errors.Wrap(err, "GetAlerts")
+	}
+
+	var multi error
+	for _, alert := range alerts {
+		if err := evaluateAlert(ctx, alertStore, pointsStore, series, alert); err != nil {
+			multi = multierror.Append(multi, errors.Wrapf(err, "alert_id: %d", alert.ID))
+		}
+	}
+	return multi
+}
+
+func evaluateAlert(ctx context.Context, alertStore store.SeriesAlertStore, pointsStore store.Interface, series *types.InsightSeries, alert types.InsightSeriesAlert) error {
+	// 🚨 SECURITY: The aggregated value of a series includes results from every repository. The points are
+	// queried on behalf of the owner of the rule so that the notification only reflects repositories they
+	// have access to.
+	userCtx := actor.WithActor(ctx, actor.FromUser(alert.UserID))
+	points, err := pointsStore.SeriesPoints(userCtx, store.SeriesPointsOpts{
+		SeriesID: &series.SeriesID,
+		Limit:    alert.Intervals + 1,
+	})
+	if err != nil {
+		return errors.Wrap(err, "SeriesPoints")
+	}
+	if len(points) == 0 {
+		return nil
+	}
+
+	matched, detail := match(alert, points)
+	value := points[0].Value
+	if matched && !alert.Triggered {
+		data := &TemplateData{
+			Query:  series.Query,
+			Detail: detail,
+		}
+		if err := SendEmail(ctx, alert.UserID, data); err != nil {
+			// Leave the rule untriggered so that the notification is retried after the next recording.
+			return err
+		}
+	}
+	if matched != alert.Triggered || alert.LastValue == nil || *alert.LastValue != value {
+		return alertStore.SetAlertTriggered(ctx, alert.ID, matched, value)
+	}
+	return nil
+}
+
+// match reports whether the rule matches the given points, which are ordered from the latest to the
+// oldest, and describes why.
+func match(alert types.InsightSeriesAlert, points []store.SeriesPoint) (bool, string) {
+	latest := points[0].Value
+	switch alert.Condition {
+	case types.AlertAbove:
+		return latest > alert.Threshold, fmt.Sprintf("The value of the series is %v, above the threshold of %v.", latest, alert.Threshold)
+	case types.AlertBelow:
+		return latest < alert.Threshold, fmt.Sprintf("The value of the series is %v, below the threshold of %v.", latest, alert.Threshold)
+	case types.AlertIncrease, types.AlertDecrease:
+		if len(points) <= alert.Intervals {
+			// Not enough data to compare against yet.
+			return false, ""
+		}
+		previous := points[alert.Intervals].Value
+		if alert.Condition == types.AlertIncrease {
+			return latest-previous >= alert.Threshold, fmt.Sprintf("The value of the series increased from %v to %v over the last %s.", previous, latest, pluralizeIntervals(alert.Intervals))
+		}
+		return previous-latest >= alert.Threshold, fmt.Sprintf("The value of the series decreased from %v to %v over the last %s.", previous, latest, pluralizeIntervals(alert.Intervals))
+	default:
+		return false, ""
+	}
+}
+
+func pluralizeIntervals(n int) string {
+	if n == 1 {
+		return "interval"
+	}
+	return fmt.Sprintf("%d intervals", n)
+}
@@ -0,0 +1,102 @@
+package alerts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+)
+
+func TestMatch(t *testing.T) {
+	points := func(values ...float64) []store.SeriesPoint {
+		var points []store.SeriesPoint
+		for _, v := range values {
+			points = append(points, store.SeriesPoint{Value: v})
+		}
+		return points
+	}
+
+	for _, tc := range []struct {
+		name   string
+		alert  types.InsightSeriesAlert
+		points []store.SeriesPoint
+		want   bool
+	}{
+		{"above", types.InsightSeriesAlert{Condition: types.AlertAbove, Threshold: 10}, points(11), true},
+		{"not above", types.InsightSeriesAlert{Condition: types.AlertAbove, Threshold: 10}, points(10), false},
+		{"below", types.InsightSeriesAlert{Condition: types.AlertBelow, Threshold: 10}, points(9), true},
+		{"not below", types.InsightSeriesAlert{Condition: types.AlertBelow, Threshold: 10}, points(10), false},
+		{"increase", types.InsightSeriesAlert{Condition: types.AlertIncrease, Threshold: 1, Intervals: 1}, points(5, 4), true},
+		{"no increase", types.InsightSeriesAlert{Condition: types.AlertIncrease, Threshold: 1, Intervals: 1}, points(4, 4), false},
+		{"increase over intervals", types.InsightSeriesAlert{Condition: types.AlertIncrease, Threshold: 2, Intervals: 2}, points(6, 3, 4), true},
+		{"increase without enough data", types.InsightSeriesAlert{Condition: types.AlertIncrease, Threshold: 1, Intervals: 2}, points(6, 3), false},
+		{"decrease", types.InsightSeriesAlert{Condition: types.AlertDecrease, Threshold: 3, Intervals: 1}, points(1, 4), true},
+		{"no decrease", types.InsightSeriesAlert{Condition: types.AlertDecrease, Threshold: 3, Intervals: 1}, points(2, 4), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if have, _ := match(tc.alert, tc.points); have != tc.want {
+				t.Errorf("want %v, have %v", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	lastValue := 3.0
+	alertStore := store.NewMockSeriesAlertStore()
+	alertStore.GetAlertsFunc.SetDefaultReturn([]types.InsightSeriesAlert{
+		{ID: 1, UserID: 1, Condition: types.AlertAbove, Threshold: 3, Intervals: 1},
+		{ID: 2, UserID: 2, Condition: types.AlertAbove, Threshold: 3, Intervals: 1, Triggered: true, LastValue: &lastValue},
+		{ID: 3, UserID: 3, Condition: types.AlertIncrease, Threshold: 1, Intervals: 1, Triggered: true, LastValue: &lastValue},
+	}, nil)
+
+	pointsStore := store.NewMockInterface()
+	pointsStore.SeriesPointsFunc.SetDefaultHook(func(ctx context.Context, opts store.SeriesPointsOpts) ([]store.SeriesPoint, error) {
+		// The value only includes the repositories the owner of the rule has access to.
+		if actor.FromContext(ctx).UID == 2 {
+			return []store.SeriesPoint{{Value: 3}, {Value: 1}}, nil
+		}
+		return []store.SeriesPoint{{Value: 4}, {Value: 4}}, nil
+	})
+
+	var notified []int32
+	MockSendEmail = func(ctx context.Context, userID int32, data *TemplateData) error {
+		if data.Query != "deprecated.Call(" {
+			t.Errorf("unexpected query %q", data.Query)
+		}
+		notified = append(notified, userID)
+		return nil
+	}
+	t.Cleanup(func() { MockSendEmail = nil })
+
+	err := Evaluate(context.Background(), alertStore, pointsStore, &types.InsightSeries{SeriesID: "series", Query: "deprecated.Call("})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]int32{1}, notified); diff != "" {
+		t.Errorf("unexpected notified users (-want +got):\n%s", diff)
+	}
+
+	type call struct {
+		ID        int
+		Triggered bool
+		Value     float64
+	}
+	var calls []call
+	for _, c := range alertStore.SetAlertTriggeredFunc.History() {
+		calls = append(calls, call{c.Arg1, c.Arg2, c.Arg3})
+	}
+	want := []call{
+		{ID: 1, Triggered: true, Value: 4},
+		{ID: 2, Triggered: false, Value: 3},
+		{ID: 3, Triggered: false, Value: 4},
+	}
+	if diff := cmp.Diff(want, calls); diff != "" {
+		t.Errorf("unexpected SetAlertTriggered calls (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,69 @@
+package alerts
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/api/internalapi"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+)
+
+const utmSourceEmail = "code-insights-alert-email"
+
+var MockSendEmail func(ctx context.Context, userID int32, data *TemplateData) error
+
+// TemplateData is the data of an alert notification email.
+type TemplateData struct {
+	Query       string
+	Detail      string
+	InsightsURL string
+}
+
+// SendEmail notifies the given user that an alert rule matched.
+func SendEmail(ctx context.Context, userID int32, data *TemplateData) error {
+	if MockSendEmail != nil {
+		return MockSendEmail(ctx, userID, data)
+	}
+
+	insightsURL, err := insightsURL(ctx)
+	if err != nil {
+		return err
+	}
+	data.InsightsURL = insightsURL
+
+	email, err := internalapi.Client.UserEmailsGetEmail(ctx, userID)
+	if err != nil {
+		return errors.Errorf("internalapi.Client.UserEmailsGetEmail for userID=%d: %w", userID, err)
+	}
+	if email == nil {
+		return errors.Errorf("unable to send email to user ID %d with unknown email address", userID)
+	}
+	if err := internalapi.Client.SendEmail(ctx, txtypes.Message{
+		To:       []string{*email},
+		Template: alertEmailTemplates,
+		Data:     data,
+	}); err != nil {
+		return errors.Errorf("internalapi.Client.SendEmail to email=%q userID=%d: %w", *email, userID, err)
+	}
+	return nil
+}
+
+// insightsURL returns the URL of the insights dashboards on this Sourcegraph instance.
+func insightsURL(ctx context.Context) (string, error) {
+	externalURLStr, err := internalapi.Client.ExternalURL(ctx)
+	if err != nil {
+		return "", errors.Errorf("failed to get ExternalURL: %w", err)
+	}
+	externalURL, err := url.Parse(externalURLStr)
+	if err != nil {
+		return "", errors.Errorf("failed to get ExternalURL: %w", err)
+	}
+
+	u := externalURL.ResolveReference(&url.URL{Path: "insights/dashboards/all"})
+	q := u.Query()
+	q.Set("utm_source", utmSourceEmail)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
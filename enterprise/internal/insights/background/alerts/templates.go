@@ -0,0 +1,46 @@
+package alerts
+
+import (
+	"github.com/sourcegraph/sourcegraph/internal/txemail"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+)
+
+var alertEmailTemplates = txemail.MustValidate(txtypes.Templates{
+	Subject: `[Code Insights alert] {{.Query}}`,
+	Text: `
+A code insight alert was triggered for the series with the query:
+
+{{.Query}}
+
+{{.Detail}}
+
+View insights on Sourcegraph: {{.InsightsURL}}
+
+__
+You are receiving this notification because you created an alert on a code insight series.
+`,
+	HTML: `
+<!DOCTYPE html>
+<html>
+  <body>
+    <p style="font-size: 16px; line-height: 24px">
+      A code insight alert was triggered for the series with the query:
+    </p>
+    <p style="font-size: 20px; line-height: 30px; font-weight: 700">
+      <code>{{.Query}}</code><br />
+      <span style="font-size: 16px; line-height: 24px; font-weight: 400">{{.Detail}}</span>
+    </p>
+    <p style="font-size: 16px; line-height: 24px">
+      <a href="{{.InsightsURL}}">View insights on Sourcegraph</a>
+    </p>
+    <br />
+    <br />
+    __
+    <p style="font-size: 14px; line-height: 24px">
+      You are receiving this notification because you created an alert on a code insight series.
+    </p>
+    <img src="https://about.sourcegraph.com/sourcegraph-logo-small.png" width="106" height="20" alt="Sourcegraph logo" />
+  </body>
+</html>
+`,
+})
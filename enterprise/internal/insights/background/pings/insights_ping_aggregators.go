@@ -103,10 +103,20 @@ func (e *InsightsPingEmitter) GetOrgVisibleInsightCounts(ctx context.Context) (_
 			return []types.OrgVisibleInsightPing{}, err
 		}
 
-		if presentationType == insightTypes.Line {
-			results = append(results, types.OrgVisibleInsightPing{Type: "search", TotalCount: count})
-		} else {
-			results = append(results, types.OrgVisibleInsightPing{Type: "lang-stats", TotalCount: count})
+		// Line charts, bar charts and tables are all backed by search series.
+		pingType := "search"
+		if presentationType == insightTypes.Pie {
+			pingType = "lang-stats"
+		}
+		found := false
+		for i := range results {
+			if results[i].Type == pingType {
+				results[i].TotalCount += count
+				found = true
+			}
+		}
+		if !found {
+			results = append(results, types.OrgVisibleInsightPing{Type: pingType, TotalCount: count})
 		}
 	}
 	return results, nil
@@ -21,6 +21,7 @@ import (
 	"github.com/inconshreveable/log15"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/background/alerts"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 )
@@ -33,6 +34,7 @@ type workHandler struct {
 	baseWorkerStore *basestore.Store
 	insightsStore   *store.Store
 	metadadataStore *store.InsightStore
+	alertStore      store.SeriesAlertStore
 	limiter         *rate.Limiter
 
 	mu          sync.RWMutex
@@ -170,6 +172,19 @@ func (r *workHandler) Handle(ctx context.Context, record workerutil.Record) (err
 		matchesPerRepo[decoded.repoID()] = matchesPerRepo[decoded.repoID()] + decoded.matchCount()
 	}
 
+	// Evaluate alert rules on the series once the new points are committed. Historical recordings are
+	// skipped, since alerts react to the current value of a series.
+	if job.RecordTime == nil {
+		defer func() {
+			if err != nil {
+				return
+			}
+			if alertErr := alerts.Evaluate(ctx, r.alertStore, r.insightsStore, series); alertErr != nil {
+				log15.Error("insights.queryrunner.workHandler: failed to evaluate alerts", "seriesID", series.SeriesID, "error", alertErr)
+			}
+		}()
+	}
+
 	tx, err := r.insightsStore.Transact(ctx)
 	if err != nil {
 		return err
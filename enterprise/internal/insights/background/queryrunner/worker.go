@@ -83,6 +83,7 @@ func NewWorker(ctx context.Context, workerStore dbworkerstore.Store, insightsSto
 		insightsStore:   insightsStore,
 		limiter:         limiter,
 		metadadataStore: store.NewInsightStore(insightsStore.Handle().DB()),
+		alertStore:      store.NewAlertStore(insightsStore.Handle().DB()),
 		seriesCache:     sharedCache,
 	}, options)
 }
@@ -0,0 +1,234 @@
+// Package export writes the recorded data points of code insight views in formats that can be consumed
+// by other tools, such as spreadsheets and BI tools.
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+)
+
+// Format is the format data points are exported in.
+type Format string
+
+const (
+	// FormatCSV exports one comma-separated row per data point, preceded by a header row.
+	FormatCSV Format = "CSV"
+	// FormatNDJSON exports one JSON object per data point, separated by newlines.
+	FormatNDJSON Format = "NDJSON"
+)
+
+// ParseFormat returns the format with the given case-insensitive name.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToUpper(s)); f {
+	case FormatCSV, FormatNDJSON:
+		return f, nil
+	default:
+		return "", errors.Newf("unsupported export format %q", s)
+	}
+}
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	if f == FormatNDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Extension returns the file extension of the format, without the leading dot.
+func (f Format) Extension() string {
+	if f == FormatNDJSON {
+		return "ndjson"
+	}
+	return "csv"
+}
+
+// Options configures which data points are exported and how.
+type Options struct {
+	Format Format
+
+	// GroupBy, if non-empty, breaks the points of each series down by repository or repository owner.
+	GroupBy store.BreakdownGroupBy
+
+	IncludeRepoRegex string
+	ExcludeRepoRegex string
+
+	// Time ranges to export from/to, if non-nil.
+	From, To *time.Time
+}
+
+// Record is a single exported data point.
+type Record struct {
+	SeriesID string    `json:"seriesId"`
+	Label    string    `json:"label"`
+	Group    string    `json:"group,omitempty"`
+	Time     time.Time `json:"time"`
+	Value    float64   `json:"value"`
+}
+
+// Write writes the data points of the given series of an insight view to w, series by series and in
+// ascending time order.
+//
+// 🚨 SECURITY: The caller is responsible for checking that the user in the context can view the insight
+// view. Points of repositories the user cannot access are excluded by the store.
+func Write(ctx context.Context, w io.Writer, pointsStore store.Interface, series []types.InsightViewSeries, opts Options) error {
+	if err := checkSeries(series); err != nil {
+		return err
+	}
+	if err := checkGroupBy(opts.GroupBy); err != nil {
+		return err
+	}
+
+	rw, err := newRecordWriter(w, opts)
+	if err != nil {
+		return err
+	}
+	for _, s := range series {
+		records, err := seriesRecords(ctx, pointsStore, s, opts)
+		if err != nil {
+			return errors.Wrapf(err, "series_id: %s", s.SeriesID)
+		}
+		for _, record := range records {
+			if err := rw.write(record); err != nil {
+				return err
+			}
+		}
+		if err := rw.flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSeries returns an error if any of the series has no recorded data points that could be exported.
+func checkSeries(series []types.InsightViewSeries) error {
+	for _, s := range series {
+		if s.GenerationMethod == types.LanguageStats || s.JustInTime || s.GeneratedFromCaptureGroups {
+			return errors.Newf("series %s is not recorded in the background and cannot be exported", s.SeriesID)
+		}
+	}
+	return nil
+}
+
+func checkGroupBy(groupBy store.BreakdownGroupBy) error {
+	switch groupBy {
+	case "", store.GroupByRepository, store.GroupByOwner:
+		return nil
+	default:
+		return errors.Newf("unsupported breakdown %q", groupBy)
+	}
+}
+
+func seriesRecords(ctx context.Context, pointsStore store.Interface, series types.InsightViewSeries, opts Options) ([]Record, error) {
+	seriesID := series.SeriesID
+	pointsOpts := store.SeriesPointsOpts{
+		SeriesID:         &seriesID,
+		IncludeRepoRegex: opts.IncludeRepoRegex,
+		ExcludeRepoRegex: opts.ExcludeRepoRegex,
+		From:             opts.From,
+		To:               opts.To,
+	}
+
+	var records []Record
+	if opts.GroupBy == "" {
+		points, err := pointsStore.SeriesPoints(ctx, pointsOpts)
+		if err != nil {
+			return nil, err
+		}
+		records = make([]Record, 0, len(points))
+		for _, p := range points {
+			records = append(records, Record{SeriesID: seriesID, Label: series.Label, Time: p.Time, Value: p.Value})
+		}
+		reverse(records)
+		return records, nil
+	}
+
+	points, err := pointsStore.SeriesPointsBreakdown(ctx, pointsOpts, opts.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+	// Points are ordered by group and then by descending time, so each group is a contiguous run of
+	// points that is reversed to export it in ascending time order.
+	records = make([]Record, 0, len(points))
+	start := 0
+	for i, p := range points {
+		if i > 0 && p.Group != points[i-1].Group {
+			reverse(records[start:])
+			start = i
+		}
+		records = append(records, Record{SeriesID: seriesID, Label: series.Label, Group: p.Group, Time: p.Time, Value: p.Value})
+	}
+	reverse(records[start:])
+	return records, nil
+}
+
+func reverse(records []Record) {
+	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+		records[i], records[j] = records[j], records[i]
+	}
+}
+
+type recordWriter struct {
+	write func(Record) error
+	flush func() error
+}
+
+func newRecordWriter(w io.Writer, opts Options) (*recordWriter, error) {
+	switch opts.Format {
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		header := []string{"series_id", "label", "time", "value"}
+		if opts.GroupBy != "" {
+			header = []string{"series_id", "label", "group", "time", "value"}
+		}
+		if err := cw.Write(header); err != nil {
+			return nil, err
+		}
+		return &recordWriter{
+			write: func(r Record) error {
+				row := []string{csvText(r.SeriesID), csvText(r.Label), r.Time.UTC().Format(time.RFC3339), strconv.FormatFloat(r.Value, 'f', -1, 64)}
+				if opts.GroupBy != "" {
+					row = []string{row[0], row[1], csvText(r.Group), row[2], row[3]}
+				}
+				return cw.Write(row)
+			},
+			flush: func() error {
+				cw.Flush()
+				return cw.Error()
+			},
+		}, nil
+
+	case FormatNDJSON:
+		enc := json.NewEncoder(w)
+		return &recordWriter{
+			write: func(r Record) error {
+				r.Time = r.Time.UTC()
+				return enc.Encode(r)
+			},
+			flush: func() error { return nil },
+		}, nil
+
+	default:
+		return nil, errors.Newf("unsupported export format %q", opts.Format)
+	}
+}
+
+// csvText prefixes text that spreadsheet applications would interpret as a
+// formula with a single quote, so that opening an export cannot run formulas
+// hidden in labels or repository names.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,168 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+)
+
+func TestWrite(t *testing.T) {
+	t1 := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	pointsStore := store.NewMockInterface()
+	// Points are returned in descending time order, like the store does.
+	pointsStore.SeriesPointsFunc.SetDefaultReturn([]store.SeriesPoint{
+		{SeriesID: "s1", Time: t2, Value: 5},
+		{SeriesID: "s1", Time: t1, Value: 2.5},
+	}, nil)
+	pointsStore.SeriesPointsBreakdownFunc.SetDefaultReturn([]store.BreakdownSeriesPoint{
+		{SeriesPoint: store.SeriesPoint{SeriesID: "s1", Time: t2, Value: 3}, Group: "github.com/a/b"},
+		{SeriesPoint: store.SeriesPoint{SeriesID: "s1", Time: t1, Value: 2}, Group: "github.com/a/b"},
+		{SeriesPoint: store.SeriesPoint{SeriesID: "s1", Time: t2, Value: 2}, Group: "github.com/c/d"},
+	}, nil)
+
+	series := []types.InsightViewSeries{{SeriesID: "s1", Label: "errors, total"}}
+
+	for _, tc := range []struct {
+		name string
+		opts Options
+		want string
+	}{
+		{
+			name: "csv",
+			opts: Options{Format: FormatCSV},
+			want: `series_id,label,time,value
+s1,"errors, total",2021-11-01T00:00:00Z,2.5
+s1,"errors, total",2021-12-01T00:00:00Z,5
+`,
+		},
+		{
+			name: "csv breakdown",
+			opts: Options{Format: FormatCSV, GroupBy: store.GroupByRepository},
+			want: `series_id,label,group,time,value
+s1,"errors, total",github.com/a/b,2021-11-01T00:00:00Z,2
+s1,"errors, total",github.com/a/b,2021-12-01T00:00:00Z,3
+s1,"errors, total",github.com/c/d,2021-12-01T00:00:00Z,2
+`,
+		},
+		{
+			name: "ndjson",
+			opts: Options{Format: FormatNDJSON},
+			want: `{"seriesId":"s1","label":"errors, total","time":"2021-11-01T00:00:00Z","value":2.5}
+{"seriesId":"s1","label":"errors, total","time":"2021-12-01T00:00:00Z","value":5}
+`,
+		},
+		{
+			name: "ndjson breakdown",
+			opts: Options{Format: FormatNDJSON, GroupBy: store.GroupByRepository},
+			want: `{"seriesId":"s1","label":"errors, total","group":"github.com/a/b","time":"2021-11-01T00:00:00Z","value":2}
+{"seriesId":"s1","label":"errors, total","group":"github.com/a/b","time":"2021-12-01T00:00:00Z","value":3}
+{"seriesId":"s1","label":"errors, total","group":"github.com/c/d","time":"2021-12-01T00:00:00Z","value":2}
+`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(context.Background(), &buf, pointsStore, series, tc.opts); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, buf.String()); diff != "" {
+				t.Errorf("unexpected export (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("filters", func(t *testing.T) {
+		opts := Options{Format: FormatCSV, IncludeRepoRegex: "a/", ExcludeRepoRegex: "c/", From: &t1, To: &t2}
+		if err := Write(context.Background(), &bytes.Buffer{}, pointsStore, series, opts); err != nil {
+			t.Fatal(err)
+		}
+		history := pointsStore.SeriesPointsFunc.History()
+		have := history[len(history)-1].Arg1
+		seriesID := "s1"
+		want := store.SeriesPointsOpts{SeriesID: &seriesID, IncludeRepoRegex: "a/", ExcludeRepoRegex: "c/", From: &t1, To: &t2}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("unexpected options (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("just in time series", func(t *testing.T) {
+		series := []types.InsightViewSeries{{SeriesID: "s1"}, {SeriesID: "s2", JustInTime: true}}
+		var buf bytes.Buffer
+		if err := Write(context.Background(), &buf, pointsStore, series, Options{Format: FormatCSV}); err == nil {
+			t.Fatal("expected error")
+		}
+		if buf.Len() != 0 {
+			t.Errorf("expected nothing to be written, got %q", buf.String())
+		}
+	})
+}
+
+func TestParseOptions(t *testing.T) {
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		query   string
+		want    Options
+		wantErr bool
+	}{
+		{query: "", want: Options{Format: FormatCSV}},
+		{query: "format=ndjson&breakdown=owner", want: Options{Format: FormatNDJSON, GroupBy: store.GroupByOwner}},
+		{query: "includeRepoRegex=a&excludeRepoRegex=b&from=2021-01-01T00:00:00Z", want: Options{Format: FormatCSV, IncludeRepoRegex: "a", ExcludeRepoRegex: "b", From: &from}},
+		{query: "format=xml", wantErr: true},
+		{query: "breakdown=language", wantErr: true},
+		{query: "to=yesterday", wantErr: true},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			have, err := parseOptions(httptest.NewRequest("GET", "/insights/export/id?"+tc.query, nil))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Errorf("unexpected options (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHandlerRequiresAuthentication(t *testing.T) {
+	h := &handler{}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/insights/export/id", nil))
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("want status %d, have %d", http.StatusUnauthorized, w.Code)
+	}
+}
+
+func TestCSVText(t *testing.T) {
+	for s, want := range map[string]string{
+		"":                      "",
+		"errors":                "errors",
+		"=HYPERLINK(\"x\")":     "'=HYPERLINK(\"x\")",
+		"+1":                    "'+1",
+		"-1":                    "'-1",
+		"@SUM(A1)":              "'@SUM(A1)",
+		"\t=1":                  "'\t=1",
+		"github.com/a/b":        "github.com/a/b",
+		"total = errors + bugs": "total = errors + bugs",
+	} {
+		if got := csvText(s); got != want {
+			t.Errorf("csvText(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package export
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gorilla/mux"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+type handler struct {
+	insightStore *store.InsightStore
+	pointsStore  store.Interface
+	orgStore     database.OrgStore
+}
+
+// NewHandler returns an HTTP handler that exports the data points of the insight view whose GraphQL ID
+// is the "id" route variable. The following query parameters are supported:
+//
+//   format            csv (default) or ndjson
+//   breakdown         repository or owner, to break the points of each series down
+//   includeRepoRegex  defaults to the default filter of the insight view
+//   excludeRepoRegex  defaults to the default filter of the insight view
+//   from, to          RFC 3339 timestamps limiting the exported time range
+func NewHandler(insightsDB, postgresDB dbutil.DB) http.Handler {
+	return &handler{
+		insightStore: store.NewInsightStore(insightsDB),
+		pointsStore:  store.New(insightsDB, store.NewInsightPermissionStore(postgresDB)),
+		orgStore:     database.Orgs(postgresDB),
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		http.Error(w, "not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	var viewID string
+	if err := relay.UnmarshalSpec(graphql.ID(mux.Vars(r)["id"]), &viewID); err != nil {
+		http.Error(w, "invalid insight view id", http.StatusBadRequest)
+		return
+	}
+	opts, err := parseOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// 🚨 SECURITY: Only export insight views that are visible to the user, either directly or through
+	// one of their organizations.
+	orgs, err := h.orgStore.GetByUserID(ctx, a.UID)
+	if err != nil {
+		log15.Error("insights.export: failed to get the organizations of the user", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	orgIDs := make([]int, 0, len(orgs))
+	for _, org := range orgs {
+		orgIDs = append(orgIDs, int(org.ID))
+	}
+	series, err := h.insightStore.GetAll(ctx, store.InsightQueryArgs{UniqueID: viewID, UserID: []int{int(a.UID)}, OrgID: orgIDs})
+	if err != nil {
+		log15.Error("insights.export: failed to get the insight view", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if len(series) == 0 {
+		http.Error(w, "insight view not found", http.StatusNotFound)
+		return
+	}
+	if err := checkSeries(series); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Filters that are not provided default to the filters saved on the insight view.
+	q := r.URL.Query()
+	if _, ok := q["includeRepoRegex"]; !ok && series[0].DefaultFilterIncludeRepoRegex != nil {
+		opts.IncludeRepoRegex = *series[0].DefaultFilterIncludeRepoRegex
+	}
+	if _, ok := q["excludeRepoRegex"]; !ok && series[0].DefaultFilterExcludeRepoRegex != nil {
+		opts.ExcludeRepoRegex = *series[0].DefaultFilterExcludeRepoRegex
+	}
+
+	w.Header().Set("Content-Type", opts.Format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "insight-"+viewID+"."+opts.Format.Extension()))
+	if err := Write(ctx, w, h.pointsStore, series, opts); err != nil {
+		// The response has already been started, so the error can only be logged.
+		log15.Error("insights.export: failed to write data points", "view_id", viewID, "error", err)
+	}
+}
+
+func parseOptions(r *http.Request) (Options, error) {
+	q := r.URL.Query()
+
+	opts := Options{
+		Format:           FormatCSV,
+		IncludeRepoRegex: q.Get("includeRepoRegex"),
+		ExcludeRepoRegex: q.Get("excludeRepoRegex"),
+	}
+	if format := q.Get("format"); format != "" {
+		f, err := ParseFormat(format)
+		if err != nil {
+			return Options{}, err
+		}
+		opts.Format = f
+	}
+	if breakdown := q.Get("breakdown"); breakdown != "" {
+		opts.GroupBy = store.BreakdownGroupBy(strings.ToUpper(breakdown))
+		if err := checkGroupBy(opts.GroupBy); err != nil {
+			return Options{}, err
+		}
+	}
+	var err error
+	if opts.From, err = parseTime(q.Get("from")); err != nil {
+		return Options{}, err
+	}
+	if opts.To, err = parseTime(q.Get("to")); err != nil {
+		return Options{}, err
+	}
+	return opts, nil
+}
+
+func parseTime(v string) (*time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, errors.Newf("invalid timestamp %q, expected RFC 3339", v)
+	}
+	return &t, nil
+}
@@ -48,6 +48,8 @@ func (c *CaptureGroupExecutor) Execute(ctx context.Context, query string, reposi
 
 	type timeCounts map[time.Time]int
 	pivoted := make(map[string]timeCounts)
+	// repoPivoted holds the same counts as pivoted, additionally keyed by repository.
+	repoPivoted := make(map[string]map[string]timeCounts)
 
 	for _, repository := range repositories {
 		firstCommit, err := git.FirstEverCommit(ctx, api.RepoName(repository))
@@ -105,10 +107,16 @@ func (c *CaptureGroupExecutor) Execute(ctx context.Context, query string, reposi
 				value := timeGroupElement.Value
 				if _, ok := pivoted[value]; !ok {
 					pivoted[value] = generateTimes()
+					repoPivoted[value] = make(map[string]timeCounts)
 				}
-				pivoted[value][execution.RecordingTime] = timeGroupElement.Count
+				if _, ok := repoPivoted[value][repository]; !ok {
+					repoPivoted[value][repository] = generateTimes()
+				}
+				pivoted[value][execution.RecordingTime] += timeGroupElement.Count
+				repoPivoted[value][repository][execution.RecordingTime] = timeGroupElement.Count
 				for _, children := range execution.SharedRecordings {
 					pivoted[value][children] += timeGroupElement.Count
+					repoPivoted[value][repository][children] += timeGroupElement.Count
 				}
 			}
 		}
@@ -117,29 +125,38 @@ func (c *CaptureGroupExecutor) Execute(ctx context.Context, query string, reposi
 	var calculated []GeneratedTimeSeries
 	seriesCount := 1
 	for value, timeCounts := range pivoted {
-		var timeseries []queryrunner.TimeDataPoint
-
-		for key, val := range timeCounts {
-			timeseries = append(timeseries, queryrunner.TimeDataPoint{
-				Time:  key,
-				Count: val,
-			})
+		repoPoints := make(map[string][]queryrunner.TimeDataPoint, len(repoPivoted[value]))
+		for repository, repoTimeCounts := range repoPivoted[value] {
+			repoPoints[repository] = toTimeDataPoints(repoTimeCounts)
 		}
 
-		sort.Slice(timeseries, func(i, j int) bool {
-			return timeseries[i].Time.Before(timeseries[j].Time)
-		})
-
 		calculated = append(calculated, GeneratedTimeSeries{
-			Label:    value,
-			Points:   timeseries,
-			SeriesId: fmt.Sprintf("dynamic-series-%d", seriesCount),
+			Label:      value,
+			Points:     toTimeDataPoints(timeCounts),
+			RepoPoints: repoPoints,
+			SeriesId:   fmt.Sprintf("dynamic-series-%d", seriesCount),
 		})
 		seriesCount++
 	}
 	return calculated, nil
 }
 
+// toTimeDataPoints returns the given counts as data points in ascending time order.
+func toTimeDataPoints(counts map[time.Time]int) []queryrunner.TimeDataPoint {
+	timeseries := make([]queryrunner.TimeDataPoint, 0, len(counts))
+	for key, val := range counts {
+		timeseries = append(timeseries, queryrunner.TimeDataPoint{
+			Time:  key,
+			Count: val,
+		})
+	}
+
+	sort.Slice(timeseries, func(i, j int) bool {
+		return timeseries[i].Time.Before(timeseries[j].Time)
+	})
+	return timeseries
+}
+
 func withCountUnlimited(s string) string {
 	if strings.Contains(s, "count:") {
 		return s
@@ -193,7 +210,10 @@ func (t TimeInterval) StepBackwards(start time.Time) time.Time {
 }
 
 type GeneratedTimeSeries struct {
-	Label    string
-	Points   []queryrunner.TimeDataPoint
-	SeriesId string
+	Label  string
+	Points []queryrunner.TimeDataPoint
+	// RepoPoints holds the points of the series for each repository it was
+	// generated from, keyed by repository name.
+	RepoPoints map[string][]queryrunner.TimeDataPoint
+	SeriesId   string
 }
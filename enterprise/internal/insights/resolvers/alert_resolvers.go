@@ -0,0 +1,153 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+)
+
+const insightSeriesAlertKind = "InsightSeriesAlert"
+
+var _ graphqlbackend.InsightSeriesAlertResolver = &insightSeriesAlertResolver{}
+
+func (r *Resolver) InsightSeriesAlerts(ctx context.Context, args *graphqlbackend.InsightSeriesAlertsArgs) ([]graphqlbackend.InsightSeriesAlertResolver, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, backend.ErrNotAuthenticated
+	}
+
+	queryArgs := store.AlertQueryArgs{UserID: a.UID}
+	if args.SeriesId != nil {
+		queryArgs.SeriesID = *args.SeriesId
+	}
+	alerts, err := r.alertStore.GetAlerts(ctx, queryArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]graphqlbackend.InsightSeriesAlertResolver, 0, len(alerts))
+	for i := range alerts {
+		resolvers = append(resolvers, &insightSeriesAlertResolver{alert: alerts[i]})
+	}
+	return resolvers, nil
+}
+
+func (r *Resolver) CreateInsightSeriesAlert(ctx context.Context, args *graphqlbackend.CreateInsightSeriesAlertArgs) (graphqlbackend.InsightSeriesAlertResolver, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, backend.ErrNotAuthenticated
+	}
+
+	alert := types.InsightSeriesAlert{
+		SeriesID:  args.Input.SeriesId,
+		UserID:    a.UID,
+		Condition: types.AlertCondition(args.Input.Condition),
+		Threshold: args.Input.Threshold,
+		Intervals: 1,
+	}
+	if args.Input.Intervals != nil {
+		alert.Intervals = int(*args.Input.Intervals)
+	}
+	if err := validateAlert(alert); err != nil {
+		return nil, err
+	}
+
+	var viewID string
+	if err := relay.UnmarshalSpec(args.Input.InsightViewId, &viewID); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the insight view id")
+	}
+
+	// 🚨 SECURITY: Alerts notify users about the value of a series, so the user must be able to view an
+	// insight the series belongs to.
+	userIds, orgIds, err := getUserPermissions(ctx, database.Orgs(r.postgresDB))
+	if err != nil {
+		return nil, errors.Wrap(err, "getUserPermissions")
+	}
+	viewSeries, err := r.insightStore.GetAll(ctx, store.InsightQueryArgs{UniqueID: viewID, UserID: userIds, OrgID: orgIds})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAll")
+	}
+	found := false
+	for _, series := range viewSeries {
+		if series.SeriesID == alert.SeriesID {
+			if series.GenerationMethod == types.LanguageStats || series.JustInTime {
+				return nil, errors.New("alerts are only supported on series recorded in the background")
+			}
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.New("insight series not found")
+	}
+
+	alert, err = r.alertStore.CreateAlert(ctx, alert)
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateAlert")
+	}
+	return &insightSeriesAlertResolver{alert: alert}, nil
+}
+
+func (r *Resolver) DeleteInsightSeriesAlert(ctx context.Context, args *graphqlbackend.DeleteInsightSeriesAlertArgs) (*graphqlbackend.EmptyResponse, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, backend.ErrNotAuthenticated
+	}
+
+	var id int
+	if err := relay.UnmarshalSpec(args.Id, &id); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the alert id")
+	}
+	// 🚨 SECURITY: Users can only delete their own alerts.
+	if err := r.alertStore.DeleteAlert(ctx, id, a.UID); err != nil {
+		return nil, errors.Wrap(err, "DeleteAlert")
+	}
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+func validateAlert(alert types.InsightSeriesAlert) error {
+	switch alert.Condition {
+	case types.AlertAbove, types.AlertBelow:
+	case types.AlertIncrease, types.AlertDecrease:
+		if alert.Threshold <= 0 {
+			return errors.Newf("the threshold of %s alerts must be positive", alert.Condition)
+		}
+	default:
+		return errors.Newf("unsupported alert condition %q", alert.Condition)
+	}
+	if alert.Intervals < 1 {
+		return errors.New("intervals must be at least 1")
+	}
+	return nil
+}
+
+type insightSeriesAlertResolver struct {
+	alert types.InsightSeriesAlert
+}
+
+func (i *insightSeriesAlertResolver) ID() graphql.ID {
+	return relay.MarshalID(insightSeriesAlertKind, i.alert.ID)
+}
+
+func (i *insightSeriesAlertResolver) SeriesId() string { return i.alert.SeriesID }
+
+func (i *insightSeriesAlertResolver) Condition() string { return string(i.alert.Condition) }
+
+func (i *insightSeriesAlertResolver) Threshold() float64 { return i.alert.Threshold }
+
+func (i *insightSeriesAlertResolver) Intervals() int32 { return int32(i.alert.Intervals) }
+
+func (i *insightSeriesAlertResolver) Triggered() bool { return i.alert.Triggered }
+
+func (i *insightSeriesAlertResolver) LastTriggeredAt() *graphqlbackend.DateTime {
+	return graphqlbackend.DateTimeOrNil(i.alert.LastTriggeredAt)
+}
@@ -0,0 +1,29 @@
+package resolvers
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+)
+
+func TestValidateAlert(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		alert   types.InsightSeriesAlert
+		wantErr bool
+	}{
+		{"above", types.InsightSeriesAlert{Condition: types.AlertAbove, Threshold: 0, Intervals: 1}, false},
+		{"below negative threshold", types.InsightSeriesAlert{Condition: types.AlertBelow, Threshold: -5, Intervals: 1}, false},
+		{"increase", types.InsightSeriesAlert{Condition: types.AlertIncrease, Threshold: 1, Intervals: 3}, false},
+		{"increase without threshold", types.InsightSeriesAlert{Condition: types.AlertIncrease, Threshold: 0, Intervals: 1}, true},
+		{"decrease with negative threshold", types.InsightSeriesAlert{Condition: types.AlertDecrease, Threshold: -1, Intervals: 1}, true},
+		{"zero intervals", types.InsightSeriesAlert{Condition: types.AlertAbove, Threshold: 1, Intervals: 0}, true},
+		{"unknown condition", types.InsightSeriesAlert{Condition: "EQUAL", Threshold: 1, Intervals: 1}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := validateAlert(tc.alert); (err != nil) != tc.wantErr {
+				t.Errorf("want error %v, have %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -2,8 +2,12 @@ package resolvers
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/cockroachdb/errors"
+
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
@@ -38,6 +42,63 @@ func (d *dynamicInsightSeriesResolver) Points(ctx context.Context, args *graphql
 	return resolvers, nil
 }
 
+func (d *dynamicInsightSeriesResolver) Breakdown(ctx context.Context, args *graphqlbackend.InsightsBreakdownArgs) ([]graphqlbackend.InsightSeriesBreakdownResolver, error) {
+	groupOf := func(repository string) string { return repository }
+	switch store.BreakdownGroupBy(args.GroupBy) {
+	case store.GroupByRepository:
+	case store.GroupByOwner:
+		groupOf = repositoryOwner
+	default:
+		return nil, errors.Errorf("unsupported breakdown group %q", args.GroupBy)
+	}
+
+	grouped := make(map[string]map[time.Time]int)
+	for repository, points := range d.generated.RepoPoints {
+		group := groupOf(repository)
+		if _, ok := grouped[group]; !ok {
+			grouped[group] = make(map[time.Time]int)
+		}
+		for _, point := range points {
+			grouped[group][point.Time] += point.Count
+		}
+	}
+
+	groups := make([]string, 0, len(grouped))
+	for group := range grouped {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	resolvers := make([]graphqlbackend.InsightSeriesBreakdownResolver, 0, len(groups))
+	for _, group := range groups {
+		times := make([]time.Time, 0, len(grouped[group]))
+		for t := range grouped[group] {
+			times = append(times, t)
+		}
+		sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+		points := make([]graphqlbackend.InsightsDataPointResolver, 0, len(times))
+		for _, t := range times {
+			points = append(points, insightsDataPointResolver{store.SeriesPoint{
+				SeriesID: d.generated.SeriesId,
+				Time:     t,
+				Value:    float64(grouped[group][t]),
+			}})
+		}
+		resolvers = append(resolvers, &insightSeriesBreakdownResolver{group: group, points: points})
+	}
+	return resolvers, nil
+}
+
+// repositoryOwner returns the repository name without its last path component,
+// matching store.GroupByOwner.
+func repositoryOwner(repository string) string {
+	if i := strings.LastIndex(repository, "/"); i >= 0 {
+		return repository[:i]
+	}
+	return repository
+}
+
 func (d *dynamicInsightSeriesResolver) Status(ctx context.Context) (graphqlbackend.InsightStatusResolver, error) {
 	return &emptyInsightStatusResolver{}, nil
 }
@@ -0,0 +1,61 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/background/queryrunner"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/query"
+)
+
+func TestDynamicInsightSeriesResolver_Breakdown(t *testing.T) {
+	t1 := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	resolver := &dynamicInsightSeriesResolver{generated: &query.GeneratedTimeSeries{
+		Label:    "v1",
+		SeriesId: "dynamic-series-1",
+		RepoPoints: map[string][]queryrunner.TimeDataPoint{
+			"github.com/acme/api":  {{Time: t1, Count: 3}, {Time: t2, Count: 1}},
+			"github.com/acme/web":  {{Time: t1, Count: 2}, {Time: t2, Count: 2}},
+			"github.com/other/lib": {{Time: t2, Count: 4}},
+		},
+	}}
+
+	breakdown := func(groupBy string) map[string][]float64 {
+		resolvers, err := resolver.Breakdown(context.Background(), &graphqlbackend.InsightsBreakdownArgs{GroupBy: groupBy})
+		if err != nil {
+			t.Fatal(err)
+		}
+		have := make(map[string][]float64)
+		for _, r := range resolvers {
+			for _, p := range r.Points() {
+				have[r.Group()] = append(have[r.Group()], p.Value())
+			}
+		}
+		return have
+	}
+
+	if diff := cmp.Diff(map[string][]float64{
+		"github.com/acme/api":  {3, 1},
+		"github.com/acme/web":  {2, 2},
+		"github.com/other/lib": {4},
+	}, breakdown("REPOSITORY")); diff != "" {
+		t.Errorf("unexpected repository breakdown (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(map[string][]float64{
+		"github.com/acme":  {5, 3},
+		"github.com/other": {4},
+	}, breakdown("OWNER")); diff != "" {
+		t.Errorf("unexpected owner breakdown (-want +got):\n%s", diff)
+	}
+
+	if _, err := resolver.Breakdown(context.Background(), &graphqlbackend.InsightsBreakdownArgs{GroupBy: "LANGUAGE"}); err == nil {
+		t.Fatal("expected an error for an unsupported group")
+	}
+}
@@ -0,0 +1,206 @@
+package resolvers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+)
+
+var _ graphqlbackend.BarChartInsightViewPresentation = &barChartInsightViewPresentation{}
+var _ graphqlbackend.TableInsightViewPresentation = &tableInsightViewPresentation{}
+var _ graphqlbackend.InsightCategoryResolver = &insightCategoryResolver{}
+
+const (
+	sortByLabel = "LABEL"
+	sortByValue = "VALUE"
+
+	sortAscending  = "ASC"
+	sortDescending = "DESC"
+
+	otherCategoryLabel = "Other"
+)
+
+// insightCategory is the latest value of a series, or of a captured value for series generated from
+// capture groups, as presented by bar charts and tables.
+type insightCategory struct {
+	label    string
+	seriesID *string
+	value    float64
+	share    float64
+	other    bool
+}
+
+type barChartInsightViewPresentation struct {
+	viewResolver *insightViewResolver
+}
+
+func (b *barChartInsightViewPresentation) Title(ctx context.Context) (string, error) {
+	return b.viewResolver.view.Title, nil
+}
+
+func (b *barChartInsightViewPresentation) OtherThreshold(ctx context.Context) (float64, error) {
+	return otherThreshold(b.viewResolver.view), nil
+}
+
+func (b *barChartInsightViewPresentation) Categories(ctx context.Context) ([]graphqlbackend.InsightCategoryResolver, error) {
+	categories, err := latestCategories(ctx, b.viewResolver)
+	if err != nil {
+		return nil, err
+	}
+	categories, other := bucketCategories(categories, otherThreshold(b.viewResolver.view))
+	sortCategories(categories, sortByValue, sortDescending)
+	return categoryResolvers(categories, other), nil
+}
+
+type tableInsightViewPresentation struct {
+	viewResolver *insightViewResolver
+}
+
+func (t *tableInsightViewPresentation) Title(ctx context.Context) (string, error) {
+	return t.viewResolver.view.Title, nil
+}
+
+func (t *tableInsightViewPresentation) OtherThreshold(ctx context.Context) (float64, error) {
+	return otherThreshold(t.viewResolver.view), nil
+}
+
+func (t *tableInsightViewPresentation) Rows(ctx context.Context, args *graphqlbackend.InsightTableRowsArgs) ([]graphqlbackend.InsightCategoryResolver, error) {
+	switch args.SortBy {
+	case sortByLabel, sortByValue:
+	default:
+		return nil, errors.Newf("unsupported sort column %q", args.SortBy)
+	}
+	switch args.Direction {
+	case sortAscending, sortDescending:
+	default:
+		return nil, errors.Newf("unsupported sort direction %q", args.Direction)
+	}
+
+	categories, err := latestCategories(ctx, t.viewResolver)
+	if err != nil {
+		return nil, err
+	}
+	categories, other := bucketCategories(categories, otherThreshold(t.viewResolver.view))
+	sortCategories(categories, args.SortBy, args.Direction)
+	return categoryResolvers(categories, other), nil
+}
+
+func otherThreshold(view *types.Insight) float64 {
+	if view.OtherThreshold == nil {
+		log15.Warn("Returning a categorical insight with no threshold set. This should never happen!", "id", view.UniqueID)
+		return 0
+	}
+	return *view.OtherThreshold
+}
+
+// latestCategories returns a category for the latest data point of every data series of the view, with
+// the filters applied to the view.
+func latestCategories(ctx context.Context, viewResolver *insightViewResolver) ([]insightCategory, error) {
+	dataSeries, err := viewResolver.DataSeries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]insightCategory, 0, len(dataSeries))
+	for _, series := range dataSeries {
+		points, err := series.Points(ctx, &graphqlbackend.InsightsPointsArgs{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "Points for seriesID: %s", series.SeriesId())
+		}
+		if len(points) == 0 {
+			continue
+		}
+		latest := points[0]
+		for _, point := range points[1:] {
+			if point.DateTime().Time.After(latest.DateTime().Time) {
+				latest = point
+			}
+		}
+		seriesID := series.SeriesId()
+		categories = append(categories, insightCategory{
+			label:    series.Label(),
+			seriesID: &seriesID,
+			value:    latest.Value(),
+		})
+	}
+	return categories, nil
+}
+
+// bucketCategories computes the share of the total of every category, and sums up the categories whose
+// share is below the threshold in a single "other" category, which is nil if there are none.
+func bucketCategories(categories []insightCategory, threshold float64) ([]insightCategory, *insightCategory) {
+	var total float64
+	for _, c := range categories {
+		total += c.value
+	}
+	if total == 0 {
+		// Shares are undefined, so nothing is grouped.
+		return categories, nil
+	}
+
+	kept := make([]insightCategory, 0, len(categories))
+	var other *insightCategory
+	for _, c := range categories {
+		c.share = c.value / total
+		if c.share >= threshold {
+			kept = append(kept, c)
+			continue
+		}
+		if other == nil {
+			other = &insightCategory{label: otherCategoryLabel, other: true}
+		}
+		other.value += c.value
+		other.share += c.share
+	}
+	return kept, other
+}
+
+// sortCategories sorts categories by the given column and direction. Ties are broken by label, and
+// then by value.
+func sortCategories(categories []insightCategory, sortBy, direction string) {
+	less := func(a, b insightCategory) bool {
+		if sortBy == sortByValue && a.value != b.value {
+			return a.value < b.value
+		}
+		if a.label != b.label {
+			return a.label < b.label
+		}
+		return a.value < b.value
+	}
+	sort.SliceStable(categories, func(i, j int) bool {
+		if direction == sortDescending {
+			return less(categories[j], categories[i])
+		}
+		return less(categories[i], categories[j])
+	})
+}
+
+func categoryResolvers(categories []insightCategory, other *insightCategory) []graphqlbackend.InsightCategoryResolver {
+	resolvers := make([]graphqlbackend.InsightCategoryResolver, 0, len(categories)+1)
+	for _, c := range categories {
+		resolvers = append(resolvers, &insightCategoryResolver{category: c})
+	}
+	if other != nil {
+		resolvers = append(resolvers, &insightCategoryResolver{category: *other})
+	}
+	return resolvers
+}
+
+type insightCategoryResolver struct {
+	category insightCategory
+}
+
+func (i *insightCategoryResolver) Label() string { return i.category.label }
+
+func (i *insightCategoryResolver) SeriesId() *string { return i.category.seriesID }
+
+func (i *insightCategoryResolver) Value() float64 { return i.category.value }
+
+func (i *insightCategoryResolver) Share() float64 { return i.category.share }
+
+func (i *insightCategoryResolver) Other() bool { return i.category.other }
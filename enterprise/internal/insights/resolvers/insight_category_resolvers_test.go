@@ -0,0 +1,80 @@
+package resolvers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBucketCategories(t *testing.T) {
+	categories := []insightCategory{
+		{label: "a", value: 50},
+		{label: "b", value: 40},
+		{label: "c", value: 6},
+		{label: "d", value: 4},
+	}
+
+	t.Run("below threshold", func(t *testing.T) {
+		kept, other := bucketCategories(categories, 0.1)
+		wantKept := []insightCategory{
+			{label: "a", value: 50, share: 0.5},
+			{label: "b", value: 40, share: 0.4},
+		}
+		if diff := cmp.Diff(wantKept, kept, cmp.AllowUnexported(insightCategory{})); diff != "" {
+			t.Errorf("unexpected categories (-want +got):\n%s", diff)
+		}
+		wantOther := &insightCategory{label: "Other", value: 10, share: 0.1, other: true}
+		if diff := cmp.Diff(wantOther, other, cmp.AllowUnexported(insightCategory{})); diff != "" {
+			t.Errorf("unexpected other category (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("nothing below threshold", func(t *testing.T) {
+		kept, other := bucketCategories(categories, 0.04)
+		if len(kept) != 4 {
+			t.Errorf("expected all categories to be kept, got %d", len(kept))
+		}
+		if other != nil {
+			t.Errorf("expected no other category, got %+v", other)
+		}
+	})
+
+	t.Run("empty total", func(t *testing.T) {
+		kept, other := bucketCategories([]insightCategory{{label: "a"}, {label: "b"}}, 0.5)
+		if len(kept) != 2 || other != nil {
+			t.Errorf("expected categories to be kept as is, got %+v and %+v", kept, other)
+		}
+	})
+}
+
+func TestSortCategories(t *testing.T) {
+	labels := func(categories []insightCategory) []string {
+		var labels []string
+		for _, c := range categories {
+			labels = append(labels, c.label)
+		}
+		return labels
+	}
+
+	for _, tc := range []struct {
+		sortBy, direction string
+		want              []string
+	}{
+		{sortByValue, sortDescending, []string{"b", "c", "a"}},
+		{sortByValue, sortAscending, []string{"a", "c", "b"}},
+		{sortByLabel, sortAscending, []string{"a", "b", "c"}},
+		{sortByLabel, sortDescending, []string{"c", "b", "a"}},
+	} {
+		t.Run(tc.sortBy+" "+tc.direction, func(t *testing.T) {
+			categories := []insightCategory{
+				{label: "c", value: 2},
+				{label: "a", value: 1},
+				{label: "b", value: 3},
+			}
+			sortCategories(categories, tc.sortBy, tc.direction)
+			if diff := cmp.Diff(tc.want, labels(categories)); diff != "" {
+				t.Errorf("unexpected order (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
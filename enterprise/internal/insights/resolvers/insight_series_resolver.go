@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/cockroachdb/errors"
+
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
@@ -71,6 +73,59 @@ func (r *insightSeriesResolver) Points(ctx context.Context, args *graphqlbackend
 	return resolvers, nil
 }
 
+func (r *insightSeriesResolver) Breakdown(ctx context.Context, args *graphqlbackend.InsightsBreakdownArgs) ([]graphqlbackend.InsightSeriesBreakdownResolver, error) {
+	if r.series.GenerationMethod == types.LanguageStats {
+		return nil, errors.New("breakdown is only supported for search-based series")
+	}
+
+	seriesID := r.series.SeriesID
+	opts := store.SeriesPointsOpts{SeriesID: &seriesID}
+
+	// Default to the same time range and filters as Points.
+	from := time.Now().AddDate(-1, 0, 0)
+	if args.From != nil {
+		from = args.From.Time
+	}
+	opts.From = &from
+	if args.To != nil {
+		opts.To = &args.To.Time
+	}
+	if args.IncludeRepoRegex != nil {
+		opts.IncludeRepoRegex = *args.IncludeRepoRegex
+	} else if r.filters.IncludeRepoRegex != nil {
+		opts.IncludeRepoRegex = *r.filters.IncludeRepoRegex
+	}
+	if args.ExcludeRepoRegex != nil {
+		opts.ExcludeRepoRegex = *args.ExcludeRepoRegex
+	} else if r.filters.ExcludeRepoRegex != nil {
+		opts.ExcludeRepoRegex = *r.filters.ExcludeRepoRegex
+	}
+
+	points, err := r.insightsStore.SeriesPointsBreakdown(ctx, opts, store.BreakdownGroupBy(args.GroupBy))
+	if err != nil {
+		return nil, err
+	}
+
+	// Points are ordered by group and then by descending time, so each group is a contiguous run of
+	// points that is reversed to return it in ascending time order like Points.
+	var resolvers []graphqlbackend.InsightSeriesBreakdownResolver
+	var current *insightSeriesBreakdownResolver
+	for _, point := range points {
+		if current == nil || current.group != point.Group {
+			current = &insightSeriesBreakdownResolver{group: point.Group}
+			resolvers = append(resolvers, current)
+		}
+		current.points = append(current.points, insightsDataPointResolver{point.SeriesPoint})
+	}
+	for _, resolver := range resolvers {
+		p := resolver.(*insightSeriesBreakdownResolver).points
+		for i, j := 0, len(p)-1; i < j; i, j = i+1, j-1 {
+			p[i], p[j] = p[j], p[i]
+		}
+	}
+	return resolvers, nil
+}
+
 func (r *insightSeriesResolver) Status(ctx context.Context) (graphqlbackend.InsightStatusResolver, error) {
 	seriesID := r.series.SeriesID
 
@@ -120,6 +175,19 @@ func (i insightsDataPointResolver) DateTime() graphqlbackend.DateTime {
 
 func (i insightsDataPointResolver) Value() float64 { return i.p.Value }
 
+var _ graphqlbackend.InsightSeriesBreakdownResolver = &insightSeriesBreakdownResolver{}
+
+type insightSeriesBreakdownResolver struct {
+	group  string
+	points []graphqlbackend.InsightsDataPointResolver
+}
+
+func (i *insightSeriesBreakdownResolver) Group() string { return i.group }
+
+func (i *insightSeriesBreakdownResolver) Points() []graphqlbackend.InsightsDataPointResolver {
+	return i.points
+}
+
 type insightStatusResolver struct {
 	totalPoints, pendingJobs, completedJobs, failedJobs int32
 	backfillQueuedAt                                    *time.Time
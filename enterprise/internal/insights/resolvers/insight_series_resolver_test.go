@@ -9,6 +9,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hexops/autogold"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
@@ -119,3 +120,57 @@ func TestResolver_InsightSeries(t *testing.T) {
 		autogold.Want("insights[0][0].Points mocked", "[{p:{SeriesID: Time:{wall:0 ext:63271811045 loc:<nil>} Value:1 Metadata:[]}} {p:{SeriesID: Time:{wall:0 ext:63271811045 loc:<nil>} Value:2 Metadata:[]}} {p:{SeriesID: Time:{wall:0 ext:63271811045 loc:<nil>} Value:3 Metadata:[]}}]").Equal(t, fmt.Sprintf("%+v", points))
 	})
 }
+
+func TestInsightSeriesResolver_Breakdown(t *testing.T) {
+	t1 := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStore := store.NewMockInterface()
+	mockStore.SeriesPointsBreakdownFunc.SetDefaultHook(func(ctx context.Context, opts store.SeriesPointsOpts, groupBy store.BreakdownGroupBy) ([]store.BreakdownSeriesPoint, error) {
+		if *opts.SeriesID != "migration" || groupBy != store.GroupByOwner || opts.ExcludeRepoRegex != "archived" {
+			t.Errorf("unexpected breakdown arguments: %v %q %q", *opts.SeriesID, groupBy, opts.ExcludeRepoRegex)
+		}
+		return []store.BreakdownSeriesPoint{
+			{Group: "github.com/acme", SeriesPoint: store.SeriesPoint{Time: t2, Value: 2}},
+			{Group: "github.com/acme", SeriesPoint: store.SeriesPoint{Time: t1, Value: 5}},
+			{Group: "github.com/other", SeriesPoint: store.SeriesPoint{Time: t2, Value: 1}},
+		}, nil
+	})
+
+	excludeRepoRegex := "archived"
+	resolver := &insightSeriesResolver{
+		insightsStore: mockStore,
+		series:        types.InsightViewSeries{SeriesID: "migration", GenerationMethod: types.Search},
+		filters:       types.InsightViewFilters{ExcludeRepoRegex: &excludeRepoRegex},
+	}
+	breakdown, err := resolver.Breakdown(context.Background(), &graphqlbackend.InsightsBreakdownArgs{GroupBy: "OWNER"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type point struct {
+		Time  time.Time
+		Value float64
+	}
+	have := make(map[string][]point)
+	var groups []string
+	for _, group := range breakdown {
+		groups = append(groups, group.Group())
+		for _, p := range group.Points() {
+			have[group.Group()] = append(have[group.Group()], point{p.DateTime().Time, p.Value()})
+		}
+	}
+	autogold.Want("breakdown groups", []string{"github.com/acme", "github.com/other"}).Equal(t, groups)
+	want := map[string][]point{
+		"github.com/acme":  {{t1, 5}, {t2, 2}},
+		"github.com/other": {{t2, 1}},
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("unexpected breakdown (-want +got):\n%s", diff)
+	}
+
+	resolver.series.GenerationMethod = types.LanguageStats
+	if _, err := resolver.Breakdown(context.Background(), &graphqlbackend.InsightsBreakdownArgs{GroupBy: "OWNER"}); err == nil {
+		t.Fatal("expected an error for a language stats series")
+	}
+}
@@ -1,10 +1,12 @@
 package resolvers
 
 import (
+	"bytes"
 	"context"
 	"sync"
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/export"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/service"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/query"
@@ -132,10 +134,17 @@ func expandCaptureGroupSeries(ctx context.Context, definition types.InsightViewS
 }
 
 func (i *insightViewResolver) Presentation(ctx context.Context) (graphqlbackend.InsightPresentation, error) {
-	if i.view.PresentationType == types.Pie {
+	switch i.view.PresentationType {
+	case types.Pie:
 		pieChartPresentation := &pieChartInsightViewPresentation{view: i.view}
 		return &insightPresentationUnionResolver{resolver: pieChartPresentation}, nil
-	} else {
+	case types.Bar:
+		barChartPresentation := &barChartInsightViewPresentation{viewResolver: i}
+		return &insightPresentationUnionResolver{resolver: barChartPresentation}, nil
+	case types.Table:
+		tablePresentation := &tableInsightViewPresentation{viewResolver: i}
+		return &insightPresentationUnionResolver{resolver: tablePresentation}, nil
+	default:
 		lineChartPresentation := &lineChartInsightViewPresentation{view: i.view}
 		return &insightPresentationUnionResolver{resolver: lineChartPresentation}, nil
 	}
@@ -149,6 +158,36 @@ func (i *insightViewResolver) DataSeriesDefinitions(ctx context.Context) ([]grap
 	return resolvers, nil
 }
 
+func (i *insightViewResolver) Export(ctx context.Context, args *graphqlbackend.InsightViewExportArgs) (string, error) {
+	filters := &i.view.Filters
+	if i.overrideFilters != nil {
+		filters = i.overrideFilters
+	}
+
+	opts := export.Options{Format: export.Format(args.Format)}
+	if args.Breakdown != nil {
+		opts.GroupBy = store.BreakdownGroupBy(*args.Breakdown)
+	}
+	if filters.IncludeRepoRegex != nil {
+		opts.IncludeRepoRegex = *filters.IncludeRepoRegex
+	}
+	if filters.ExcludeRepoRegex != nil {
+		opts.ExcludeRepoRegex = *filters.ExcludeRepoRegex
+	}
+	if args.From != nil {
+		opts.From = &args.From.Time
+	}
+	if args.To != nil {
+		opts.To = &args.To.Time
+	}
+
+	var buf bytes.Buffer
+	if err := export.Write(ctx, &buf, i.timeSeriesStore, i.view.Series, opts); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 type searchInsightDataSeriesDefinitionResolver struct {
 	series *types.InsightViewSeries
 }
@@ -233,6 +272,16 @@ func (l *lineChartDataSeriesPresentationResolver) Color(ctx context.Context) (st
 }
 
 func (r *Resolver) CreateLineChartSearchInsight(ctx context.Context, args *graphqlbackend.CreateLineChartSearchInsightArgs) (_ graphqlbackend.InsightViewPayloadResolver, err error) {
+	return r.createSearchInsight(ctx, types.InsightView{
+		Title:            emptyIfNil(args.Input.Options.Title),
+		Filters:          types.InsightViewFilters{},
+		PresentationType: types.Line,
+	}, args.Input.DataSeries, args.Input.Dashboards)
+}
+
+// createSearchInsight creates a view with the given search data series for the current user, and adds it
+// to the given dashboards.
+func (r *Resolver) createSearchInsight(ctx context.Context, view types.InsightView, dataSeries []graphqlbackend.LineChartSearchInsightDataSeriesInput, dashboards *[]graphql.ID) (_ graphqlbackend.InsightViewPayloadResolver, err error) {
 	uid := actor.FromContext(ctx).UID
 
 	tx, err := r.insightStore.Transact(ctx)
@@ -241,31 +290,27 @@ func (r *Resolver) CreateLineChartSearchInsight(ctx context.Context, args *graph
 	}
 	defer func() { err = tx.Done(err) }()
 
-	view, err := tx.CreateView(ctx, types.InsightView{
-		Title:            emptyIfNil(args.Input.Options.Title),
-		UniqueID:         ksuid.New().String(),
-		Filters:          types.InsightViewFilters{},
-		PresentationType: types.Line,
-	}, []store.InsightViewGrant{store.UserGrant(int(uid))})
+	view.UniqueID = ksuid.New().String()
+	view, err = tx.CreateView(ctx, view, []store.InsightViewGrant{store.UserGrant(int(uid))})
 	if err != nil {
 		return nil, errors.Wrap(err, "CreateView")
 	}
 
-	for _, series := range args.Input.DataSeries {
+	for _, series := range dataSeries {
 		err = createAndAttachSeries(ctx, tx, view, series)
 		if err != nil {
 			return nil, errors.Wrap(err, "createAndAttachSeries")
 		}
 	}
 
-	if args.Input.Dashboards != nil {
+	if dashboards != nil {
 		dashboardTx := r.dashboardStore.With(tx)
-		err := validateUserDashboardPermissions(ctx, dashboardTx, *args.Input.Dashboards, database.Orgs(r.postgresDB))
+		err := validateUserDashboardPermissions(ctx, dashboardTx, *dashboards, database.Orgs(r.postgresDB))
 		if err != nil {
 			return nil, err
 		}
 
-		for _, id := range *args.Input.Dashboards {
+		for _, id := range *dashboards {
 			dashboardID, err := unmarshalDashboardID(id)
 			if err != nil {
 				return nil, errors.Wrapf(err, "unmarshalDashboardID, id:%s", dashboardID)
@@ -283,6 +328,18 @@ func (r *Resolver) CreateLineChartSearchInsight(ctx context.Context, args *graph
 }
 
 func (r *Resolver) UpdateLineChartSearchInsight(ctx context.Context, args *graphqlbackend.UpdateLineChartSearchInsightArgs) (_ graphqlbackend.InsightViewPayloadResolver, err error) {
+	return r.updateSearchInsight(ctx, args.Id, types.InsightView{
+		Title: emptyIfNil(args.Input.PresentationOptions.Title),
+		Filters: types.InsightViewFilters{
+			IncludeRepoRegex: args.Input.ViewControls.Filters.IncludeRepoRegex,
+			ExcludeRepoRegex: args.Input.ViewControls.Filters.ExcludeRepoRegex},
+		PresentationType: types.Line,
+	}, args.Input.DataSeries)
+}
+
+// updateSearchInsight updates the view with the given ID, and replaces its data series with the given
+// search data series.
+func (r *Resolver) updateSearchInsight(ctx context.Context, id graphql.ID, view types.InsightView, dataSeries []graphqlbackend.LineChartSearchInsightDataSeriesInput) (_ graphqlbackend.InsightViewPayloadResolver, err error) {
 	tx, err := r.insightStore.Transact(ctx)
 	if err != nil {
 		return nil, err
@@ -290,7 +347,7 @@ func (r *Resolver) UpdateLineChartSearchInsight(ctx context.Context, args *graph
 	defer func() { err = tx.Done(err) }()
 
 	var insightViewId string
-	err = relay.UnmarshalSpec(args.Id, &insightViewId)
+	err = relay.UnmarshalSpec(id, &insightViewId)
 	if err != nil {
 		return nil, errors.Wrap(err, "error unmarshalling the insight view id")
 	}
@@ -307,20 +364,14 @@ func (r *Resolver) UpdateLineChartSearchInsight(ctx context.Context, args *graph
 		return nil, errors.New("No insight view found with this id")
 	}
 
-	view, err := tx.UpdateView(ctx, types.InsightView{
-		UniqueID: insightViewId,
-		Title:    emptyIfNil(args.Input.PresentationOptions.Title),
-		Filters: types.InsightViewFilters{
-			IncludeRepoRegex: args.Input.ViewControls.Filters.IncludeRepoRegex,
-			ExcludeRepoRegex: args.Input.ViewControls.Filters.ExcludeRepoRegex},
-		PresentationType: types.Line,
-	})
+	view.UniqueID = insightViewId
+	view, err = tx.UpdateView(ctx, view)
 	if err != nil {
 		return nil, errors.Wrap(err, "UpdateView")
 	}
 
 	for _, existingSeries := range views[0].Series {
-		if !seriesFound(existingSeries, args.Input.DataSeries) {
+		if !seriesFound(existingSeries, dataSeries) {
 			err = tx.RemoveSeriesFromView(ctx, existingSeries.SeriesID, view.ID)
 			if err != nil {
 				return nil, errors.Wrap(err, "RemoveSeriesFromView")
@@ -328,7 +379,7 @@ func (r *Resolver) UpdateLineChartSearchInsight(ctx context.Context, args *graph
 		}
 	}
 
-	for _, series := range args.Input.DataSeries {
+	for _, series := range dataSeries {
 		if series.SeriesId == nil {
 			err = createAndAttachSeries(ctx, tx, view, series)
 			if err != nil {
@@ -485,6 +536,55 @@ func (r *Resolver) UpdatePieChartSearchInsight(ctx context.Context, args *graphq
 	return &insightPayloadResolver{baseInsightResolver: r.baseInsightResolver, validator: r.permissionsValidator, viewId: view.UniqueID}, nil
 }
 
+func (r *Resolver) CreateBarChartSearchInsight(ctx context.Context, args *graphqlbackend.CreateCategoricalSearchInsightArgs) (_ graphqlbackend.InsightViewPayloadResolver, err error) {
+	return r.createCategoricalSearchInsight(ctx, args, types.Bar)
+}
+
+func (r *Resolver) UpdateBarChartSearchInsight(ctx context.Context, args *graphqlbackend.UpdateCategoricalSearchInsightArgs) (_ graphqlbackend.InsightViewPayloadResolver, err error) {
+	return r.updateCategoricalSearchInsight(ctx, args, types.Bar)
+}
+
+func (r *Resolver) CreateTableSearchInsight(ctx context.Context, args *graphqlbackend.CreateCategoricalSearchInsightArgs) (_ graphqlbackend.InsightViewPayloadResolver, err error) {
+	return r.createCategoricalSearchInsight(ctx, args, types.Table)
+}
+
+func (r *Resolver) UpdateTableSearchInsight(ctx context.Context, args *graphqlbackend.UpdateCategoricalSearchInsightArgs) (_ graphqlbackend.InsightViewPayloadResolver, err error) {
+	return r.updateCategoricalSearchInsight(ctx, args, types.Table)
+}
+
+func (r *Resolver) createCategoricalSearchInsight(ctx context.Context, args *graphqlbackend.CreateCategoricalSearchInsightArgs, presentationType types.PresentationType) (graphqlbackend.InsightViewPayloadResolver, error) {
+	if err := validateOtherThreshold(args.Input.PresentationOptions.OtherThreshold); err != nil {
+		return nil, err
+	}
+	return r.createSearchInsight(ctx, types.InsightView{
+		Title:            args.Input.PresentationOptions.Title,
+		Filters:          types.InsightViewFilters{},
+		OtherThreshold:   &args.Input.PresentationOptions.OtherThreshold,
+		PresentationType: presentationType,
+	}, args.Input.DataSeries, args.Input.Dashboards)
+}
+
+func (r *Resolver) updateCategoricalSearchInsight(ctx context.Context, args *graphqlbackend.UpdateCategoricalSearchInsightArgs, presentationType types.PresentationType) (graphqlbackend.InsightViewPayloadResolver, error) {
+	if err := validateOtherThreshold(args.Input.PresentationOptions.OtherThreshold); err != nil {
+		return nil, err
+	}
+	return r.updateSearchInsight(ctx, args.Id, types.InsightView{
+		Title: args.Input.PresentationOptions.Title,
+		Filters: types.InsightViewFilters{
+			IncludeRepoRegex: args.Input.ViewControls.Filters.IncludeRepoRegex,
+			ExcludeRepoRegex: args.Input.ViewControls.Filters.ExcludeRepoRegex},
+		OtherThreshold:   &args.Input.PresentationOptions.OtherThreshold,
+		PresentationType: presentationType,
+	}, args.Input.DataSeries)
+}
+
+func validateOtherThreshold(threshold float64) error {
+	if threshold < 0 || threshold > 1 {
+		return errors.New("otherThreshold must be between 0 and 1")
+	}
+	return nil
+}
+
 type pieChartInsightViewPresentation struct {
 	view *types.Insight
 }
@@ -560,6 +660,16 @@ func (r *insightPresentationUnionResolver) ToPieChartInsightViewPresentation() (
 	return res, ok
 }
 
+func (r *insightPresentationUnionResolver) ToBarChartInsightViewPresentation() (graphqlbackend.BarChartInsightViewPresentation, bool) {
+	res, ok := r.resolver.(*barChartInsightViewPresentation)
+	return res, ok
+}
+
+func (r *insightPresentationUnionResolver) ToTableInsightViewPresentation() (graphqlbackend.TableInsightViewPresentation, bool) {
+	res, ok := r.resolver.(*tableInsightViewPresentation)
+	return res, ok
+}
+
 // A dummy type to represent the GraphQL union InsightDataSeriesDefinition
 type insightDataSeriesDefinitionUnionResolver struct {
 	resolver interface{}
@@ -0,0 +1,155 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// AlertStore stores the alert rules of insight series.
+type AlertStore struct {
+	*basestore.Store
+	Now func() time.Time
+}
+
+// NewAlertStore returns a new AlertStore backed by the given Timescale db.
+func NewAlertStore(db dbutil.DB) *AlertStore {
+	return &AlertStore{Store: basestore.NewWithDB(db, sql.TxOptions{}), Now: time.Now}
+}
+
+// Handle returns the underlying transactable database handle.
+// Needed to implement the ShareableStore interface.
+func (s *AlertStore) Handle() *basestore.TransactableHandle { return s.Store.Handle() }
+
+// With creates a new AlertStore with the given basestore.Shareable store as the underlying basestore.Store.
+// Needed to implement the basestore.Store interface
+func (s *AlertStore) With(other basestore.ShareableStore) *AlertStore {
+	return &AlertStore{Store: s.Store.With(other), Now: s.Now}
+}
+
+// SeriesAlertStore is the subset of the AlertStore used to evaluate alert rules.
+type SeriesAlertStore interface {
+	GetAlerts(ctx context.Context, args AlertQueryArgs) ([]types.InsightSeriesAlert, error)
+	SetAlertTriggered(ctx context.Context, id int, triggered bool, value float64) error
+}
+
+var _ SeriesAlertStore = &AlertStore{}
+
+// AlertQueryArgs contains query predicates for fetching alert rules. Any provided values will be included as
+// query arguments.
+type AlertQueryArgs struct {
+	ID       int
+	SeriesID string
+	UserID   int32
+}
+
+// GetAlerts returns the alert rules matching args, ordered by ID.
+func (s *AlertStore) GetAlerts(ctx context.Context, args AlertQueryArgs) ([]types.InsightSeriesAlert, error) {
+	preds := make([]*sqlf.Query, 0, 3)
+	if args.ID > 0 {
+		preds = append(preds, sqlf.Sprintf("id = %s", args.ID))
+	}
+	if args.SeriesID != "" {
+		preds = append(preds, sqlf.Sprintf("series_id = %s", args.SeriesID))
+	}
+	if args.UserID > 0 {
+		preds = append(preds, sqlf.Sprintf("user_id = %s", args.UserID))
+	}
+	if len(preds) == 0 {
+		preds = append(preds, sqlf.Sprintf("TRUE"))
+	}
+	return scanAlerts(s.Query(ctx, sqlf.Sprintf(getAlertsSql, sqlf.Join(preds, "\n AND "))))
+}
+
+// CreateAlert creates a new alert rule and returns it with its ID populated.
+func (s *AlertStore) CreateAlert(ctx context.Context, alert types.InsightSeriesAlert) (types.InsightSeriesAlert, error) {
+	if alert.CreatedAt.IsZero() {
+		alert.CreatedAt = s.Now()
+	}
+	row := s.QueryRow(ctx, sqlf.Sprintf(createAlertSql,
+		alert.SeriesID,
+		alert.UserID,
+		alert.Condition,
+		alert.Threshold,
+		alert.Intervals,
+		alert.CreatedAt,
+	))
+	if err := row.Scan(&alert.ID); err != nil {
+		return types.InsightSeriesAlert{}, err
+	}
+	return alert, nil
+}
+
+// DeleteAlert deletes the alert rule with the given ID, if it belongs to the given user.
+func (s *AlertStore) DeleteAlert(ctx context.Context, id int, userID int32) error {
+	return s.Exec(ctx, sqlf.Sprintf(deleteAlertSql, id, userID))
+}
+
+// SetAlertTriggered records the result of evaluating the alert rule with the given ID against the given value.
+// The last triggered time is only updated when the rule is triggered.
+func (s *AlertStore) SetAlertTriggered(ctx context.Context, id int, triggered bool, value float64) error {
+	return s.Exec(ctx, sqlf.Sprintf(setAlertTriggeredSql, triggered, value, triggered, s.Now(), id))
+}
+
+func scanAlerts(rows *sql.Rows, queryErr error) (_ []types.InsightSeriesAlert, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	results := make([]types.InsightSeriesAlert, 0)
+	for rows.Next() {
+		var temp types.InsightSeriesAlert
+		if err := rows.Scan(
+			&temp.ID,
+			&temp.SeriesID,
+			&temp.UserID,
+			&temp.Condition,
+			&temp.Threshold,
+			&temp.Intervals,
+			&temp.Triggered,
+			&temp.LastValue,
+			&temp.LastTriggeredAt,
+			&temp.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, temp)
+	}
+	return results, nil
+}
+
+const getAlertsSql = `
+-- source: enterprise/internal/insights/store/alert_store.go:GetAlerts
+SELECT id, series_id, user_id, condition, threshold, intervals, triggered, last_value, last_triggered_at, created_at
+FROM insight_series_alerts
+WHERE %s
+ORDER BY id;
+`
+
+const createAlertSql = `
+-- source: enterprise/internal/insights/store/alert_store.go:CreateAlert
+INSERT INTO insight_series_alerts (series_id, user_id, condition, threshold, intervals, created_at)
+VALUES (%s, %s, %s, %s, %s, %s)
+RETURNING id;
+`
+
+const deleteAlertSql = `
+-- source: enterprise/internal/insights/store/alert_store.go:DeleteAlert
+DELETE FROM insight_series_alerts WHERE id = %s AND user_id = %s;
+`
+
+const setAlertTriggeredSql = `
+-- source: enterprise/internal/insights/store/alert_store.go:SetAlertTriggered
+UPDATE insight_series_alerts
+SET triggered = %s,
+    last_value = %s,
+    last_triggered_at = CASE WHEN %s THEN %s ELSE last_triggered_at END
+WHERE id = %s;
+`
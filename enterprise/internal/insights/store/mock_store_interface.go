@@ -24,6 +24,9 @@ type MockInterface struct {
 	// SeriesPointsFunc is an instance of a mock function object controlling
 	// the behavior of the method SeriesPoints.
 	SeriesPointsFunc *InterfaceSeriesPointsFunc
+	// SeriesPointsBreakdownFunc is an instance of a mock function object
+	// controlling the behavior of the method SeriesPointsBreakdown.
+	SeriesPointsBreakdownFunc *InterfaceSeriesPointsBreakdownFunc
 }
 
 // NewMockInterface creates a new mock of the Interface interface. All
@@ -50,6 +53,11 @@ func NewMockInterface() *MockInterface {
 				return nil, nil
 			},
 		},
+		SeriesPointsBreakdownFunc: &InterfaceSeriesPointsBreakdownFunc{
+			defaultHook: func(context.Context, SeriesPointsOpts, BreakdownGroupBy) ([]BreakdownSeriesPoint, error) {
+				return nil, nil
+			},
+		},
 	}
 }
 
@@ -77,6 +85,11 @@ func NewStrictMockInterface() *MockInterface {
 				panic("unexpected invocation of MockInterface.SeriesPoints")
 			},
 		},
+		SeriesPointsBreakdownFunc: &InterfaceSeriesPointsBreakdownFunc{
+			defaultHook: func(context.Context, SeriesPointsOpts, BreakdownGroupBy) ([]BreakdownSeriesPoint, error) {
+				panic("unexpected invocation of MockInterface.SeriesPointsBreakdown")
+			},
+		},
 	}
 }
 
@@ -96,6 +109,9 @@ func NewMockInterfaceFrom(i Interface) *MockInterface {
 		SeriesPointsFunc: &InterfaceSeriesPointsFunc{
 			defaultHook: i.SeriesPoints,
 		},
+		SeriesPointsBreakdownFunc: &InterfaceSeriesPointsBreakdownFunc{
+			defaultHook: i.SeriesPointsBreakdown,
+		},
 	}
 }
 
@@ -528,3 +544,117 @@ func (c InterfaceSeriesPointsFuncCall) Args() []interface{} {
 func (c InterfaceSeriesPointsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
+
+// InterfaceSeriesPointsBreakdownFunc describes the behavior when the
+// SeriesPointsBreakdown method of the parent MockInterface instance is
+// invoked.
+type InterfaceSeriesPointsBreakdownFunc struct {
+	defaultHook func(context.Context, SeriesPointsOpts, BreakdownGroupBy) ([]BreakdownSeriesPoint, error)
+	hooks       []func(context.Context, SeriesPointsOpts, BreakdownGroupBy) ([]BreakdownSeriesPoint, error)
+	history     []InterfaceSeriesPointsBreakdownFuncCall
+	mutex       sync.Mutex
+}
+
+// SeriesPointsBreakdown delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockInterface) SeriesPointsBreakdown(v0 context.Context, v1 SeriesPointsOpts, v2 BreakdownGroupBy) ([]BreakdownSeriesPoint, error) {
+	r0, r1 := m.SeriesPointsBreakdownFunc.nextHook()(v0, v1, v2)
+	m.SeriesPointsBreakdownFunc.appendCall(InterfaceSeriesPointsBreakdownFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// SeriesPointsBreakdown method of the parent MockInterface instance is
+// invoked and the hook queue is empty.
+func (f *InterfaceSeriesPointsBreakdownFunc) SetDefaultHook(hook func(context.Context, SeriesPointsOpts, BreakdownGroupBy) ([]BreakdownSeriesPoint, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SeriesPointsBreakdown method of the parent MockInterface instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *InterfaceSeriesPointsBreakdownFunc) PushHook(hook func(context.Context, SeriesPointsOpts, BreakdownGroupBy) ([]BreakdownSeriesPoint, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *InterfaceSeriesPointsBreakdownFunc) SetDefaultReturn(r0 []BreakdownSeriesPoint, r1 error) {
+	f.SetDefaultHook(func(context.Context, SeriesPointsOpts, BreakdownGroupBy) ([]BreakdownSeriesPoint, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *InterfaceSeriesPointsBreakdownFunc) PushReturn(r0 []BreakdownSeriesPoint, r1 error) {
+	f.PushHook(func(context.Context, SeriesPointsOpts, BreakdownGroupBy) ([]BreakdownSeriesPoint, error) {
+		return r0, r1
+	})
+}
+
+func (f *InterfaceSeriesPointsBreakdownFunc) nextHook() func(context.Context, SeriesPointsOpts, BreakdownGroupBy) ([]BreakdownSeriesPoint, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *InterfaceSeriesPointsBreakdownFunc) appendCall(r0 InterfaceSeriesPointsBreakdownFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of InterfaceSeriesPointsBreakdownFuncCall
+// objects describing the invocations of this function.
+func (f *InterfaceSeriesPointsBreakdownFunc) History() []InterfaceSeriesPointsBreakdownFuncCall {
+	f.mutex.Lock()
+	history := make([]InterfaceSeriesPointsBreakdownFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// InterfaceSeriesPointsBreakdownFuncCall is an object that describes an
+// invocation of method SeriesPointsBreakdown on an instance of
+// MockInterface.
+type InterfaceSeriesPointsBreakdownFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 SeriesPointsOpts
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 BreakdownGroupBy
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []BreakdownSeriesPoint
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c InterfaceSeriesPointsBreakdownFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c InterfaceSeriesPointsBreakdownFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
@@ -23,6 +23,7 @@ import (
 // for actual API usage.
 type Interface interface {
 	SeriesPoints(ctx context.Context, opts SeriesPointsOpts) ([]SeriesPoint, error)
+	SeriesPointsBreakdown(ctx context.Context, opts SeriesPointsOpts, groupBy BreakdownGroupBy) ([]BreakdownSeriesPoint, error)
 	RecordSeriesPoint(ctx context.Context, v RecordSeriesPointArgs) error
 	RecordSeriesPoints(ctx context.Context, pts []RecordSeriesPointArgs) error
 	CountData(ctx context.Context, opts CountDataOpts) (int, error)
@@ -113,7 +114,8 @@ type SeriesPointsOpts struct {
 	// Time ranges to query from/to, if non-nil, in UTC.
 	From, To *time.Time
 
-	// Limit is the number of data points to query, if non-zero.
+	// Limit is the number of data points to query, if non-zero. It is ignored by
+	// SeriesPointsBreakdown.
 	Limit int
 }
 
@@ -188,6 +190,19 @@ ORDER BY sub.series_id, sub.interval_time DESC
 // 3. Searches may not complete at the same exact time, so even in a perfect world if the interval
 //    should be 12h it may be off by a minute or so.
 func seriesPointsQuery(opts SeriesPointsOpts) *sqlf.Query {
+	limitClause := ""
+	if opts.Limit > 0 {
+		limitClause = fmt.Sprintf("LIMIT %d", opts.Limit)
+	}
+	return sqlf.Sprintf(
+		fullVectorSeriesAggregation+limitClause,
+		sqlf.Join(seriesPointsPredicates(opts), "\n AND "),
+	)
+}
+
+// seriesPointsPredicates returns the conditions selecting the series_points rows
+// matching opts.
+func seriesPointsPredicates(opts SeriesPointsOpts) []*sqlf.Query {
 	preds := []*sqlf.Query{}
 
 	if opts.SeriesID != nil {
@@ -202,10 +217,6 @@ func seriesPointsQuery(opts SeriesPointsOpts) *sqlf.Query {
 	if opts.To != nil {
 		preds = append(preds, sqlf.Sprintf("time <= %s", *opts.To))
 	}
-	limitClause := ""
-	if opts.Limit > 0 {
-		limitClause = fmt.Sprintf("LIMIT %d", opts.Limit)
-	}
 	if len(opts.Included) > 0 {
 		s := fmt.Sprintf("repo_id = any(%v)", values(opts.Included))
 		preds = append(preds, sqlf.Sprintf(s))
@@ -224,10 +235,100 @@ func seriesPointsQuery(opts SeriesPointsOpts) *sqlf.Query {
 	if len(preds) == 0 {
 		preds = append(preds, sqlf.Sprintf("TRUE"))
 	}
+	return preds
+}
+
+// BreakdownGroupBy describes how the per-repository points of a series are grouped
+// by SeriesPointsBreakdown.
+type BreakdownGroupBy string
+
+const (
+	// GroupByRepository groups points by repository name.
+	GroupByRepository BreakdownGroupBy = "REPOSITORY"
+
+	// GroupByOwner groups points by the owner of the repository, i.e. the
+	// repository name without its last path component (e.g. github.com/sourcegraph
+	// for github.com/sourcegraph/sourcegraph).
+	GroupByOwner BreakdownGroupBy = "OWNER"
+)
+
+// BreakdownSeriesPoint is a data point of a series aggregated over a single group
+// of repositories.
+type BreakdownSeriesPoint struct {
+	SeriesPoint
+
+	// Group is the repository name or owner the point was aggregated over.
+	Group string
+}
+
+// SeriesPointsBreakdown queries data points over time for a specific insights'
+// series, aggregated per group of repositories rather than over all of them.
+// Points are ordered by group and then by descending time.
+func (s *Store) SeriesPointsBreakdown(ctx context.Context, opts SeriesPointsOpts, groupBy BreakdownGroupBy) ([]BreakdownSeriesPoint, error) {
+	// 🚨 SECURITY: Groups expose repository names, so repositories the current user cannot see must be
+	// excluded the same way they are in SeriesPoints.
+	denylist, err := s.permStore.GetUnauthorizedRepoIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts.Excluded = append(opts.Excluded, denylist...)
+
+	q, err := seriesPointsBreakdownQuery(opts, groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var points []BreakdownSeriesPoint
+	err = s.query(ctx, q, func(sc scanner) error {
+		var point BreakdownSeriesPoint
+		err := sc.Scan(
+			&point.SeriesID,
+			&point.Group,
+			&point.Time,
+			&point.Value,
+		)
+		if err != nil {
+			return err
+		}
+		points = append(points, point)
+		return nil
+	})
+	return points, err
+}
+
+// Like fullVectorSeriesAggregation, the inner query selects the per-repository maximum to eliminate
+// duplicate points. The outer query then sums the repositories within each group.
+const seriesPointsBreakdownAggregation = `
+-- source: enterprise/internal/insights/store/store.go:SeriesPointsBreakdown
+SELECT sub.series_id, sub.grp, sub.interval_time, SUM(sub.value) as value FROM (
+	SELECT sp.repo_name_id, sp.series_id, %s AS grp, sp.time AS interval_time, MAX(value) as value
+	FROM (  select * from series_points
+			union
+			select * from series_points_snapshots
+	) AS sp
+	JOIN repo_names rn ON sp.repo_name_id = rn.id
+	WHERE %s
+	GROUP BY sp.series_id, interval_time, sp.repo_name_id, grp
+) sub
+GROUP BY sub.series_id, sub.grp, sub.interval_time
+ORDER BY sub.series_id, sub.grp, sub.interval_time DESC
+`
+
+func seriesPointsBreakdownQuery(opts SeriesPointsOpts, groupBy BreakdownGroupBy) (*sqlf.Query, error) {
+	var group *sqlf.Query
+	switch groupBy {
+	case GroupByRepository:
+		group = sqlf.Sprintf("rn.name")
+	case GroupByOwner:
+		group = sqlf.Sprintf("regexp_replace(rn.name, '/[^/]*$', '')")
+	default:
+		return nil, errors.Errorf("unsupported breakdown group %q", groupBy)
+	}
 	return sqlf.Sprintf(
-		fullVectorSeriesAggregation+limitClause,
-		sqlf.Join(preds, "\n AND "),
-	)
+		seriesPointsBreakdownAggregation,
+		group,
+		sqlf.Join(seriesPointsPredicates(opts), "\n AND "),
+	), nil
 }
 
 //values constructs a SQL values statement out of an array of repository ids
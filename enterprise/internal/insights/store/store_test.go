@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -130,6 +131,92 @@ SELECT time,
 
 }
 
+func TestSeriesPointsBreakdown(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	timescale, cleanup := insightsdbtesting.TimescaleDB(t)
+	defer cleanup()
+
+	postgres := dbtest.NewDB(t)
+	permStore := NewInsightPermissionStore(postgres)
+	store := NewWithClock(timescale, permStore, timeutil.Now)
+
+	recordTime := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	record := func(repoName string, repoID api.RepoID, value float64) {
+		err := store.RecordSeriesPoint(ctx, RecordSeriesPointArgs{
+			SeriesID:    "migration",
+			Point:       SeriesPoint{Time: recordTime, Value: value},
+			RepoName:    &repoName,
+			RepoID:      &repoID,
+			PersistMode: RecordMode,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	record("github.com/acme/api", 1, 3)
+	record("github.com/acme/web", 2, 4)
+	record("github.com/other/lib", 3, 5)
+	// A duplicate point for the same repository is not counted twice.
+	record("github.com/acme/api", 1, 3)
+
+	seriesID := "migration"
+	t.Run("by repository", func(t *testing.T) {
+		points, err := store.SeriesPointsBreakdown(ctx, SeriesPointsOpts{SeriesID: &seriesID}, GroupByRepository)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []BreakdownSeriesPoint{
+			{Group: "github.com/acme/api", SeriesPoint: SeriesPoint{SeriesID: seriesID, Time: recordTime, Value: 3}},
+			{Group: "github.com/acme/web", SeriesPoint: SeriesPoint{SeriesID: seriesID, Time: recordTime, Value: 4}},
+			{Group: "github.com/other/lib", SeriesPoint: SeriesPoint{SeriesID: seriesID, Time: recordTime, Value: 5}},
+		}
+		if diff := cmp.Diff(want, points); diff != "" {
+			t.Errorf("unexpected points (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("by owner", func(t *testing.T) {
+		points, err := store.SeriesPointsBreakdown(ctx, SeriesPointsOpts{SeriesID: &seriesID}, GroupByOwner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []BreakdownSeriesPoint{
+			{Group: "github.com/acme", SeriesPoint: SeriesPoint{SeriesID: seriesID, Time: recordTime, Value: 7}},
+			{Group: "github.com/other", SeriesPoint: SeriesPoint{SeriesID: seriesID, Time: recordTime, Value: 5}},
+		}
+		if diff := cmp.Diff(want, points); diff != "" {
+			t.Errorf("unexpected points (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("exclude list", func(t *testing.T) {
+		points, err := store.SeriesPointsBreakdown(ctx, SeriesPointsOpts{SeriesID: &seriesID, Excluded: []api.RepoID{1, 2}}, GroupByOwner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(1, len(points)); diff != "" {
+			t.Errorf("unexpected results from exclude list: %v", diff)
+		}
+	})
+}
+
+func TestSeriesPointsBreakdownQuery(t *testing.T) {
+	if _, err := seriesPointsBreakdownQuery(SeriesPointsOpts{}, "LANGUAGE"); err == nil {
+		t.Fatal("expected an error for an unsupported group")
+	}
+	q, err := seriesPointsBreakdownQuery(SeriesPointsOpts{}, GroupByOwner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(q.Query(sqlf.PostgresBindVar), "regexp_replace(rn.name, '/[^/]*$', '') AS grp") {
+		t.Fatalf("unexpected query: %s", q.Query(sqlf.PostgresBindVar))
+	}
+}
+
 func TestCountData(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
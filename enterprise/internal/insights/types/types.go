@@ -121,6 +121,36 @@ type DirtyQueryAggregate struct {
 	Reason  string
 }
 
+// AlertCondition is the condition under which an alert rule on an insight series matches.
+type AlertCondition string
+
+const (
+	// AlertAbove matches when the latest value of the series is above the threshold.
+	AlertAbove AlertCondition = "ABOVE"
+	// AlertBelow matches when the latest value of the series is below the threshold.
+	AlertBelow AlertCondition = "BELOW"
+	// AlertIncrease matches when the latest value of the series increased by at least
+	// the threshold over the last N intervals.
+	AlertIncrease AlertCondition = "INCREASE"
+	// AlertDecrease matches when the latest value of the series decreased by at least
+	// the threshold over the last N intervals.
+	AlertDecrease AlertCondition = "DECREASE"
+)
+
+// InsightSeriesAlert is an alert rule on the aggregated value of an insight series.
+type InsightSeriesAlert struct {
+	ID              int
+	SeriesID        string
+	UserID          int32
+	Condition       AlertCondition
+	Threshold       float64
+	Intervals       int
+	Triggered       bool
+	LastValue       *float64
+	LastTriggeredAt *time.Time
+	CreatedAt       time.Time
+}
+
 type Dashboard struct {
 	ID           int
 	Title        string
@@ -145,6 +175,8 @@ type InsightSeriesStatus struct {
 type PresentationType string
 
 const (
-	Line PresentationType = "LINE"
-	Pie  PresentationType = "PIE"
+	Line  PresentationType = "LINE"
+	Pie   PresentationType = "PIE"
+	Bar   PresentationType = "BAR"
+	Table PresentationType = "TABLE"
 )
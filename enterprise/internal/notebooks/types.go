@@ -2,6 +2,10 @@ package notebooks
 
 import (
 	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/compute"
 )
 
 type NotebookBlockType string
@@ -10,6 +14,8 @@ const (
 	NotebookQueryBlockType    NotebookBlockType = "query"
 	NotebookMarkdownBlockType NotebookBlockType = "md"
 	NotebookFileBlockType     NotebookBlockType = "file"
+	NotebookSymbolBlockType   NotebookBlockType = "symbol"
+	NotebookComputeBlockType  NotebookBlockType = "compute"
 )
 
 type NotebookQueryBlockInput struct {
@@ -35,12 +41,131 @@ type NotebookFileBlockInput struct {
 	LineRange      *LineRange `json:"lineRange,omitempty"`
 }
 
+// NotebookSymbolBlockInput pins a symbol definition. The symbol is rendered
+// with LineContext lines of source code around its definition.
+type NotebookSymbolBlockInput struct {
+	RepositoryName      string  `json:"repositoryName"`
+	FilePath            string  `json:"filePath"`
+	Revision            *string `json:"revision,omitempty"`
+	LineContext         int32   `json:"lineContext"`
+	SymbolName          string  `json:"symbolName"`
+	SymbolContainerName string  `json:"symbolContainerName"`
+	SymbolKind          string  `json:"symbolKind"`
+}
+
+// NotebookComputeBlockInput is a compute query whose results are rendered in
+// the notebook.
+type NotebookComputeBlockInput struct {
+	Text string `json:"text"`
+}
+
 type NotebookBlock struct {
 	ID            string                      `json:"id"`
 	Type          NotebookBlockType           `json:"type"`
 	QueryInput    *NotebookQueryBlockInput    `json:"queryInput,omitempty"`
 	MarkdownInput *NotebookMarkdownBlockInput `json:"markdownInput,omitempty"`
 	FileInput     *NotebookFileBlockInput     `json:"fileInput,omitempty"`
+	SymbolInput   *NotebookSymbolBlockInput   `json:"symbolInput,omitempty"`
+	ComputeInput  *NotebookComputeBlockInput  `json:"computeInput,omitempty"`
+}
+
+// Validate returns an error if the block does not have exactly the input that
+// corresponds to its type, or if the input is invalid.
+func (b *NotebookBlock) Validate() error {
+	if b.ID == "" {
+		return errors.New("notebook block must have an id")
+	}
+
+	inputs := 0
+	for _, set := range []bool{
+		b.QueryInput != nil,
+		b.MarkdownInput != nil,
+		b.FileInput != nil,
+		b.SymbolInput != nil,
+		b.ComputeInput != nil,
+	} {
+		if set {
+			inputs++
+		}
+	}
+	if inputs > 1 {
+		return errors.Errorf("notebook block %q must have a single input", b.ID)
+	}
+
+	switch b.Type {
+	case NotebookQueryBlockType:
+		if b.QueryInput == nil {
+			return errors.Errorf("query block %q is missing its input", b.ID)
+		}
+	case NotebookMarkdownBlockType:
+		if b.MarkdownInput == nil {
+			return errors.Errorf("markdown block %q is missing its input", b.ID)
+		}
+	case NotebookFileBlockType:
+		if b.FileInput == nil {
+			return errors.Errorf("file block %q is missing its input", b.ID)
+		}
+		return b.FileInput.validate()
+	case NotebookSymbolBlockType:
+		if b.SymbolInput == nil {
+			return errors.Errorf("symbol block %q is missing its input", b.ID)
+		}
+		return b.SymbolInput.validate()
+	case NotebookComputeBlockType:
+		if b.ComputeInput == nil {
+			return errors.Errorf("compute block %q is missing its input", b.ID)
+		}
+		return b.ComputeInput.validate()
+	default:
+		return errors.Errorf("notebook block %q has unknown type %q", b.ID, b.Type)
+	}
+	return nil
+}
+
+func (i *NotebookFileBlockInput) validate() error {
+	if i.RepositoryName == "" || i.FilePath == "" {
+		return errors.New("file block must have a repository name and file path")
+	}
+	if r := i.LineRange; r != nil && (r.StartLine < 1 || r.EndLine < r.StartLine) {
+		return errors.Errorf("file block has invalid line range %d-%d", r.StartLine, r.EndLine)
+	}
+	return nil
+}
+
+func (i *NotebookSymbolBlockInput) validate() error {
+	if i.RepositoryName == "" || i.FilePath == "" {
+		return errors.New("symbol block must have a repository name and file path")
+	}
+	if i.SymbolName == "" {
+		return errors.New("symbol block must have a symbol name")
+	}
+	if i.LineContext < 0 {
+		return errors.Errorf("symbol block has negative line context %d", i.LineContext)
+	}
+	return nil
+}
+
+func (i *NotebookComputeBlockInput) validate() error {
+	if _, err := compute.Parse(i.Text); err != nil {
+		return errors.Wrap(err, "compute block has invalid query")
+	}
+	return nil
+}
+
+// ValidateBlocks validates each block and checks that block ids are unique
+// within the notebook.
+func ValidateBlocks(blocks []NotebookBlock) error {
+	seen := make(map[string]struct{}, len(blocks))
+	for i := range blocks {
+		if err := blocks[i].Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[blocks[i].ID]; ok {
+			return errors.Errorf("duplicate notebook block id %q", blocks[i].ID)
+		}
+		seen[blocks[i].ID] = struct{}{}
+	}
+	return nil
 }
 
 type Notebook struct {
@@ -12,6 +12,8 @@ func TestNotebookBlockMarshalling(t *testing.T) {
 	markdownBlockInput := NotebookMarkdownBlockInput{Text: "# Title"}
 	revision := "main"
 	fileBlockInput := NotebookFileBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.ts", Revision: &revision, LineRange: &LineRange{1, 10}}
+	symbolBlockInput := NotebookSymbolBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.go", Revision: &revision, LineContext: 3, SymbolName: "Parse", SymbolContainerName: "compute", SymbolKind: "FUNCTION"}
+	computeBlockInput := NotebookComputeBlockInput{Text: "content:aggregate((\\d+) -> $1)"}
 
 	tests := []struct {
 		block NotebookBlock
@@ -29,6 +31,14 @@ func TestNotebookBlockMarshalling(t *testing.T) {
 			block: NotebookBlock{ID: "id1", Type: NotebookFileBlockType, FileInput: &fileBlockInput},
 			want:  autogold.Want("marshals file block", `{"id":"id1","type":"file","fileInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.ts","revision":"main","lineRange":{"startLine":1,"endLine":10}}}`),
 		},
+		{
+			block: NotebookBlock{ID: "id1", Type: NotebookSymbolBlockType, SymbolInput: &symbolBlockInput},
+			want:  autogold.Want("marshals symbol block", `{"id":"id1","type":"symbol","symbolInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.go","revision":"main","lineContext":3,"symbolName":"Parse","symbolContainerName":"compute","symbolKind":"FUNCTION"}}`),
+		},
+		{
+			block: NotebookBlock{ID: "id1", Type: NotebookComputeBlockType, ComputeInput: &computeBlockInput},
+			want:  autogold.Want("marshals compute block", `{"id":"id1","type":"compute","computeInput":{"text":"content:aggregate((\\d+) -\u003e $1)"}}`),
+		},
 	}
 
 	for _, tt := range tests {
@@ -45,6 +55,8 @@ func TestNotebookBlockUnmarshalling(t *testing.T) {
 	markdownBlockInput := NotebookMarkdownBlockInput{Text: "# Title"}
 	revision := "main"
 	fileBlockInput := NotebookFileBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.ts", Revision: &revision, LineRange: &LineRange{1, 10}}
+	symbolBlockInput := NotebookSymbolBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.go", Revision: &revision, LineContext: 3, SymbolName: "Parse", SymbolContainerName: "compute", SymbolKind: "FUNCTION"}
+	computeBlockInput := NotebookComputeBlockInput{Text: "content:aggregate((\\d+) -> $1)"}
 
 	tests := []struct {
 		json string
@@ -62,6 +74,14 @@ func TestNotebookBlockUnmarshalling(t *testing.T) {
 			json: `{"id":"id1","type":"file","fileInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.ts","revision":"main","lineRange":{"startLine":1,"endLine":10}}}`,
 			want: autogold.Want("marshals file block", NotebookBlock{ID: "id1", Type: NotebookFileBlockType, FileInput: &fileBlockInput}),
 		},
+		{
+			json: `{"id":"id1","type":"symbol","symbolInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.go","revision":"main","lineContext":3,"symbolName":"Parse","symbolContainerName":"compute","symbolKind":"FUNCTION"}}`,
+			want: autogold.Want("marshals symbol block", NotebookBlock{ID: "id1", Type: NotebookSymbolBlockType, SymbolInput: &symbolBlockInput}),
+		},
+		{
+			json: `{"id":"id1","type":"compute","computeInput":{"text":"content:aggregate((\\d+) -> $1)"}}`,
+			want: autogold.Want("marshals compute block", NotebookBlock{ID: "id1", Type: NotebookComputeBlockType, ComputeInput: &computeBlockInput}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -73,3 +93,72 @@ func TestNotebookBlockUnmarshalling(t *testing.T) {
 		tt.want.Equal(t, block)
 	}
 }
+
+func TestNotebookBlockValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		block NotebookBlock
+		want  autogold.Value
+	}{
+		{
+			name:  "valid symbol block",
+			block: NotebookBlock{ID: "id1", Type: NotebookSymbolBlockType, SymbolInput: &NotebookSymbolBlockInput{RepositoryName: "r", FilePath: "f", SymbolName: "s"}},
+			want:  autogold.Want("valid symbol block", "<nil>"),
+		},
+		{
+			name:  "valid compute block",
+			block: NotebookBlock{ID: "id1", Type: NotebookComputeBlockType, ComputeInput: &NotebookComputeBlockInput{Text: "content:output(a -> b)"}},
+			want:  autogold.Want("valid compute block", "<nil>"),
+		},
+		{
+			name:  "missing input",
+			block: NotebookBlock{ID: "id1", Type: NotebookSymbolBlockType},
+			want:  autogold.Want("missing input", `symbol block "id1" is missing its input`),
+		},
+		{
+			name:  "mismatched input",
+			block: NotebookBlock{ID: "id1", Type: NotebookComputeBlockType, QueryInput: &NotebookQueryBlockInput{Text: "a"}},
+			want:  autogold.Want("mismatched input", `compute block "id1" is missing its input`),
+		},
+		{
+			name:  "multiple inputs",
+			block: NotebookBlock{ID: "id1", Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{Text: "a"}, MarkdownInput: &NotebookMarkdownBlockInput{Text: "b"}},
+			want:  autogold.Want("multiple inputs", `notebook block "id1" must have a single input`),
+		},
+		{
+			name:  "symbol block without name",
+			block: NotebookBlock{ID: "id1", Type: NotebookSymbolBlockType, SymbolInput: &NotebookSymbolBlockInput{RepositoryName: "r", FilePath: "f"}},
+			want:  autogold.Want("symbol block without name", "symbol block must have a symbol name"),
+		},
+		{
+			name:  "invalid compute query",
+			block: NotebookBlock{ID: "id1", Type: NotebookComputeBlockType, ComputeInput: &NotebookComputeBlockInput{Text: "repo:a"}},
+			want:  autogold.Want("invalid compute query", "compute block has invalid query: compute endpoint expects nonempty pattern"),
+		},
+		{
+			name:  "invalid line range",
+			block: NotebookBlock{ID: "id1", Type: NotebookFileBlockType, FileInput: &NotebookFileBlockInput{RepositoryName: "r", FilePath: "f", LineRange: &LineRange{10, 1}}},
+			want:  autogold.Want("invalid line range", "file block has invalid line range 10-1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := "<nil>"
+			if err := tt.block.Validate(); err != nil {
+				got = err.Error()
+			}
+			tt.want.Equal(t, got)
+		})
+	}
+}
+
+func TestValidateBlocks_DuplicateIDs(t *testing.T) {
+	blocks := []NotebookBlock{
+		{ID: "id1", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{Text: "a"}},
+		{ID: "id1", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{Text: "b"}},
+	}
+	if err := ValidateBlocks(blocks); err == nil {
+		t.Fatal("expected error for duplicate block ids")
+	}
+}
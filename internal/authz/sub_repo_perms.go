@@ -2,8 +2,10 @@ package authz
 
 import (
 	"context"
+	"io/fs"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -21,6 +23,9 @@ import (
 // RepoContent specifies data existing in a repo. It currently only supports
 // paths but will be extended in future to support other pieces of metadata, for
 // example branch.
+//
+// Paths of directories end with a slash, which lets the directories above the
+// paths a user can read be listed even if no rule includes them.
 type RepoContent struct {
 	Repo api.RepoName
 	Path string
@@ -43,6 +48,22 @@ type SubRepoPermissionChecker interface {
 
 var _ SubRepoPermissionChecker = &SubRepoPermsClient{}
 
+// DefaultSubRepoPermsChecker allows us to use a single instance with a shared
+// cache and database connection. Since we don't have a database connection at
+// initialisation time, services that require this client should initialise it
+// in their main function.
+var DefaultSubRepoPermsChecker SubRepoPermissionChecker = &noopPermsChecker{}
+
+type noopPermsChecker struct{}
+
+func (*noopPermsChecker) Permissions(ctx context.Context, userID int32, content RepoContent) (Perms, error) {
+	return Read, nil
+}
+
+func (*noopPermsChecker) Enabled() bool {
+	return false
+}
+
 // SubRepoPermissionsGetter allows getting sub repository permissions.
 //
 //go:generate ../../dev/mockgen.sh github.com/sourcegraph/sourcegraph/internal/authz -i SubRepoPermissionsGetter -o mock_sub_repo_perms_getter.go
@@ -72,8 +93,9 @@ type cachedRules struct {
 }
 
 type compiledRules struct {
-	includes []glob.Glob
-	excludes []glob.Glob
+	includes    []glob.Glob
+	dirIncludes []glob.Glob
+	excludes    []glob.Glob
 }
 
 // NewSubRepoPermsClient instantiates an instance of authz.SubRepoPermsClient
@@ -193,6 +215,10 @@ func (s *SubRepoPermsClient) Permissions(ctx context.Context, userID int32, cont
 
 	// Rules are created including the repo name
 	toMatch := path.Join(string(content.Repo), content.Path)
+	isDir := strings.HasSuffix(content.Path, "/")
+	if isDir {
+		toMatch += "/"
+	}
 
 	// The current path needs to either be included or NOT excluded and we'll give
 	// preference to exclusion.
@@ -207,6 +233,16 @@ func (s *SubRepoPermsClient) Permissions(ctx context.Context, userID int32, cont
 		}
 	}
 
+	// Directories above included paths must be readable too, otherwise users
+	// could not browse down to the paths they are allowed to read.
+	if isDir {
+		for _, rule := range rules.dirIncludes {
+			if rule.Match(toMatch) {
+				return Read, nil
+			}
+		}
+	}
+
 	// Return None if no rule matches to be safe
 	return None, nil
 }
@@ -242,12 +278,21 @@ func (s *SubRepoPermsClient) getCompiledRules(ctx context.Context, userID int32)
 		}
 		for repo, perms := range repoPerms {
 			includes := make([]glob.Glob, 0, len(perms.PathIncludes))
+			var dirIncludes []glob.Glob
 			for _, rule := range perms.PathIncludes {
 				g, err := glob.Compile(rule, '/')
 				if err != nil {
 					return nil, errors.Wrap(err, "building include matcher")
 				}
 				includes = append(includes, g)
+
+				for _, dir := range parentDirs(rule) {
+					// Splitting the rule may cut through alternatives such as
+					// "{a,b/c}", in which case the directory is not listable.
+					if g, err := glob.Compile(dir, '/'); err == nil {
+						dirIncludes = append(dirIncludes, g)
+					}
+				}
 			}
 			excludes := make([]glob.Glob, 0, len(perms.PathExcludes))
 			for _, rule := range perms.PathExcludes {
@@ -258,8 +303,9 @@ func (s *SubRepoPermsClient) getCompiledRules(ctx context.Context, userID int32)
 				excludes = append(excludes, g)
 			}
 			toCache.rules[repo] = compiledRules{
-				includes: includes,
-				excludes: excludes,
+				includes:    includes,
+				dirIncludes: dirIncludes,
+				excludes:    excludes,
 			}
 		}
 		toCache.timestamp = s.clock()
@@ -274,6 +320,20 @@ func (s *SubRepoPermsClient) getCompiledRules(ctx context.Context, userID int32)
 	return compiled, nil
 }
 
+// parentDirs returns patterns matching the directories above the paths that
+// match the given pattern, each with a trailing slash. For example, the
+// parent directories of "repo/depot/main/**" are "repo/", "repo/depot/" and
+// "repo/depot/main/".
+func parentDirs(pattern string) []string {
+	parts := strings.Split(pattern, "/")
+
+	dirs := make([]string, 0, len(parts)-1)
+	for i := 1; i < len(parts); i++ {
+		dirs = append(dirs, strings.Join(parts[:i], "/")+"/")
+	}
+	return dirs
+}
+
 func (s *SubRepoPermsClient) Enabled() bool {
 	if c := conf.Get(); c.ExperimentalFeatures != nil && c.ExperimentalFeatures.SubRepoPermissions != nil {
 		return c.ExperimentalFeatures.SubRepoPermissions.Enabled
@@ -312,3 +372,47 @@ func ActorPermissions(ctx context.Context, s SubRepoPermissionChecker, a *actor.
 	}
 	return perms, nil
 }
+
+// FilterActorPaths returns the subset of paths in the given repo that the
+// actor is allowed to read, preserving their order.
+func FilterActorPaths(ctx context.Context, s SubRepoPermissionChecker, a *actor.Actor, repo api.RepoName, paths []string) ([]string, error) {
+	if !s.Enabled() {
+		return paths, nil
+	}
+
+	filtered := make([]string, 0, len(paths))
+	for _, p := range paths {
+		ok, err := FilterActorPath(ctx, s, a, repo, p)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered, nil
+}
+
+// FilterActorPath returns true if the actor is allowed to read the given path
+// in the given repo.
+func FilterActorPath(ctx context.Context, s SubRepoPermissionChecker, a *actor.Actor, repo api.RepoName, filePath string) (bool, error) {
+	perms, err := ActorPermissions(ctx, s, a, RepoContent{
+		Repo: repo,
+		Path: filePath,
+	})
+	if err != nil {
+		return false, err
+	}
+	return perms.Include(Read), nil
+}
+
+// FilterActorFileInfo returns true if the actor is allowed to read the given
+// file or directory in the given repo. Directories above the paths the actor
+// is allowed to read are readable as well.
+func FilterActorFileInfo(ctx context.Context, s SubRepoPermissionChecker, a *actor.Actor, repo api.RepoName, fi fs.FileInfo) (bool, error) {
+	filePath := fi.Name()
+	if fi.IsDir() {
+		filePath += "/"
+	}
+	return FilterActorPath(ctx, s, a, repo, filePath)
+}
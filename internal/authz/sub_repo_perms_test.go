@@ -143,6 +143,58 @@ func TestSubRepoPermsPermissions(t *testing.T) {
 	}
 }
 
+func TestSubRepoPermsPermissionsParentDirectories(t *testing.T) {
+	conf.Mock(&conf.Unified{
+		SiteConfiguration: schema.SiteConfiguration{
+			ExperimentalFeatures: &schema.ExperimentalFeatures{
+				SubRepoPermissions: &schema.SubRepoPermissions{
+					Enabled: true,
+				},
+			},
+		},
+	})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	// Rules as synced from a Perforce protections table granting access to
+	// //depot/main/sub/... but not //depot/main/sub/secret/...
+	getter := NewMockSubRepoPermissionsGetter()
+	getter.GetByUserFunc.SetDefaultHook(func(ctx context.Context, i int32) (map[api.RepoName]SubRepoPermissions, error) {
+		return map[api.RepoName]SubRepoPermissions{
+			"perforce": {
+				PathIncludes: []string{"perforce/depot/main/sub/**"},
+				PathExcludes: []string{"perforce/depot/main/sub/secret/**"},
+			},
+		}, nil
+	})
+	client, err := NewSubRepoPermsClient(getter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, want := range map[string]Perms{
+		"depot/":                       Read,
+		"depot/main/":                  Read,
+		"depot/main/sub/":              Read,
+		"depot/main/sub/file.go":       Read,
+		"depot/main/sub/dir/":          Read,
+		"depot/main/sub/secret/":       None,
+		"depot/main/sub/secret/key":    None,
+		"depot/main/other/":            None,
+		"depot/main/file.go":           None,
+		"depot":                        None,
+		"depot/main/sub/../../other/":  None,
+		"depot/main/sub/secret/inner/": None,
+	} {
+		have, err := client.Permissions(context.Background(), 1, RepoContent{Repo: "perforce", Path: path})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have != want {
+			t.Errorf("%q: have %v, want %v", path, have, want)
+		}
+	}
+}
+
 func TestSubRepoPermsPermissionsCache(t *testing.T) {
 	conf.Mock(&conf.Unified{
 		SiteConfiguration: schema.SiteConfiguration{
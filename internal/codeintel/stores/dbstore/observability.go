@@ -10,6 +10,8 @@ import (
 type Operations struct {
 	repoName           *observation.Operation
 	getJVMDependencies *observation.Operation
+	getNPMDependencies *observation.Operation
+	getGoDependencies  *observation.Operation
 }
 
 func NewREDMetrics(observationContext *observation.Context) *metrics.REDMetrics {
@@ -33,5 +35,7 @@ func NewOperations(observationContext *observation.Context, metrics *metrics.RED
 	return &Operations{
 		repoName:           op("RepoName"),
 		getJVMDependencies: op("GetJVMDependencies"),
+		getNPMDependencies: op("GetNPMDependencies"),
+		getGoDependencies:  op("GetGoDependencies"),
 	}
 }
@@ -3,6 +3,7 @@ package dbstore
 import (
 	"context"
 	"database/sql"
+	"strings"
 
 	"github.com/keegancsmith/sqlf"
 	"github.com/opentracing/opentracing-go/log"
@@ -55,23 +56,92 @@ func (s *Store) GetJVMDependencyRepos(ctx context.Context, filter GetJVMDependen
 	}})
 	defer endObservation(1, observation.Args{})
 
+	return scanJVMDependencyRepo(s.Query(ctx, lsifDependencyReposQuery("semanticdb", filter.ArtifactName, filter.After, filter.Limit)))
+}
+
+type GetNPMDependencyReposOpts struct {
+	// PackageName is the name of the package, such as "@types/node".
+	PackageName string
+	After       int
+	Limit       int
+}
+
+type NPMDependencyRepo struct {
+	Package string
+	Version string
+	ID      int
+}
+
+func (s *Store) GetNPMDependencyRepos(ctx context.Context, filter GetNPMDependencyReposOpts) (repos []NPMDependencyRepo, err error) {
+	ctx, endObservation := s.operations.getNPMDependencies.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("after", filter.After),
+		log.Int("limit", filter.Limit),
+		log.Lazy(func(l log.Encoder) {
+			l.EmitInt("results", len(repos))
+		}),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanNPMDependencyRepo(s.Query(ctx, lsifDependencyReposQuery("npm", filter.PackageName, filter.After, filter.Limit)))
+}
+
+type GetGoDependencyReposOpts struct {
+	// ModulePath is the path of the module, such as "golang.org/x/mod".
+	ModulePath string
+	After      int
+	Limit      int
+}
+
+type GoDependencyRepo struct {
+	// Module is the module path, without the "https://" prefix that lsif-go
+	// adds to the names of packages.
+	Module  string
+	Version string
+	ID      int
+}
+
+func (s *Store) GetGoDependencyRepos(ctx context.Context, filter GetGoDependencyReposOpts) (repos []GoDependencyRepo, err error) {
+	ctx, endObservation := s.operations.getGoDependencies.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("after", filter.After),
+		log.Int("limit", filter.Limit),
+		log.Lazy(func(l log.Encoder) {
+			l.EmitInt("results", len(repos))
+		}),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	var name string
+	if filter.ModulePath != "" {
+		name = goPackageNamePrefix + filter.ModulePath
+	}
+
+	return scanGoDependencyRepo(s.Query(ctx, lsifDependencyReposQuery("gomod", name, filter.After, filter.Limit)))
+}
+
+// goPackageNamePrefix is the prefix lsif-go adds to module paths to form the
+// names of the packages of its monikers.
+const goPackageNamePrefix = "https://"
+
+// lsifDependencyReposQuery returns the query selecting the dependency repos of
+// the given LSIF moniker scheme.
+func lsifDependencyReposQuery(scheme, name string, after, limit int) *sqlf.Query {
 	conds := make([]*sqlf.Query, 0, 3)
-	conds = append(conds, sqlf.Sprintf("scheme = 'semanticdb'"))
+	conds = append(conds, sqlf.Sprintf("scheme = %s", scheme))
 
-	if filter.After > 0 {
-		conds = append(conds, sqlf.Sprintf("id > %d", filter.After))
+	if after > 0 {
+		conds = append(conds, sqlf.Sprintf("id > %d", after))
 	}
 
-	if filter.ArtifactName != "" {
-		conds = append(conds, sqlf.Sprintf("name = %s", filter.ArtifactName))
+	if name != "" {
+		conds = append(conds, sqlf.Sprintf("name = %s", name))
 	}
 
-	limit := sqlf.Sprintf("")
-	if filter.Limit != 0 {
-		limit = sqlf.Sprintf("LIMIT %s", filter.Limit)
+	limitQuery := sqlf.Sprintf("")
+	if limit != 0 {
+		limitQuery = sqlf.Sprintf("LIMIT %s", limit)
 	}
 
-	return scanJVMDependencyRepo(s.Query(ctx, sqlf.Sprintf(getLSIFDependencyReposQuery, sqlf.Join(conds, "AND"), limit)))
+	return sqlf.Sprintf(getLSIFDependencyReposQuery, sqlf.Join(conds, "AND"), limitQuery)
 }
 
 func scanJVMDependencyRepo(rows *sql.Rows, queryErr error) (dependencies []JVMDependencyRepo, err error) {
@@ -96,6 +166,51 @@ func scanJVMDependencyRepo(rows *sql.Rows, queryErr error) (dependencies []JVMDe
 	return dependencies, nil
 }
 
+func scanNPMDependencyRepo(rows *sql.Rows, queryErr error) (dependencies []NPMDependencyRepo, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		var dep NPMDependencyRepo
+		if err = rows.Scan(
+			&dep.ID,
+			&dep.Package,
+			&dep.Version,
+		); err != nil {
+			return nil, err
+		}
+
+		dependencies = append(dependencies, dep)
+	}
+
+	return dependencies, nil
+}
+
+func scanGoDependencyRepo(rows *sql.Rows, queryErr error) (dependencies []GoDependencyRepo, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		var dep GoDependencyRepo
+		if err = rows.Scan(
+			&dep.ID,
+			&dep.Module,
+			&dep.Version,
+		); err != nil {
+			return nil, err
+		}
+		dep.Module = strings.TrimPrefix(dep.Module, goPackageNamePrefix)
+
+		dependencies = append(dependencies, dep)
+	}
+
+	return dependencies, nil
+}
+
 const getLSIFDependencyReposQuery = `
 -- source: internal/codeintel/stores/dbstore/repos.go:GetLSIFDependencyRepos
 SELECT id, name, version FROM lsif_dependency_repos
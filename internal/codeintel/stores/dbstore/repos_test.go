@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 )
 
@@ -23,3 +25,73 @@ func TestRepoName(t *testing.T) {
 		t.Errorf("unexpected repo name. want=%s have=%s", "github.com/foo/bar", name)
 	}
 }
+
+func TestGetNPMDependencyRepos(t *testing.T) {
+	db := dbtest.NewDB(t)
+	store := testStore(db)
+
+	if _, err := db.Exec(`
+		INSERT INTO lsif_dependency_repos (id, scheme, name, version) VALUES
+			(1, 'npm', 'react', '17.0.2'),
+			(2, 'semanticdb', 'org.example:example', '1.0.0'),
+			(3, 'npm', '@types/node', '16.11.0'),
+			(4, 'npm', 'react', '16.14.0')
+	`); err != nil {
+		t.Fatalf("unexpected error inserting dependency repos: %s", err)
+	}
+
+	repos, err := store.GetNPMDependencyRepos(context.Background(), GetNPMDependencyReposOpts{PackageName: "react"})
+	if err != nil {
+		t.Fatalf("unexpected error getting npm dependency repos: %s", err)
+	}
+	expected := []NPMDependencyRepo{
+		{ID: 1, Package: "react", Version: "17.0.2"},
+		{ID: 4, Package: "react", Version: "16.14.0"},
+	}
+	if diff := cmp.Diff(expected, repos); diff != "" {
+		t.Errorf("unexpected dependency repos (-want +got):\n%s", diff)
+	}
+
+	repos, err = store.GetNPMDependencyRepos(context.Background(), GetNPMDependencyReposOpts{After: 1, Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error getting npm dependency repos: %s", err)
+	}
+	if diff := cmp.Diff([]NPMDependencyRepo{{ID: 3, Package: "@types/node", Version: "16.11.0"}}, repos); diff != "" {
+		t.Errorf("unexpected dependency repos (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetGoDependencyRepos(t *testing.T) {
+	db := dbtest.NewDB(t)
+	store := testStore(db)
+
+	if _, err := db.Exec(`
+		INSERT INTO lsif_dependency_repos (id, scheme, name, version) VALUES
+			(1, 'gomod', 'https://golang.org/x/mod', 'v0.5.1'),
+			(2, 'npm', 'react', '17.0.2'),
+			(3, 'gomod', 'https://github.com/sourcegraph/sourcegraph', 'v0.0.0-20211117231018-1b9f5d4e2c66'),
+			(4, 'gomod', 'https://golang.org/x/mod', 'v0.4.2')
+	`); err != nil {
+		t.Fatalf("unexpected error inserting dependency repos: %s", err)
+	}
+
+	repos, err := store.GetGoDependencyRepos(context.Background(), GetGoDependencyReposOpts{ModulePath: "golang.org/x/mod"})
+	if err != nil {
+		t.Fatalf("unexpected error getting Go dependency repos: %s", err)
+	}
+	expected := []GoDependencyRepo{
+		{ID: 1, Module: "golang.org/x/mod", Version: "v0.5.1"},
+		{ID: 4, Module: "golang.org/x/mod", Version: "v0.4.2"},
+	}
+	if diff := cmp.Diff(expected, repos); diff != "" {
+		t.Errorf("unexpected dependency repos (-want +got):\n%s", diff)
+	}
+
+	repos, err = store.GetGoDependencyRepos(context.Background(), GetGoDependencyReposOpts{After: 1, Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error getting Go dependency repos: %s", err)
+	}
+	if diff := cmp.Diff([]GoDependencyRepo{{ID: 3, Module: "github.com/sourcegraph/sourcegraph", Version: "v0.0.0-20211117231018-1b9f5d4e2c66"}}, repos); diff != "" {
+		t.Errorf("unexpected dependency repos (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,96 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// Aggregate counts the values of a pattern over all matches. Values are
+// produced like with Output, so the group by pattern may refer to capture
+// groups (e.g., $1) and builtin variables such as $repo, $path or $author.
+type Aggregate struct {
+	MatchPattern   MatchPattern
+	GroupByPattern string
+}
+
+func (c *Aggregate) String() string {
+	return fmt.Sprintf("Aggregate: (%s) -> (%s)", c.MatchPattern.String(), c.GroupByPattern)
+}
+
+// Run returns the counts of the values in a single match. Use an Aggregator to
+// combine the tables returned for each match.
+func (c *Aggregate) Run(ctx context.Context, r result.Match) (Result, error) {
+	content, ok, err := resultContent(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	env := NewMetaEnvironment(r, content)
+	groupByPattern, err := substituteMetaVariables(c.GroupByPattern, env)
+	if err != nil {
+		return nil, err
+	}
+	// Group by values are newline separated, so a value containing a newline is
+	// counted as multiple values.
+	text, err := output(ctx, content, c.MatchPattern, groupByPattern, "\n")
+	if err != nil {
+		return nil, err
+	}
+
+	aggregator := NewAggregator()
+	for _, value := range strings.Split(text.Value, "\n") {
+		if value == "" {
+			continue
+		}
+		aggregator.add(value, 1)
+	}
+	return aggregator.Table(), nil
+}
+
+// Aggregator combines the tables of an Aggregate command into a single table.
+// It is not safe for concurrent use.
+type Aggregator struct {
+	counts map[string]int
+}
+
+func NewAggregator() *Aggregator {
+	return &Aggregator{counts: make(map[string]int)}
+}
+
+func (a *Aggregator) add(value string, count int) {
+	a.counts[value] += count
+}
+
+// Add adds the counts of table to the aggregate.
+func (a *Aggregator) Add(table *Table) {
+	for _, row := range table.Rows {
+		a.add(row.Value, row.Count)
+	}
+}
+
+// Len returns the number of distinct values seen so far.
+func (a *Aggregator) Len() int {
+	return len(a.counts)
+}
+
+// Table returns a snapshot of the aggregate, ordered by descending count and
+// then by value.
+func (a *Aggregator) Table() *Table {
+	rows := make([]TableRow, 0, len(a.counts))
+	for value, count := range a.counts {
+		rows = append(rows, TableRow{Value: value, Count: count})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Count != rows[j].Count {
+			return rows[i].Count > rows[j].Count
+		}
+		return rows[i].Value < rows[j].Value
+	})
+	return &Table{Rows: rows, Kind: "aggregate"}
+}
@@ -0,0 +1,83 @@
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hexops/autogold"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+func TestAggregate(t *testing.T) {
+	contents := map[string]string{
+		"a/package.json": `"lodash": "4.17.21", "react": "17.0.2"`,
+		"b/package.json": `"lodash": "4.17.21"`,
+		"c/package.json": `"lodash": "3.10.1"`,
+	}
+	git.Mocks.ReadFile = func(_ api.CommitID, name string) ([]byte, error) {
+		return []byte(contents[name]), nil
+	}
+	t.Cleanup(git.ResetMocks)
+
+	fileMatch := func(repo, path string) result.Match {
+		return &result.FileMatch{
+			File: result.File{
+				Repo: types.MinimalRepo{Name: api.RepoName(repo)},
+				Path: path,
+			},
+		}
+	}
+	matches := []result.Match{
+		fileMatch("github.com/sourcegraph/a", "a/package.json"),
+		fileMatch("github.com/sourcegraph/b", "b/package.json"),
+		fileMatch("github.com/sourcegraph/b", "c/package.json"),
+		&result.CommitMatch{
+			Commit: gitdomain.Commit{
+				Author:    gitdomain.Signature{Name: "alice"},
+				Committer: &gitdomain.Signature{},
+				Message:   `bump "lodash": "4.17.21"`,
+			},
+		},
+	}
+
+	test := func(q string) string {
+		computeQuery, err := Parse(q)
+		if err != nil {
+			return err.Error()
+		}
+		aggregator := NewAggregator()
+		for _, m := range matches {
+			res, err := computeQuery.Command.Run(context.Background(), m)
+			if err != nil {
+				return err.Error()
+			}
+			if table, ok := res.(*Table); ok {
+				aggregator.Add(table)
+			}
+		}
+		v, _ := json.Marshal(aggregator.Table().Rows)
+		return string(v)
+	}
+
+	autogold.Want("group by capture group",
+		`[{"value":"4.17.21","count":3},{"value":"3.10.1","count":1}]`).
+		Equal(t, test(`content:aggregate("lodash": "([^"]+)" -> $1)`))
+
+	autogold.Want("group by repo",
+		`[{"value":"github.com/sourcegraph/b","count":2},{"value":"github.com/sourcegraph/a","count":1}]`).
+		Equal(t, test(`content:aggregate("lodash" -> $repo)`))
+
+	autogold.Want("group by path and named capture group",
+		`[{"value":"a/package.json 17.0.2","count":1}]`).
+		Equal(t, test(`content:aggregate("react": "(?P<version>[^"]+)" -> $path ${version})`))
+
+	autogold.Want("group by author",
+		`[{"value":"alice","count":1}]`).
+		Equal(t, test(`content:aggregate(bump -> $author)`))
+}
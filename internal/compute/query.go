@@ -32,6 +32,8 @@ func (q Query) ToSearchQuery() (string, error) {
 		searchPattern = c.MatchPattern.String()
 	case *Output:
 		searchPattern = c.MatchPattern.String()
+	case *Aggregate:
+		searchPattern = c.MatchPattern.String()
 	default:
 		return "", errors.Errorf("unsupported query conversion for compute command %T", c)
 	}
@@ -104,12 +106,15 @@ func toRegexpPattern(value string) (MatchPattern, error) {
 
 var ComputePredicateRegistry = query.PredicateRegistry{
 	query.FieldContent: {
-		"replace":            func() query.Predicate { return query.EmptyPredicate{} },
-		"replace.regexp":     func() query.Predicate { return query.EmptyPredicate{} },
-		"replace.structural": func() query.Predicate { return query.EmptyPredicate{} },
-		"output":             func() query.Predicate { return query.EmptyPredicate{} },
-		"output.regexp":      func() query.Predicate { return query.EmptyPredicate{} },
-		"output.structural":  func() query.Predicate { return query.EmptyPredicate{} },
+		"replace":              func() query.Predicate { return query.EmptyPredicate{} },
+		"replace.regexp":       func() query.Predicate { return query.EmptyPredicate{} },
+		"replace.structural":   func() query.Predicate { return query.EmptyPredicate{} },
+		"output":               func() query.Predicate { return query.EmptyPredicate{} },
+		"output.regexp":        func() query.Predicate { return query.EmptyPredicate{} },
+		"output.structural":    func() query.Predicate { return query.EmptyPredicate{} },
+		"aggregate":            func() query.Predicate { return query.EmptyPredicate{} },
+		"aggregate.regexp":     func() query.Predicate { return query.EmptyPredicate{} },
+		"aggregate.structural": func() query.Predicate { return query.EmptyPredicate{} },
 	},
 }
 
@@ -195,6 +200,35 @@ func parseOutput(pattern *query.Pattern) (Command, bool, error) {
 	return &Output{MatchPattern: matchPattern, OutputPattern: right, Separator: "\n"}, true, nil
 }
 
+func parseAggregate(pattern *query.Pattern) (Command, bool, error) {
+	name, args, ok := parseContentPredicate(pattern)
+	if !ok {
+		return nil, false, nil
+	}
+	left, right, err := parseArrowSyntax(args)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var matchPattern MatchPattern
+	switch name {
+	case "aggregate", "aggregate.regexp":
+		var err error
+		matchPattern, err = toRegexpPattern(left)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "aggregate command")
+		}
+	case "aggregate.structural":
+		// structural search doesn't do any match pattern validation
+		matchPattern = &Comby{Value: left}
+	default:
+		// unrecognized name
+		return nil, false, nil
+	}
+
+	return &Aggregate{MatchPattern: matchPattern, GroupByPattern: right}, true, nil
+}
+
 func parseMatchOnly(pattern *query.Pattern) (Command, bool, error) {
 	rp, err := toRegexpPattern(pattern.Value)
 	if err != nil {
@@ -224,6 +258,7 @@ func first(parsers ...commandParser) commandParser {
 var parseCommand = first(
 	parseReplace,
 	parseOutput,
+	parseAggregate,
 	parseMatchOnly,
 )
 
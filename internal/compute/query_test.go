@@ -46,6 +46,10 @@ func TestParse(t *testing.T) {
 	autogold.Want("replace no left hand side",
 		"Command: `Replace in place: () -> (b)`").
 		Equal(t, test("content:replace(->b)"))
+
+	autogold.Want("aggregate",
+		"Command: `Aggregate: (lodash@(\\d+)) -> ($1)`").
+		Equal(t, test("content:aggregate(lodash@(\\d+) -> $1)"))
 }
 
 func TestToSearchQuery(t *testing.T) {
@@ -65,4 +69,8 @@ func TestToSearchQuery(t *testing.T) {
 	autogold.Want("convert replace-in-place to search query",
 		"repo:foo file:bar colarado").
 		Equal(t, test("content:replace(colarado -> colorodo) repo:foo file:bar"))
+
+	autogold.Want("convert aggregate to search query",
+		"repo:foo lodash@(\\d+)").
+		Equal(t, test("content:aggregate(lodash@(\\d+) -> $repo) repo:foo"))
 }
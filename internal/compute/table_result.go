@@ -0,0 +1,11 @@
+package compute
+
+type TableRow struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type Table struct {
+	Rows []TableRow `json:"rows"`
+	Kind string     `json:"kind"`
+}
@@ -0,0 +1,57 @@
+package reposource
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type AzureDevOps struct {
+	*schema.AzureDevOpsConnection
+}
+
+var _ RepoSource = AzureDevOps{}
+
+func (c AzureDevOps) CloneURLToRepoName(cloneURL string) (repoName api.RepoName, err error) {
+	parsedCloneURL, baseURL, match, err := parseURLs(cloneURL, c.Url)
+	if err != nil {
+		return "", err
+	}
+
+	var parts []string
+	switch {
+	case match:
+		// HTTP(S) clone URLs, and SSH clone URLs of Azure DevOps Server, have
+		// the form {baseURL}/org/project/_git/repo.
+		path := strings.TrimPrefix(parsedCloneURL.Path, strings.TrimSuffix(baseURL.Path, "/"))
+		parts = strings.Split(strings.Trim(path, "/"), "/")
+		if len(parts) != 4 || parts[2] != "_git" {
+			return "", nil
+		}
+		parts = []string{parts[0], parts[1], parts[3]}
+	case hostname(parsedCloneURL) == "ssh."+hostname(baseURL):
+		// SSH clone URLs of Azure DevOps Services have the form
+		// git@ssh.dev.azure.com:v3/org/project/repo.
+		parts = strings.Split(strings.Trim(parsedCloneURL.Path, "/"), "/")
+		if len(parts) != 4 || parts[0] != "v3" {
+			return "", nil
+		}
+		parts = parts[1:]
+	default:
+		return "", nil
+	}
+
+	return AzureDevOpsRepoName(c.RepositoryPathPattern, baseURL.Hostname(), strings.Join(parts, "/")), nil
+}
+
+func AzureDevOpsRepoName(repositoryPathPattern, host, nameWithOwner string) api.RepoName {
+	if repositoryPathPattern == "" {
+		repositoryPathPattern = "{host}/{nameWithOwner}"
+	}
+
+	return api.RepoName(strings.NewReplacer(
+		"{host}", host,
+		"{nameWithOwner}", nameWithOwner,
+	).Replace(repositoryPathPattern))
+}
@@ -0,0 +1,111 @@
+package reposource
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// GoModule is a Go module identified by its module path, such as
+// "golang.org/x/mod".
+type GoModule struct {
+	Path string
+}
+
+// PackageSyntax returns the module path, such as "golang.org/x/mod".
+func (m *GoModule) PackageSyntax() string {
+	return m.Path
+}
+
+func (m *GoModule) MatchesDependencyString(dependency string) bool {
+	return strings.HasPrefix(dependency, m.Path+"@")
+}
+
+func (m *GoModule) SortText() string {
+	return m.Path
+}
+
+func (m *GoModule) RepoName() api.RepoName {
+	return api.RepoName("go/" + m.Path)
+}
+
+func (m *GoModule) CloneURL() string {
+	cloneURL := url.URL{Path: string(m.RepoName())}
+	return cloneURL.String()
+}
+
+type GoDependency struct {
+	GoModule
+	Version string
+}
+
+// SortGoDependencies sorts the dependencies by the semantic version in
+// descending order. The latest version of a dependency becomes the first
+// element of the slice.
+func SortGoDependencies(dependencies []GoDependency) {
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].GoModule == dependencies[j].GoModule {
+			return semver.Compare(dependencies[i].Version, dependencies[j].Version) > 0
+		}
+		return dependencies[i].GoModule.SortText() > dependencies[j].GoModule.SortText()
+	})
+}
+
+// PackageManagerSyntax returns the dependency in the "path@version" syntax
+// used by the go command, such as "golang.org/x/mod@v0.5.1".
+func (d GoDependency) PackageManagerSyntax() string {
+	return fmt.Sprintf("%s@%s", d.Path, d.Version)
+}
+
+// GitTagFromVersion returns the version as is, since Go module versions
+// already carry the "v" prefix of git tags.
+func (d GoDependency) GitTagFromVersion() string {
+	return d.Version
+}
+
+// ParseGoDependency parses a dependency string in the "path@version" syntax
+// used by the go command into a GoDependency.
+func ParseGoDependency(dependency string) (GoDependency, error) {
+	i := strings.LastIndex(dependency, "@")
+	if i <= 0 {
+		return GoDependency{}, fmt.Errorf("dependency %q must be of the form path@version", dependency)
+	}
+
+	mod, err := ParseGoModule(dependency[:i])
+	if err != nil {
+		return GoDependency{}, err
+	}
+
+	version := dependency[i+1:]
+	if !semver.IsValid(version) {
+		return GoDependency{}, fmt.Errorf("invalid version %q of Go module %s", version, mod.Path)
+	}
+
+	return GoDependency{
+		GoModule: mod,
+		Version:  version,
+	}, nil
+}
+
+// ParseGoModule validates the given module path and returns it as a GoModule.
+func ParseGoModule(path string) (GoModule, error) {
+	if err := module.CheckPath(path); err != nil {
+		return GoModule{}, err
+	}
+	return GoModule{Path: path}, nil
+}
+
+// ParseGoModuleFromRepoURL returns a parsed Go module from the provided URL
+// path, without a leading `/`. The path is of the form "go/<module path>".
+func ParseGoModuleFromRepoURL(urlPath string) (GoModule, error) {
+	if !strings.HasPrefix(urlPath, "go/") {
+		return GoModule{}, fmt.Errorf("failed to parse a Go module from the path %s", urlPath)
+	}
+	return ParseGoModule(strings.TrimPrefix(urlPath, "go/"))
+}
@@ -0,0 +1,67 @@
+package reposource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestParseGoDependency(t *testing.T) {
+	dependency, err := ParseGoDependency("golang.org/x/mod@v0.5.1")
+	assert.Nil(t, err)
+	assert.Equal(t, GoModule{Path: "golang.org/x/mod"}, dependency.GoModule)
+	assert.Equal(t, "v0.5.1", dependency.Version)
+	assert.Equal(t, "v0.5.1", dependency.GitTagFromVersion())
+	assert.Equal(t, "golang.org/x/mod@v0.5.1", dependency.PackageManagerSyntax())
+	assert.Equal(t, api.RepoName("go/golang.org/x/mod"), dependency.RepoName())
+
+	dependency, err = ParseGoDependency("github.com/sourcegraph/sourcegraph@v0.0.0-20211117231018-1b9f5d4e2c66")
+	assert.Nil(t, err)
+	assert.Equal(t, "v0.0.0-20211117231018-1b9f5d4e2c66", dependency.Version)
+
+	for _, invalid := range []string{"golang.org/x/mod", "golang.org/x/mod@", "golang.org/x/mod@0.5.1", "@v1.0.0", "-bad@v1.0.0"} {
+		if _, err := ParseGoDependency(invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestParseGoModuleFromRepoURL(t *testing.T) {
+	mod, err := ParseGoModuleFromRepoURL("go/golang.org/x/mod")
+	assert.Nil(t, err)
+	assert.Equal(t, "golang.org/x/mod", mod.Path)
+
+	for _, invalid := range []string{"npm/react", "go/", "go/golang.org/x//mod"} {
+		if _, err := ParseGoModuleFromRepoURL(invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestSortGoDependencies(t *testing.T) {
+	parse := func(s string) GoDependency {
+		dependency, err := ParseGoDependency(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dependency
+	}
+	dependencies := []GoDependency{
+		parse("a.com/a@v1.2.0"),
+		parse("b.com/b@v1.2.0"),
+		parse("b.com/b@v1.11.0"),
+		parse("b.com/b@v1.2.0-rc.1"),
+		parse("b.com/b@v1.1.0"),
+	}
+	expected := []GoDependency{
+		parse("b.com/b@v1.11.0"),
+		parse("b.com/b@v1.2.0"),
+		parse("b.com/b@v1.2.0-rc.1"),
+		parse("b.com/b@v1.1.0"),
+		parse("a.com/a@v1.2.0"),
+	}
+	SortGoDependencies(dependencies)
+	assert.Equal(t, expected, dependencies)
+}
@@ -0,0 +1,131 @@
+package reposource
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// NPMPackage is an npm package, optionally belonging to a scope such as
+// "@types".
+type NPMPackage struct {
+	// Scope is the scope of the package without the leading "@", or an empty
+	// string for unscoped packages.
+	Scope string
+	Name  string
+}
+
+// PackageSyntax returns the name of the package as it appears in a
+// package.json file, such as "@types/node" or "react".
+func (p *NPMPackage) PackageSyntax() string {
+	if p.Scope != "" {
+		return fmt.Sprintf("@%s/%s", p.Scope, p.Name)
+	}
+	return p.Name
+}
+
+func (p *NPMPackage) MatchesDependencyString(dependency string) bool {
+	return strings.HasPrefix(dependency, p.PackageSyntax()+"@")
+}
+
+func (p *NPMPackage) SortText() string {
+	return p.PackageSyntax()
+}
+
+func (p *NPMPackage) RepoName() api.RepoName {
+	if p.Scope != "" {
+		return api.RepoName(fmt.Sprintf("npm/%s/%s", p.Scope, p.Name))
+	}
+	return api.RepoName("npm/" + p.Name)
+}
+
+func (p *NPMPackage) CloneURL() string {
+	cloneURL := url.URL{Path: string(p.RepoName())}
+	return cloneURL.String()
+}
+
+type NPMDependency struct {
+	NPMPackage
+	Version string
+}
+
+// SortNPMDependencies sorts the dependencies by the semantic version in
+// descending order. The latest version of a dependency becomes the first
+// element of the slice.
+func SortNPMDependencies(dependencies []NPMDependency) {
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].NPMPackage == dependencies[j].NPMPackage {
+			return versionGreaterThan(dependencies[i].Version, dependencies[j].Version)
+		}
+		return dependencies[i].NPMPackage.SortText() > dependencies[j].NPMPackage.SortText()
+	})
+}
+
+// PackageManagerSyntax returns the dependency in the "name@version" syntax
+// used by the npm command-line tool, such as "@types/node@16.11.0".
+func (d NPMDependency) PackageManagerSyntax() string {
+	return fmt.Sprintf("%s@%s", d.NPMPackage.PackageSyntax(), d.Version)
+}
+
+func (d NPMDependency) GitTagFromVersion() string {
+	return "v" + d.Version
+}
+
+// ParseNPMDependency parses a dependency string in the npm format
+// ("(@scope/)?name@version") into an NPMDependency.
+func ParseNPMDependency(dependency string) (NPMDependency, error) {
+	// The version follows the last "@", since the "@" of a scope always comes
+	// first.
+	i := strings.LastIndex(dependency, "@")
+	if i <= 0 || i == len(dependency)-1 {
+		return NPMDependency{}, fmt.Errorf("dependency %q must be of the form (@scope/)?name@version", dependency)
+	}
+
+	pkg, err := ParseNPMPackageFromPackageSyntax(dependency[:i])
+	if err != nil {
+		return NPMDependency{}, err
+	}
+
+	return NPMDependency{
+		NPMPackage: pkg,
+		Version:    dependency[i+1:],
+	}, nil
+}
+
+// ParseNPMPackageFromPackageSyntax parses a package name as it appears in a
+// package.json file, such as "@types/node", into an NPMPackage.
+func ParseNPMPackageFromPackageSyntax(name string) (NPMPackage, error) {
+	if !strings.HasPrefix(name, "@") {
+		if name == "" || strings.Contains(name, "/") {
+			return NPMPackage{}, fmt.Errorf("invalid npm package name %q", name)
+		}
+		return NPMPackage{Name: name}, nil
+	}
+
+	parts := strings.Split(strings.TrimPrefix(name, "@"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return NPMPackage{}, fmt.Errorf("invalid scoped npm package name %q", name)
+	}
+	return NPMPackage{Scope: parts[0], Name: parts[1]}, nil
+}
+
+// ParseNPMPackageFromRepoURL returns a parsed npm package from the provided URL
+// path, without a leading `/`. The path is of the form "npm/scope/name" for
+// scoped packages and "npm/name" otherwise.
+func ParseNPMPackageFromRepoURL(urlPath string) (NPMPackage, error) {
+	if !strings.HasPrefix(urlPath, "npm/") {
+		return NPMPackage{}, fmt.Errorf("failed to parse an npm package from the path %s", urlPath)
+	}
+	parts := strings.Split(strings.TrimPrefix(urlPath, "npm/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return NPMPackage{Name: parts[0]}, nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return NPMPackage{Scope: parts[0], Name: parts[1]}, nil
+	default:
+		return NPMPackage{}, fmt.Errorf("failed to parse an npm package from the path %s", urlPath)
+	}
+}
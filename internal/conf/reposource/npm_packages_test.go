@@ -0,0 +1,72 @@
+package reposource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestParseNPMDependency(t *testing.T) {
+	dependency, err := ParseNPMDependency("@types/node@16.11.0")
+	assert.Nil(t, err)
+	assert.Equal(t, NPMPackage{Scope: "types", Name: "node"}, dependency.NPMPackage)
+	assert.Equal(t, "16.11.0", dependency.Version)
+	assert.Equal(t, "@types/node@16.11.0", dependency.PackageManagerSyntax())
+	assert.Equal(t, api.RepoName("npm/types/node"), dependency.RepoName())
+
+	dependency, err = ParseNPMDependency("react@17.0.2")
+	assert.Nil(t, err)
+	assert.Equal(t, NPMPackage{Name: "react"}, dependency.NPMPackage)
+	assert.Equal(t, "v17.0.2", dependency.GitTagFromVersion())
+	assert.Equal(t, api.RepoName("npm/react"), dependency.RepoName())
+
+	for _, invalid := range []string{"react", "react@", "@types/node", "@types@1.0.0", "a/b@1.0.0"} {
+		if _, err := ParseNPMDependency(invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestParseNPMPackageFromRepoURL(t *testing.T) {
+	pkg, err := ParseNPMPackageFromRepoURL("npm/types/node")
+	assert.Nil(t, err)
+	assert.Equal(t, "@types/node", pkg.PackageSyntax())
+
+	pkg, err = ParseNPMPackageFromRepoURL("npm/react")
+	assert.Nil(t, err)
+	assert.Equal(t, "react", pkg.PackageSyntax())
+
+	for _, invalid := range []string{"maven/org.example/example", "npm/", "npm/a/b/c"} {
+		if _, err := ParseNPMPackageFromRepoURL(invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestSortNPMDependencies(t *testing.T) {
+	parse := func(s string) NPMDependency {
+		dependency, err := ParseNPMDependency(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dependency
+	}
+	dependencies := []NPMDependency{
+		parse("a@1.2.0"),
+		parse("b@1.2.0"),
+		parse("b@1.11.0"),
+		parse("b@1.2.0-rc.1"),
+		parse("b@1.1.0"),
+	}
+	expected := []NPMDependency{
+		parse("b@1.11.0"),
+		parse("b@1.2.0"),
+		parse("b@1.2.0-rc.1"),
+		parse("b@1.1.0"),
+		parse("a@1.2.0"),
+	}
+	SortNPMDependencies(dependencies)
+	assert.Equal(t, expected, dependencies)
+}
@@ -34,6 +34,19 @@ type ExecutorStore interface {
 	// the Sourcegraph instance in at least the given duration.
 	DeleteInactiveHeartbeats(ctx context.Context, minAge time.Duration) error
 
+	// UpsertArtifact creates or replaces the artifact record of the given job with the same
+	// name as the given artifact.
+	UpsertArtifact(ctx context.Context, artifact types.ExecutorArtifact) error
+
+	// ListArtifacts returns the artifacts uploaded for the job with the given identifier in
+	// the given queue.
+	ListArtifacts(ctx context.Context, queueName string, jobID int) ([]types.ExecutorArtifact, error)
+
+	// DeleteExpiredArtifacts deletes the artifact records that were uploaded at least the given
+	// duration ago and returns the keys of their objects in the upload store. The caller is
+	// responsible for deleting the objects.
+	DeleteExpiredArtifacts(ctx context.Context, ttl time.Duration) (objectKeys []string, err error)
+
 	With(store basestore.ShareableStore) ExecutorStore
 	Transact(ctx context.Context) (ExecutorStore, error)
 	Done(err error) error
@@ -307,3 +320,99 @@ const executorStoreDeleteInactiveHeartbeatsQuery = `
 DELETE FROM executor_heartbeats
 WHERE %s - last_seen_at >= %s * interval '1 second'
 `
+
+func (s *executorStore) UpsertArtifact(ctx context.Context, artifact types.ExecutorArtifact) error {
+	return s.upsertArtifact(ctx, artifact, timeutil.Now())
+}
+
+func (s *executorStore) upsertArtifact(ctx context.Context, artifact types.ExecutorArtifact, now time.Time) error {
+	return s.Exec(ctx, sqlf.Sprintf(
+		executorStoreUpsertArtifactQuery,
+		artifact.QueueName,
+		artifact.JobID,
+		artifact.Name,
+		artifact.ObjectKey,
+		artifact.Size,
+		now,
+	))
+}
+
+const executorStoreUpsertArtifactQuery = `
+-- source: internal/database/executors.go:UpsertArtifact
+INSERT INTO executor_job_artifacts (
+	queue_name,
+	job_id,
+	name,
+	object_key,
+	size,
+	created_at
+)
+VALUES (%s, %s, %s, %s, %s, %s)
+ON CONFLICT (queue_name, job_id, name) DO UPDATE
+SET
+	object_key = EXCLUDED.object_key,
+	size = EXCLUDED.size,
+	created_at = EXCLUDED.created_at
+`
+
+func (s *executorStore) ListArtifacts(ctx context.Context, queueName string, jobID int) ([]types.ExecutorArtifact, error) {
+	return scanExecutorArtifacts(s.Query(ctx, sqlf.Sprintf(executorStoreListArtifactsQuery, queueName, jobID)))
+}
+
+const executorStoreListArtifactsQuery = `
+-- source: internal/database/executors.go:ListArtifacts
+SELECT
+	a.id,
+	a.queue_name,
+	a.job_id,
+	a.name,
+	a.object_key,
+	a.size,
+	a.created_at
+FROM executor_job_artifacts a
+WHERE a.queue_name = %s AND a.job_id = %s
+ORDER BY a.name
+`
+
+// scanExecutorArtifacts reads executor artifact objects from the given row object.
+func scanExecutorArtifacts(rows *sql.Rows, queryErr error) (_ []types.ExecutorArtifact, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var artifacts []types.ExecutorArtifact
+	for rows.Next() {
+		var artifact types.ExecutorArtifact
+		if err := rows.Scan(
+			&artifact.ID,
+			&artifact.QueueName,
+			&artifact.JobID,
+			&artifact.Name,
+			&artifact.ObjectKey,
+			&artifact.Size,
+			&artifact.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		artifacts = append(artifacts, artifact)
+	}
+
+	return artifacts, nil
+}
+
+func (s *executorStore) DeleteExpiredArtifacts(ctx context.Context, ttl time.Duration) ([]string, error) {
+	return s.deleteExpiredArtifacts(ctx, ttl, timeutil.Now())
+}
+
+func (s *executorStore) deleteExpiredArtifacts(ctx context.Context, ttl time.Duration, now time.Time) ([]string, error) {
+	return basestore.ScanStrings(s.Query(ctx, sqlf.Sprintf(executorStoreDeleteExpiredArtifactsQuery, now, ttl/time.Second)))
+}
+
+const executorStoreDeleteExpiredArtifactsQuery = `
+-- source: internal/database/executors.go:DeleteExpiredArtifacts
+DELETE FROM executor_job_artifacts
+WHERE %s - created_at >= %s * interval '1 second'
+RETURNING object_key
+`
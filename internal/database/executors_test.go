@@ -226,3 +226,58 @@ func TestExecutorsDeleteInactiveHeartbeats(t *testing.T) {
 		t.Fatalf("unexpected total count. want=%d have=%d", 5, totalCount)
 	}
 }
+
+func TestExecutorsArtifacts(t *testing.T) {
+	db := dbtest.NewDB(t)
+	store := executors(db)
+	ctx := context.Background()
+
+	now := time.Unix(1587396557, 0).UTC()
+	t1 := now.Add(-time.Hour * 1)   // fresh
+	t2 := now.Add(-time.Hour * 200) // expired
+
+	artifacts := []struct {
+		artifact types.ExecutorArtifact
+		uploaded time.Time
+	}{
+		{types.ExecutorArtifact{QueueName: "codeintel", JobID: 1, Name: "report.xml", ObjectKey: "k1", Size: 10}, t1},
+		{types.ExecutorArtifact{QueueName: "codeintel", JobID: 1, Name: "dump.lsif", ObjectKey: "k2", Size: 20}, t2},
+		{types.ExecutorArtifact{QueueName: "batches", JobID: 1, Name: "report.xml", ObjectKey: "k3", Size: 30}, t1},
+		// replaces the first artifact
+		{types.ExecutorArtifact{QueueName: "codeintel", JobID: 1, Name: "report.xml", ObjectKey: "k4", Size: 40}, t1},
+	}
+	for _, a := range artifacts {
+		if err := store.upsertArtifact(ctx, a.artifact, a.uploaded); err != nil {
+			t.Fatalf("unexpected error upserting artifact: %s", err)
+		}
+	}
+
+	listNames := func() []string {
+		artifacts, err := store.ListArtifacts(ctx, "codeintel", 1)
+		if err != nil {
+			t.Fatalf("unexpected error listing artifacts: %s", err)
+		}
+
+		names := make([]string, 0, len(artifacts))
+		for _, artifact := range artifacts {
+			names = append(names, fmt.Sprintf("%s:%s:%d", artifact.Name, artifact.ObjectKey, artifact.Size))
+		}
+		return names
+	}
+
+	if diff := cmp.Diff([]string{"dump.lsif:k2:20", "report.xml:k4:40"}, listNames()); diff != "" {
+		t.Errorf("unexpected artifacts (-want +got):\n%s", diff)
+	}
+
+	objectKeys, err := store.deleteExpiredArtifacts(ctx, time.Hour*168, now)
+	if err != nil {
+		t.Fatalf("unexpected error deleting expired artifacts: %s", err)
+	}
+	if diff := cmp.Diff([]string{"k2"}, objectKeys); diff != "" {
+		t.Errorf("unexpected object keys (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff([]string{"report.xml:k4:40"}, listNames()); diff != "" {
+		t.Errorf("unexpected artifacts (-want +got):\n%s", diff)
+	}
+}
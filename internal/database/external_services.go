@@ -26,6 +26,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/encryption"
 	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/jsonc"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
@@ -230,12 +231,16 @@ func (e *externalServiceStore) Done(err error) error {
 // external services.
 var ExternalServiceKinds = map[string]ExternalServiceKind{
 	extsvc.KindAWSCodeCommit:   {CodeHost: true, JSONSchema: schema.AWSCodeCommitSchemaJSON},
+	extsvc.KindAzureDevOps:     {CodeHost: true, JSONSchema: schema.AzureDevOpsSchemaJSON},
 	extsvc.KindBitbucketCloud:  {CodeHost: true, JSONSchema: schema.BitbucketCloudSchemaJSON},
 	extsvc.KindBitbucketServer: {CodeHost: true, JSONSchema: schema.BitbucketServerSchemaJSON},
+	extsvc.KindGitea:           {CodeHost: true, JSONSchema: schema.GiteaSchemaJSON},
 	extsvc.KindGitHub:          {CodeHost: true, JSONSchema: schema.GitHubSchemaJSON},
 	extsvc.KindGitLab:          {CodeHost: true, JSONSchema: schema.GitLabSchemaJSON},
 	extsvc.KindGitolite:        {CodeHost: true, JSONSchema: schema.GitoliteSchemaJSON},
+	extsvc.KindGoModules:       {CodeHost: true, JSONSchema: schema.GoModulesSchemaJSON},
 	extsvc.KindJVMPackages:     {CodeHost: true, JSONSchema: schema.JVMPackagesSchemaJSON},
+	extsvc.KindNPMPackages:     {CodeHost: true, JSONSchema: schema.NPMPackagesSchemaJSON},
 	extsvc.KindOther:           {CodeHost: true, JSONSchema: schema.OtherExternalServiceSchemaJSON},
 	extsvc.KindPagure:          {CodeHost: true, JSONSchema: schema.PagureSchemaJSON},
 	extsvc.KindPerforce:        {CodeHost: true, JSONSchema: schema.PerforceSchemaJSON},
@@ -958,6 +963,14 @@ func (e *externalServiceStore) Update(ctx context.Context, ps []schema.AuthProvi
 		return Mocks.ExternalServices.Update(ctx, ps, id, update)
 	}
 
+	defer func() {
+		if err == nil {
+			// Authenticators shared by the clients of the external service must
+			// not outlive its configuration.
+			github.EvictConnectionAuthenticator(id)
+		}
+	}()
+
 	var (
 		normalized  []byte
 		keyID       string
@@ -1079,6 +1092,14 @@ func (e *externalServiceStore) Delete(ctx context.Context, id int64) (err error)
 		return Mocks.ExternalServices.Delete(ctx, id)
 	}
 
+	defer func() {
+		if err == nil {
+			// Authenticators shared by the clients of the external service must
+			// not outlive its configuration.
+			github.EvictConnectionAuthenticator(id)
+		}
+	}()
+
 	tx, err := e.transact(ctx)
 	if err != nil {
 		return err
@@ -1478,6 +1499,8 @@ func configurationHasWebhooks(config interface{}) bool {
 		return len(v.Webhooks) > 0
 	case *schema.BitbucketServerConnection:
 		return v.WebhookSecret() != ""
+	case *schema.BitbucketCloudConnection:
+		return v.WebhookSecret != ""
 	}
 
 	return false
@@ -28,12 +28,16 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitolite"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodules"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/jvmpackages"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/npmpackages"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/perforce"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/phabricator"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
@@ -480,10 +484,18 @@ func scanRepo(rows *sql.Rows, r *types.Repo) (err error) {
 		r.Metadata = new(phabricator.Repo)
 	case extsvc.TypePagure:
 		r.Metadata = new(pagure.Project)
+	case extsvc.TypeGitea:
+		r.Metadata = new(gitea.Repository)
+	case extsvc.TypeAzureDevOps:
+		r.Metadata = new(azuredevops.Repository)
 	case extsvc.TypeOther:
 		r.Metadata = new(extsvc.OtherRepoMetadata)
 	case extsvc.TypeJVMPackages:
 		r.Metadata = new(jvmpackages.Metadata)
+	case extsvc.TypeNPMPackages:
+		r.Metadata = new(npmpackages.Metadata)
+	case extsvc.TypeGoModules:
+		r.Metadata = new(gomodules.Metadata)
 	default:
 		log15.Warn("scanRepo - unknown service type", "typ", typ)
 		return nil
@@ -584,6 +596,16 @@ type ReposListOptions struct {
 	// OnlyPrivate excludes non-private repositories from the list.
 	OnlyPrivate bool
 
+	// Topics is a list of code host topics, all of which a repository must be
+	// tagged with to be returned in the list. Topics are compared case
+	// insensitively.
+	Topics []string
+
+	// DescriptionPatterns is a list of case insensitive regular expressions,
+	// all of which must match the description of all repositories returned in
+	// the list.
+	DescriptionPatterns []string
+
 	// Index when set will only include repositories which should be indexed
 	// if true. If false it will exclude repositories which should be
 	// indexed. An example use case of this is for indexed search only
@@ -814,6 +836,18 @@ func (s *repoStore) list(ctx context.Context, tr *trace.Trace, opt ReposListOpti
 	return rows.Err()
 }
 
+// repoHasTopicCondFmtstr matches repositories tagged with a topic on the code
+// host. GitHub stores topics as RepositoryTopics connection nodes and GitLab as
+// a list of strings in the repository metadata.
+const repoHasTopicCondFmtstr = `
+%s IN (
+	SELECT lower(t.node->'Topic'->>'Name')
+	FROM jsonb_array_elements(CASE jsonb_typeof(repo.metadata->'RepositoryTopics'->'Nodes') WHEN 'array' THEN repo.metadata->'RepositoryTopics'->'Nodes' ELSE '[]' END) AS t(node)
+	UNION ALL
+	SELECT lower(t.name)
+	FROM jsonb_array_elements_text(CASE jsonb_typeof(repo.metadata->'topics') WHEN 'array' THEN repo.metadata->'topics' ELSE '[]' END) AS t(name)
+)`
+
 func (s *repoStore) listSQL(ctx context.Context, opt ReposListOptions) (*sqlf.Query, error) {
 	var ctes, joins, where []*sqlf.Query
 
@@ -917,6 +951,12 @@ func (s *repoStore) listSQL(ctx context.Context, opt ReposListOptions) (*sqlf.Qu
 	if opt.OnlyPrivate {
 		where = append(where, sqlf.Sprintf("private"))
 	}
+	for _, topic := range opt.Topics {
+		where = append(where, sqlf.Sprintf(repoHasTopicCondFmtstr, strings.ToLower(topic)))
+	}
+	for _, pattern := range opt.DescriptionPatterns {
+		where = append(where, sqlf.Sprintf("repo.description ~* %s", pattern))
+	}
 
 	if len(opt.Names) > 0 {
 		lowerNames := make([]string, len(opt.Names))
@@ -22,6 +22,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/types/typestest"
 )
@@ -1249,6 +1250,68 @@ func TestRepos_List_externalServiceID(t *testing.T) {
 	}
 }
 
+func TestRepos_List_topicsAndDescription(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	db := dbtest.NewDB(t)
+	ctx := actor.WithInternalActor(context.Background())
+
+	confGet := func() *conf.Unified {
+		return &conf.Unified{}
+	}
+
+	services := typestest.MakeExternalServices()
+	service1 := services[0]
+	service2 := services[1]
+	if err := ExternalServices(db).Create(ctx, confGet, service1); err != nil {
+		t.Fatal(err)
+	}
+	if err := ExternalServices(db).Create(ctx, confGet, service2); err != nil {
+		t.Fatal(err)
+	}
+
+	githubRepo := typestest.MakeGithubRepo(service1)
+	githubRepo.Description = "The payments API"
+	githubRepo.Metadata = &github.Repository{
+		RepositoryTopics: &github.RepositoryTopics{Nodes: []github.RepositoryTopic{
+			{Topic: github.Topic{Name: "payments"}},
+			{Topic: github.Topic{Name: "go"}},
+		}},
+	}
+	gitlabRepo := typestest.MakeGitlabRepo(service2)
+	gitlabRepo.Description = "Billing service"
+	gitlabRepo.Metadata = &gitlab.Project{Topics: []string{"Payments"}}
+
+	if err := Repos(db).Create(ctx, githubRepo, gitlabRepo); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		opt  ReposListOptions
+		want []*types.Repo
+	}{
+		{"topic on both code hosts", ReposListOptions{Topics: []string{"payments"}}, types.Repos{githubRepo, gitlabRepo}},
+		{"all topics must match", ReposListOptions{Topics: []string{"payments", "go"}}, types.Repos{githubRepo}},
+		{"unknown topic", ReposListOptions{Topics: []string{"frontend"}}, nil},
+		{"description", ReposListOptions{DescriptionPatterns: []string{"^billing"}}, types.Repos{gitlabRepo}},
+		{"topic and description", ReposListOptions{Topics: []string{"payments"}, DescriptionPatterns: []string{"api"}}, types.Repos{githubRepo}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repos, err := Repos(db).List(ctx, test.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertJSONEqual(t, test.want, repos)
+		})
+	}
+}
+
 func TestRepos_ListMinimalRepos(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
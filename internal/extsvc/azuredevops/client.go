@@ -0,0 +1,370 @@
+//nolint:bodyclose // Body is closed in Client.do, but the response is still returned to provide access to the headers
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	// apiVersion is the version of the REST API used by the client. 6.0 is
+	// supported by Azure DevOps Services and Azure DevOps Server 2020 and later.
+	apiVersion = "6.0"
+
+	defaultRateLimit      = rate.Limit(5) // 300/min or 18,000/hr
+	defaultRateLimitBurst = 500
+
+	// continuationTokenHeader is the response header that contains the token
+	// of the next page of paginated list calls.
+	continuationTokenHeader = "X-Ms-Continuationtoken"
+
+	// DefaultProjectsPageSize is the number of projects requested per page.
+	DefaultProjectsPageSize = 100
+)
+
+// Client access Azure DevOps Services or Azure DevOps Server via the REST API.
+type Client struct {
+	// HTTP Client used to communicate with the API
+	httpClient httpcli.Doer
+
+	// URL is the base URL of Azure DevOps, such as https://dev.azure.com/. The
+	// organization (or collection) is the first component of the path of API
+	// requests.
+	URL *url.URL
+
+	// The username and personal access token used to authenticate requests.
+	// Azure DevOps ignores the username of requests authenticated with a
+	// personal access token.
+	Username, Token string
+
+	// RateLimit is the self-imposed rate limiter. Azure DevOps throttles
+	// requests based on their cost, which isn't known in advance.
+	RateLimit *rate.Limiter
+}
+
+// NewClient returns an authenticated Azure DevOps API client with the provided
+// configuration. If a nil httpClient is provided, httpcli.ExternalDoer will be
+// used.
+func NewClient(config *schema.AzureDevOpsConnection, httpClient httpcli.Doer) (*Client, error) {
+	u, err := url.Parse(config.Url)
+	if err != nil {
+		return nil, err
+	}
+	// Ensure API paths are resolved relative to the full base URL, which
+	// includes a path for some Azure DevOps Server instances.
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+
+	// Normally our registry will return a default infinite limiter when nothing has been
+	// synced from config. However, we always want to ensure there is at least some form of rate
+	// limiting for Azure DevOps.
+	defaultLimiter := rate.NewLimiter(defaultRateLimit, defaultRateLimitBurst)
+	l := ratelimit.DefaultRegistry.GetOrSet(u.String(), defaultLimiter)
+
+	return &Client{
+		httpClient: httpClient,
+		URL:        u,
+		Username:   config.Username,
+		Token:      config.Token,
+		RateLimit:  l,
+	}, nil
+}
+
+// WithAuthenticator returns a new Client that uses the same configuration as
+// the current Client, except authenticated with the given authenticator. Only
+// basic authentication with a personal access token as the password is
+// supported by Azure DevOps.
+func (c *Client) WithAuthenticator(a auth.Authenticator) (*Client, error) {
+	var username, token string
+	switch a := a.(type) {
+	case *auth.BasicAuth:
+		username, token = a.Username, a.Password
+	case *auth.BasicAuthWithSSH:
+		username, token = a.Username, a.Password
+	default:
+		return nil, errors.Errorf("authenticator type unsupported for Azure DevOps clients: %T", a)
+	}
+
+	return &Client{
+		httpClient: c.httpClient,
+		URL:        c.URL,
+		Username:   username,
+		Token:      token,
+		RateLimit:  c.RateLimit,
+	}, nil
+}
+
+// Project is an Azure DevOps project. Projects belong to an organization and
+// contain Git repositories.
+type Project struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url,omitempty"`
+	State       string `json:"state,omitempty"`
+	Visibility  string `json:"visibility,omitempty"`
+}
+
+// Repository is a Git repository in an Azure DevOps project.
+type Repository struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	URL           string  `json:"url"`
+	Project       Project `json:"project"`
+	DefaultBranch string  `json:"defaultBranch,omitempty"`
+	Size          int64   `json:"size,omitempty"`
+	RemoteURL     string  `json:"remoteUrl"`
+	SSHURL        string  `json:"sshUrl"`
+	WebURL        string  `json:"webUrl"`
+	IsDisabled    bool    `json:"isDisabled,omitempty"`
+	IsFork        bool    `json:"isFork,omitempty"`
+
+	// Org is the organization (or collection, on Azure DevOps Server) of the
+	// repository. It is not part of the API response, and set by the client.
+	Org string `json:"org"`
+}
+
+// NameWithOwner returns the "org/project/repo" path of the repository.
+func (r *Repository) NameWithOwner() string {
+	return r.Org + "/" + r.Project.Name + "/" + r.Name
+}
+
+// Private reports whether the repository is in a private project.
+func (r *Repository) Private() bool {
+	return r.Project.Visibility != "public"
+}
+
+// ListProjects lists the projects of the given organization. The continuation
+// token of the next page is returned, or an empty string if this was the last
+// page. Pass an empty token to request the first page.
+func (c *Client) ListProjects(ctx context.Context, org, continuationToken string) ([]*Project, string, error) {
+	qs := make(url.Values)
+	qs.Set("$top", strconv.Itoa(DefaultProjectsPageSize))
+	if continuationToken != "" {
+		qs.Set("continuationToken", continuationToken)
+	}
+
+	req, err := c.newRequest("GET", []string{org, "_apis", "projects"}, qs, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var resp struct {
+		Value []*Project `json:"value"`
+	}
+	res, err := c.do(ctx, req, &resp)
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Value, res.Header.Get(continuationTokenHeader), nil
+}
+
+// ListRepositories lists the Git repositories of the given project. The API
+// does not paginate repositories.
+func (c *Client) ListRepositories(ctx context.Context, org, project string) ([]*Repository, error) {
+	req, err := c.newRequest("GET", []string{org, project, "_apis", "git", "repositories"}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Value []*Repository `json:"value"`
+	}
+	if _, err := c.do(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+	for _, r := range resp.Value {
+		r.Org = org
+	}
+	return resp.Value, nil
+}
+
+// GetRepository returns the repository with the given name or ID in the given
+// project.
+func (c *Client) GetRepository(ctx context.Context, org, project, nameOrID string) (*Repository, error) {
+	req, err := c.newRequest("GET", []string{org, project, "_apis", "git", "repositories", nameOrID}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var repo Repository
+	if _, err := c.do(ctx, req, &repo); err != nil {
+		return nil, err
+	}
+	repo.Org = org
+	return &repo, nil
+}
+
+// Identity is an Azure DevOps user or group.
+type Identity struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName,omitempty"`
+	UniqueName  string `json:"uniqueName,omitempty"`
+	ImageURL    string `json:"imageUrl,omitempty"`
+}
+
+// AuthenticatedUser returns the user the client is authenticated as in the
+// given organization.
+func (c *Client) AuthenticatedUser(ctx context.Context, org string) (*Identity, error) {
+	req, err := c.newRequest("GET", []string{org, "_apis", "connectionData"}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		AuthenticatedUser struct {
+			ID                  string `json:"id"`
+			ProviderDisplayName string `json:"providerDisplayName"`
+		} `json:"authenticatedUser"`
+	}
+	if _, err := c.do(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+	return &Identity{
+		ID:          resp.AuthenticatedUser.ID,
+		DisplayName: resp.AuthenticatedUser.ProviderDisplayName,
+	}, nil
+}
+
+// newRequest returns a request for the API endpoint made up of the given path
+// components, which are escaped. If body is not nil, it's encoded as JSON.
+func (c *Client) newRequest(method string, path []string, qs url.Values, body interface{}) (*http.Request, error) {
+	if qs == nil {
+		qs = make(url.Values)
+	}
+	qs.Set("api-version", apiVersion)
+
+	escaped := make([]string, len(path))
+	for i, p := range path {
+		escaped[i] = url.PathEscape(p)
+	}
+	u := &url.URL{RawPath: strings.Join(escaped, "/"), RawQuery: qs.Encode()}
+	u.Path, _ = url.PathUnescape(u.RawPath)
+
+	var r io.Reader
+	if body != nil {
+		bs, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(bs)
+	}
+
+	req, err := http.NewRequest(method, u.String(), r)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+func (c *Client) do(ctx context.Context, req *http.Request, result interface{}) (*http.Response, error) {
+	req.URL = c.URL.ResolveReference(req.URL)
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(c.Username, c.Token)
+
+	startWait := time.Now()
+	if err := c.RateLimit.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	if d := time.Since(startWait); d > 200*time.Millisecond {
+		log15.Warn("Azure DevOps self-enforced API rate limit: request delayed longer than expected due to rate limit", "delay", d)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Azure DevOps redirects unauthenticated requests to a sign-in page
+	// instead of returning a 401.
+	if resp.StatusCode == http.StatusNonAuthoritativeInfo {
+		resp.StatusCode = http.StatusUnauthorized
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return nil, errors.WithStack(&httpError{
+			URL:        req.URL,
+			StatusCode: resp.StatusCode,
+			Body:       bs,
+		})
+	}
+
+	if result != nil {
+		return resp, json.Unmarshal(bs, result)
+	}
+	return resp, nil
+}
+
+type httpError struct {
+	StatusCode int
+	URL        *url.URL
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("Azure DevOps API HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *httpError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// typeKey returns the typeKey of the error in the response body, which
+// identifies the kind of error, e.g. "GitPullRequestExistsException".
+func (e *httpError) typeKey() string {
+	var body struct {
+		TypeKey string `json:"typeKey"`
+	}
+	_ = json.Unmarshal(e.Body, &body)
+	return body.TypeKey
+}
+
+// IsUnauthorized reports whether err is an Azure DevOps API HTTP 401 error.
+func IsUnauthorized(err error) bool {
+	var e *httpError
+	return errors.As(err, &e) && e.Unauthorized()
+}
+
+// IsNotFound reports whether err is an Azure DevOps API HTTP 404 error.
+func IsNotFound(err error) bool {
+	var e *httpError
+	return errors.As(err, &e) && e.NotFound()
+}
@@ -0,0 +1,126 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(&schema.AzureDevOpsConnection{Url: srv.URL + "/tfs", Token: "secret"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func TestClient_ListProjects(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if have, want := r.URL.Path, "/tfs/myorg/_apis/projects"; have != want {
+			t.Errorf("unexpected path: have %q, want %q", have, want)
+		}
+		if have, want := r.URL.Query().Get("api-version"), apiVersion; have != want {
+			t.Errorf("unexpected api version: have %q, want %q", have, want)
+		}
+		if _, password, _ := r.BasicAuth(); password != "secret" {
+			t.Errorf("unexpected password: %q", password)
+		}
+		switch r.URL.Query().Get("continuationToken") {
+		case "":
+			w.Header().Set("X-MS-ContinuationToken", "next")
+			fmt.Fprint(w, `{"count":1,"value":[{"id":"1","name":"first"}]}`)
+		case "next":
+			fmt.Fprint(w, `{"count":1,"value":[{"id":"2","name":"second"}]}`)
+		}
+	})
+
+	var names []string
+	token := ""
+	for {
+		projects, next, err := cli.ListProjects(context.Background(), "myorg", token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range projects {
+			names = append(names, p.Name)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+
+	if diff := cmp.Diff([]string{"first", "second"}, names); diff != "" {
+		t.Fatalf("unexpected projects (-want +got):\n%s", diff)
+	}
+}
+
+func TestClient_ListRepositories(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if have, want := r.URL.EscapedPath(), "/tfs/myorg/My%20Project/_apis/git/repositories"; have != want {
+			t.Errorf("unexpected path: have %q, want %q", have, want)
+		}
+		fmt.Fprint(w, `{"count":1,"value":[{"id":"abc","name":"repo","project":{"id":"1","name":"My Project"}}]}`)
+	})
+
+	repos, err := cli.ListRepositories(context.Background(), "myorg", "My Project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 {
+		t.Fatalf("unexpected repos: %+v", repos)
+	}
+	if have, want := repos[0].NameWithOwner(), "myorg/My Project/repo"; have != want {
+		t.Errorf("unexpected name: have %q, want %q", have, want)
+	}
+}
+
+func TestClient_Unauthorized(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// Azure DevOps answers requests with invalid tokens with a sign-in page.
+		w.WriteHeader(http.StatusNonAuthoritativeInfo)
+		fmt.Fprint(w, `<html></html>`)
+	})
+
+	_, err := cli.AuthenticatedUser(context.Background(), "myorg")
+	if !IsUnauthorized(err) {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestClient_WithAuthenticator(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if username, password, _ := r.BasicAuth(); username != "user" || password != "other" {
+			t.Errorf("unexpected credentials: %q:%q", username, password)
+		}
+		fmt.Fprint(w, `{"authenticatedUser":{"id":"u1","providerDisplayName":"User"}}`)
+	})
+
+	if _, err := cli.WithAuthenticator(&auth.OAuthBearerToken{Token: "token"}); err == nil {
+		t.Fatal("expected error for unsupported authenticator")
+	}
+
+	other, err := cli.WithAuthenticator(&auth.BasicAuth{Username: "user", Password: "other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := other.AuthenticatedUser(context.Background(), "myorg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != "u1" || user.DisplayName != "User" {
+		t.Fatalf("unexpected user: %+v", user)
+	}
+}
@@ -0,0 +1,318 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrPullRequestAlreadyExists is returned by CreatePullRequest when an active
+// pull request for the same source and target branches already exists.
+var ErrPullRequestAlreadyExists = errors.New("pull request already exists")
+
+// ErrNotMergeable is returned by CompletePullRequest when Azure DevOps refuses
+// to complete the pull request in its current state, e.g. because of merge
+// conflicts or branch policies.
+var ErrNotMergeable = errors.New("pull request cannot be completed")
+
+// PullRequestState is the status of a pull request. Azure DevOps calls merged
+// pull requests "completed" and closed pull requests "abandoned".
+type PullRequestState string
+
+const (
+	PullRequestStateActive    PullRequestState = "active"
+	PullRequestStateAbandoned PullRequestState = "abandoned"
+	PullRequestStateCompleted PullRequestState = "completed"
+)
+
+// MergeStrategy is the strategy used to complete a pull request.
+type MergeStrategy string
+
+const (
+	MergeStrategyNoFastForward MergeStrategy = "noFastForward"
+	MergeStrategySquash        MergeStrategy = "squash"
+)
+
+// Reviewer votes, as returned by the API.
+const (
+	VoteApproved                = 10
+	VoteApprovedWithSuggestions = 5
+	VoteNone                    = 0
+	VoteWaitingForAuthor        = -5
+	VoteRejected                = -10
+)
+
+// PullRequest is an Azure DevOps pull request.
+type PullRequest struct {
+	ID                    int64            `json:"pullRequestId"`
+	Repository            Repository       `json:"repository"`
+	Status                PullRequestState `json:"status"`
+	CreatedBy             Identity         `json:"createdBy"`
+	CreationDate          time.Time        `json:"creationDate"`
+	ClosedDate            time.Time        `json:"closedDate"`
+	Title                 string           `json:"title"`
+	Description           string           `json:"description"`
+	SourceRefName         string           `json:"sourceRefName"`
+	TargetRefName         string           `json:"targetRefName"`
+	MergeStatus           string           `json:"mergeStatus,omitempty"`
+	IsDraft               bool             `json:"isDraft"`
+	LastMergeSourceCommit *Commit          `json:"lastMergeSourceCommit,omitempty"`
+	LastMergeTargetCommit *Commit          `json:"lastMergeTargetCommit,omitempty"`
+	Reviewers             []Reviewer       `json:"reviewers"`
+	URL                   string           `json:"url"`
+
+	// Statuses are the statuses posted to the pull request, e.g. by CI
+	// systems. They are not returned as part of the pull request by the API,
+	// and have to be loaded with GetPullRequestStatuses.
+	Statuses []*PullRequestStatus `json:"statuses,omitempty"`
+}
+
+// WebURL returns the URL of the pull request in the Azure DevOps web
+// interface.
+func (pr *PullRequest) WebURL() string {
+	if pr.Repository.WebURL == "" {
+		return ""
+	}
+	return pr.Repository.WebURL + "/pullrequest/" + strconv.FormatInt(pr.ID, 10)
+}
+
+// UpdatedAt returns the most recent time the pull request is known to have
+// changed. The API doesn't expose when a pull request was last updated.
+func (pr *PullRequest) UpdatedAt() time.Time {
+	t := pr.CreationDate
+	if pr.ClosedDate.After(t) {
+		t = pr.ClosedDate
+	}
+	for _, s := range pr.Statuses {
+		if s.UpdatedDate.After(t) {
+			t = s.UpdatedDate
+		}
+	}
+	return t
+}
+
+// Commit is a reference to a Git commit.
+type Commit struct {
+	CommitID string `json:"commitId"`
+}
+
+// Reviewer is a reviewer of a pull request and their vote.
+type Reviewer struct {
+	Identity
+	Vote       int  `json:"vote"`
+	IsRequired bool `json:"isRequired,omitempty"`
+}
+
+// PullRequestStatusState is the state of a pull request status.
+type PullRequestStatusState string
+
+const (
+	PullRequestStatusStateNotSet        PullRequestStatusState = "notSet"
+	PullRequestStatusStatePending       PullRequestStatusState = "pending"
+	PullRequestStatusStateSucceeded     PullRequestStatusState = "succeeded"
+	PullRequestStatusStateFailed        PullRequestStatusState = "failed"
+	PullRequestStatusStateError         PullRequestStatusState = "error"
+	PullRequestStatusStateNotApplicable PullRequestStatusState = "notApplicable"
+)
+
+// PullRequestStatus is a status posted to a pull request, such as the result
+// of a CI build. Statuses are identified by their context.
+type PullRequestStatus struct {
+	ID          int64                  `json:"id"`
+	State       PullRequestStatusState `json:"state"`
+	Description string                 `json:"description,omitempty"`
+	Context     StatusContext          `json:"context"`
+	TargetURL   string                 `json:"targetUrl,omitempty"`
+	CreatedDate time.Time              `json:"creationDate"`
+	UpdatedDate time.Time              `json:"updatedDate"`
+}
+
+// StatusContext identifies the service that posted a status.
+type StatusContext struct {
+	Name  string `json:"name"`
+	Genre string `json:"genre,omitempty"`
+}
+
+// Key is a unique key identifying this status. A status posted again with the
+// same context replaces the previous one.
+func (s *PullRequestStatus) Key() string {
+	return fmt.Sprintf("%s/%s", s.Context.Genre, s.Context.Name)
+}
+
+// PullRequestInput is the input to create a pull request.
+type PullRequestInput struct {
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	SourceRefName string `json:"sourceRefName"`
+	TargetRefName string `json:"targetRefName"`
+	IsDraft       bool   `json:"isDraft,omitempty"`
+}
+
+// PullRequestUpdateInput is the input to update a pull request. Only non-nil
+// fields are updated.
+type PullRequestUpdateInput struct {
+	Title                 *string            `json:"title,omitempty"`
+	Description           *string            `json:"description,omitempty"`
+	TargetRefName         *string            `json:"targetRefName,omitempty"`
+	Status                *PullRequestState  `json:"status,omitempty"`
+	IsDraft               *bool              `json:"isDraft,omitempty"`
+	LastMergeSourceCommit *Commit            `json:"lastMergeSourceCommit,omitempty"`
+	CompletionOptions     *CompletionOptions `json:"completionOptions,omitempty"`
+}
+
+// CompletionOptions are the options used to complete (merge) a pull request.
+type CompletionOptions struct {
+	MergeStrategy      MergeStrategy `json:"mergeStrategy,omitempty"`
+	DeleteSourceBranch bool          `json:"deleteSourceBranch,omitempty"`
+}
+
+func pullRequestsPath(repo *Repository, rest ...string) []string {
+	return append([]string{repo.Org, repo.Project.Name, "_apis", "git", "repositories", repo.ID, "pullrequests"}, rest...)
+}
+
+// CreatePullRequest opens a new pull request in the given repository. If an
+// active pull request for the same branches already exists,
+// ErrPullRequestAlreadyExists is returned.
+func (c *Client) CreatePullRequest(ctx context.Context, repo *Repository, input PullRequestInput) (*PullRequest, error) {
+	req, err := c.newRequest("POST", pullRequestsPath(repo), nil, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, req, &pr); err != nil {
+		var e *httpError
+		if errors.As(err, &e) && e.StatusCode == http.StatusConflict && e.typeKey() == "GitPullRequestExistsException" {
+			return nil, ErrPullRequestAlreadyExists
+		}
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// FindActivePullRequest returns the active pull request from sourceRefName to
+// targetRefName in the given repository. A not found error is returned if
+// there is none.
+func (c *Client) FindActivePullRequest(ctx context.Context, repo *Repository, sourceRefName, targetRefName string) (*PullRequest, error) {
+	qs := make(url.Values)
+	qs.Set("searchCriteria.sourceRefName", sourceRefName)
+	qs.Set("searchCriteria.targetRefName", targetRefName)
+	qs.Set("searchCriteria.status", string(PullRequestStateActive))
+
+	req, err := c.newRequest("GET", pullRequestsPath(repo), qs, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Value []*PullRequest `json:"value"`
+	}
+	if _, err := c.do(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Value) == 0 {
+		return nil, &httpError{URL: req.URL, StatusCode: http.StatusNotFound}
+	}
+	return resp.Value[0], nil
+}
+
+// GetPullRequest returns the pull request with the given ID.
+func (c *Client) GetPullRequest(ctx context.Context, repo *Repository, id int64) (*PullRequest, error) {
+	req, err := c.newRequest("GET", pullRequestsPath(repo, strconv.FormatInt(id, 10)), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, req, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// UpdatePullRequest updates the pull request with the given ID.
+func (c *Client) UpdatePullRequest(ctx context.Context, repo *Repository, id int64, input PullRequestUpdateInput) (*PullRequest, error) {
+	req, err := c.newRequest("PATCH", pullRequestsPath(repo, strconv.FormatInt(id, 10)), nil, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, req, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// CompletePullRequest completes (merges) the given pull request with the given
+// merge strategy. Azure DevOps merges pull requests asynchronously, so the
+// returned pull request may still be active. If Azure DevOps refuses to
+// complete the pull request, ErrNotMergeable is returned.
+func (c *Client) CompletePullRequest(ctx context.Context, repo *Repository, pr *PullRequest, strategy MergeStrategy) (*PullRequest, error) {
+	if pr.LastMergeSourceCommit == nil {
+		return nil, errors.Wrap(ErrNotMergeable, "pull request has no source commit")
+	}
+
+	status := PullRequestStateCompleted
+	updated, err := c.UpdatePullRequest(ctx, repo, pr.ID, PullRequestUpdateInput{
+		Status:                &status,
+		LastMergeSourceCommit: pr.LastMergeSourceCommit,
+		CompletionOptions:     &CompletionOptions{MergeStrategy: strategy},
+	})
+	if err != nil {
+		var e *httpError
+		if errors.As(err, &e) && (e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusConflict) {
+			return nil, errors.Wrap(ErrNotMergeable, string(e.Body))
+		}
+		return nil, err
+	}
+	return updated, nil
+}
+
+// CreatePullRequestComment starts a new comment thread on the pull request
+// with the given text.
+func (c *Client) CreatePullRequestComment(ctx context.Context, repo *Repository, id int64, text string) error {
+	type comment struct {
+		ParentCommentID int    `json:"parentCommentId"`
+		Content         string `json:"content"`
+		CommentType     int    `json:"commentType"`
+	}
+	thread := struct {
+		Comments []comment `json:"comments"`
+		Status   int       `json:"status"`
+	}{
+		// Comment type 1 is a text comment, thread status 1 an active thread.
+		Comments: []comment{{Content: text, CommentType: 1}},
+		Status:   1,
+	}
+
+	req, err := c.newRequest("POST", pullRequestsPath(repo, strconv.FormatInt(id, 10), "threads"), nil, thread)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// GetPullRequestStatuses returns the statuses posted to the pull request with
+// the given ID.
+func (c *Client) GetPullRequestStatuses(ctx context.Context, repo *Repository, id int64) ([]*PullRequestStatus, error) {
+	req, err := c.newRequest("GET", pullRequestsPath(repo, strconv.FormatInt(id, 10), "statuses"), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Value []*PullRequestStatus `json:"value"`
+	}
+	if _, err := c.do(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Value, nil
+}
@@ -0,0 +1,144 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+var testRepo = &Repository{
+	ID:      "abc",
+	Name:    "repo",
+	Org:     "myorg",
+	Project: Project{ID: "1", Name: "project"},
+	WebURL:  "https://dev.azure.com/myorg/project/_git/repo",
+}
+
+func TestClient_CreatePullRequest(t *testing.T) {
+	t.Run("created", func(t *testing.T) {
+		cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if have, want := r.Method, "POST"; have != want {
+				t.Errorf("unexpected method: have %q, want %q", have, want)
+			}
+			if have, want := r.URL.Path, "/tfs/myorg/project/_apis/git/repositories/abc/pullrequests"; have != want {
+				t.Errorf("unexpected path: have %q, want %q", have, want)
+			}
+			var input PullRequestInput
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				t.Fatal(err)
+			}
+			if input.SourceRefName != "refs/heads/feature" || input.TargetRefName != "refs/heads/main" {
+				t.Errorf("unexpected input: %+v", input)
+			}
+			fmt.Fprint(w, `{"pullRequestId":42,"status":"active","title":"Title"}`)
+		})
+
+		pr, err := cli.CreatePullRequest(context.Background(), testRepo, PullRequestInput{
+			Title:         "Title",
+			SourceRefName: "refs/heads/feature",
+			TargetRefName: "refs/heads/main",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pr.ID != 42 || pr.Status != PullRequestStateActive {
+			t.Fatalf("unexpected pull request: %+v", pr)
+		}
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"message":"TF401179: An active pull request for the source and target branch already exists.","typeKey":"GitPullRequestExistsException"}`)
+		})
+
+		_, err := cli.CreatePullRequest(context.Background(), testRepo, PullRequestInput{})
+		if !errors.Is(err, ErrPullRequestAlreadyExists) {
+			t.Fatalf("expected ErrPullRequestAlreadyExists, got %v", err)
+		}
+	})
+}
+
+func TestClient_FindActivePullRequest(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("searchCriteria.sourceRefName") == "refs/heads/missing" {
+			fmt.Fprint(w, `{"count":0,"value":[]}`)
+			return
+		}
+		if have, want := q.Get("searchCriteria.status"), "active"; have != want {
+			t.Errorf("unexpected status: have %q, want %q", have, want)
+		}
+		fmt.Fprint(w, `{"count":1,"value":[{"pullRequestId":42}]}`)
+	})
+
+	pr, err := cli.FindActivePullRequest(context.Background(), testRepo, "refs/heads/feature", "refs/heads/main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.ID != 42 {
+		t.Fatalf("unexpected pull request: %+v", pr)
+	}
+
+	_, err = cli.FindActivePullRequest(context.Background(), testRepo, "refs/heads/missing", "refs/heads/main")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestClient_CompletePullRequest(t *testing.T) {
+	t.Run("completed", func(t *testing.T) {
+		cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if have, want := r.Method, "PATCH"; have != want {
+				t.Errorf("unexpected method: have %q, want %q", have, want)
+			}
+			var input PullRequestUpdateInput
+			if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+				t.Fatal(err)
+			}
+			if *input.Status != PullRequestStateCompleted ||
+				input.LastMergeSourceCommit.CommitID != "deadbeef" ||
+				input.CompletionOptions.MergeStrategy != MergeStrategySquash {
+				t.Errorf("unexpected input: %+v", input)
+			}
+			fmt.Fprint(w, `{"pullRequestId":42,"status":"completed"}`)
+		})
+
+		pr, err := cli.CompletePullRequest(context.Background(), testRepo, &PullRequest{
+			ID:                    42,
+			LastMergeSourceCommit: &Commit{CommitID: "deadbeef"},
+		}, MergeStrategySquash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pr.Status != PullRequestStateCompleted {
+			t.Fatalf("unexpected pull request: %+v", pr)
+		}
+	})
+
+	t.Run("not mergeable", func(t *testing.T) {
+		cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"message":"TF401027: policies must be satisfied"}`)
+		})
+
+		_, err := cli.CompletePullRequest(context.Background(), testRepo, &PullRequest{
+			ID:                    42,
+			LastMergeSourceCommit: &Commit{CommitID: "deadbeef"},
+		}, MergeStrategyNoFastForward)
+		if !errors.Is(err, ErrNotMergeable) {
+			t.Fatalf("expected ErrNotMergeable, got %v", err)
+		}
+	})
+}
+
+func TestPullRequest_WebURL(t *testing.T) {
+	pr := &PullRequest{ID: 42, Repository: *testRepo}
+	if have, want := pr.WebURL(), "https://dev.azure.com/myorg/project/_git/repo/pullrequest/42"; have != want {
+		t.Fatalf("unexpected URL: have %q, want %q", have, want)
+	}
+}
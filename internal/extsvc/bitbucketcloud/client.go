@@ -16,6 +16,7 @@ import (
 	"github.com/opentracing-contrib/go-stdlib/nethttp"
 	"golang.org/x/time/rate"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
@@ -50,6 +51,10 @@ type Client struct {
 	// The username and app password credentials for accessing the server.
 	Username, AppPassword string
 
+	// OAuthToken is the OAuth access token of a user. If set, it is used instead of
+	// the username and app password.
+	OAuthToken string
+
 	// RateLimit is the self-imposed rate limiter (since Bitbucket does not have a concept
 	// of rate limiting in HTTP response headers).
 	RateLimit *rate.Limiter
@@ -86,6 +91,33 @@ func NewClient(apiURL *url.URL, httpClient httpcli.Doer) *Client {
 	}
 }
 
+// WithAuthenticator returns a new Client that uses the same configuration as
+// the current Client, except authenticated with the given authenticator. Basic
+// authentication with a username and app password, and OAuth access tokens of
+// users are supported by Bitbucket Cloud.
+func (c *Client) WithAuthenticator(a auth.Authenticator) (*Client, error) {
+	var username, password, token string
+	switch a := a.(type) {
+	case *auth.BasicAuth:
+		username, password = a.Username, a.Password
+	case *auth.BasicAuthWithSSH:
+		username, password = a.Username, a.Password
+	case *auth.OAuthBearerToken:
+		token = a.Token
+	default:
+		return nil, errors.Errorf("authenticator type unsupported for Bitbucket Cloud clients: %T", a)
+	}
+
+	return &Client{
+		httpClient:  c.httpClient,
+		URL:         c.URL,
+		Username:    username,
+		AppPassword: password,
+		OAuthToken:  token,
+		RateLimit:   c.RateLimit,
+	}, nil
+}
+
 // Repos returns a list of repositories that are fetched and populated based on given account
 // name and pagination criteria. If the account requested is a team, results will be filtered
 // down to the ones that the app password's user has access to.
@@ -195,6 +227,9 @@ func (c *Client) do(ctx context.Context, req *http.Request, result interface{})
 }
 
 func (c *Client) authenticate(req *http.Request) error {
+	if c.OAuthToken != "" {
+		return (&auth.OAuthBearerToken{Token: c.OAuthToken}).Authenticate(req)
+	}
 	req.SetBasicAuth(c.Username, c.AppPassword)
 	return nil
 }
@@ -277,3 +312,15 @@ func (e *httpError) Unauthorized() bool {
 func (e *httpError) NotFound() bool {
 	return e.StatusCode == http.StatusNotFound
 }
+
+// IsUnauthorized reports whether err is a Bitbucket Cloud API HTTP 401 error.
+func IsUnauthorized(err error) bool {
+	var e *httpError
+	return errors.As(err, &e) && e.Unauthorized()
+}
+
+// IsNotFound reports whether err is a Bitbucket Cloud API HTTP 404 error.
+func IsNotFound(err error) bool {
+	var e *httpError
+	return errors.As(err, &e) && e.NotFound()
+}
@@ -0,0 +1,83 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// RepoPermission is the permission of a user on a repository.
+type RepoPermission struct {
+	// Permission is one of "read", "write", or "admin".
+	Permission string   `json:"permission"`
+	User       *Account `json:"user"`
+	Repository *Repo    `json:"repository"`
+}
+
+// CurrentUserRepoPermissions returns a page of the permissions of the user associated
+// with the client's credentials on the repositories they have explicit access to, or
+// access to through a group or workspace role.
+//
+// API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-users/#api-user-permissions-repositories-get
+func (c *Client) CurrentUserRepoPermissions(ctx context.Context, pageToken *PageToken) ([]*RepoPermission, *PageToken, error) {
+	var perms []*RepoPermission
+	var next *PageToken
+	var err error
+	if pageToken.HasMore() {
+		next, err = c.reqPage(ctx, pageToken.Next, &perms)
+	} else {
+		next, err = c.page(ctx, "/2.0/user/permissions/repositories", nil, pageToken, &perms)
+	}
+	return perms, next, err
+}
+
+// WorkspaceRepoPermissions returns a page of the permissions of the users that have access
+// to the repository with the given slug in the given workspace. The user associated with
+// the client's credentials must be an administrator of the workspace.
+//
+// API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-permissions-repositories-repo-slug-get
+func (c *Client) WorkspaceRepoPermissions(ctx context.Context, workspace, repoSlug string, pageToken *PageToken) ([]*RepoPermission, *PageToken, error) {
+	var perms []*RepoPermission
+	var next *PageToken
+	var err error
+	if pageToken.HasMore() {
+		next, err = c.reqPage(ctx, pageToken.Next, &perms)
+	} else {
+		path := fmt.Sprintf("/2.0/workspaces/%s/permissions/repositories/%s", url.PathEscape(workspace), url.PathEscape(repoSlug))
+		next, err = c.page(ctx, path, nil, pageToken, &perms)
+	}
+	return perms, next, err
+}
+
+// Email is an email address of a Bitbucket Cloud user.
+type Email struct {
+	Email       string `json:"email"`
+	IsPrimary   bool   `json:"is_primary"`
+	IsConfirmed bool   `json:"is_confirmed"`
+}
+
+// CurrentUserEmails returns all email addresses of the user associated with the client's
+// credentials.
+//
+// API docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-users/#api-user-emails-get
+func (c *Client) CurrentUserEmails(ctx context.Context) ([]*Email, error) {
+	var all []*Email
+	var next *PageToken
+	for {
+		var emails []*Email
+		var err error
+		if next.HasMore() {
+			next, err = c.reqPage(ctx, next.Next, &emails)
+		} else {
+			next, err = c.page(ctx, "/2.0/user/emails", nil, nil, &emails)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, emails...)
+		if !next.HasMore() {
+			return all, nil
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+)
+
+func TestClient_CurrentUserRepoPermissions(t *testing.T) {
+	var srvURL string
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/user/permissions/repositories" {
+			t.Errorf("unexpected request path: %s", r.URL.Path)
+		}
+		if have := r.Header.Get("Authorization"); have != "Bearer token" {
+			t.Errorf("unexpected authorization header: %q", have)
+		}
+
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"values": [{"permission": "write", "repository": {"full_name": "sglocal/other", "uuid": "{2}"}}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"values": [{"permission": "read", "repository": {"full_name": "sglocal/mux", "uuid": "{1}"}}], "next": "%s/2.0/user/permissions/repositories?page=2"}`, srvURL)
+	})
+	srvURL = cli.URL.String()
+
+	cli, err := cli.WithAuthenticator(&auth.OAuthBearerToken{Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var have []string
+	var next *PageToken
+	for {
+		var perms []*RepoPermission
+		perms, next, err = cli.CurrentUserRepoPermissions(context.Background(), next)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, perm := range perms {
+			have = append(have, perm.Permission+":"+perm.Repository.UUID)
+		}
+		if !next.HasMore() {
+			break
+		}
+	}
+
+	if diff := cmp.Diff([]string{"read:{1}", "write:{2}"}, have); diff != "" {
+		t.Fatalf("unexpected permissions (-want +got):\n%s", diff)
+	}
+}
+
+func TestClient_WorkspaceRepoPermissions(t *testing.T) {
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/workspaces/sglocal/permissions/repositories/mux" {
+			t.Errorf("unexpected request path: %s", r.URL.Path)
+		}
+		if username, password, _ := r.BasicAuth(); username != "user" || password != "password" {
+			t.Errorf("unexpected credentials: %s:%s", username, password)
+		}
+		fmt.Fprint(w, `{"values": [{"permission": "admin", "user": {"uuid": "{a}", "nickname": "alice"}}, {"permission": "read", "user": {"uuid": "{b}", "nickname": "bob"}}]}`)
+	})
+
+	perms, next, err := cli.WorkspaceRepoPermissions(context.Background(), "sglocal", "mux", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.HasMore() {
+		t.Errorf("unexpected next page: %q", next.Next)
+	}
+
+	want := []*RepoPermission{
+		{Permission: "admin", User: &Account{UUID: "{a}", Nickname: "alice"}},
+		{Permission: "read", User: &Account{UUID: "{b}", Nickname: "bob"}},
+	}
+	if diff := cmp.Diff(want, perms); diff != "" {
+		t.Fatalf("unexpected permissions (-want +got):\n%s", diff)
+	}
+}
+
+func TestClient_CurrentUserEmails(t *testing.T) {
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/user/emails" {
+			t.Errorf("unexpected request path: %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"values": [{"email": "alice@example.com", "is_primary": true, "is_confirmed": true}, {"email": "alice@example.org", "is_primary": false, "is_confirmed": false}]}`)
+	})
+
+	emails, err := cli.CurrentUserEmails(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Email{
+		{Email: "alice@example.com", IsPrimary: true, IsConfirmed: true},
+		{Email: "alice@example.org"},
+	}
+	if diff := cmp.Diff(want, emails); diff != "" {
+		t.Fatalf("unexpected emails (-want +got):\n%s", diff)
+	}
+}
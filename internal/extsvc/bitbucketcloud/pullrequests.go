@@ -0,0 +1,356 @@
+package bitbucketcloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrNotMergeable is returned by MergePullRequest when Bitbucket Cloud refuses to
+// merge the pull request in its current state, e.g. because of merge conflicts.
+var ErrNotMergeable = errors.New("pull request cannot be merged")
+
+// PullRequestState is the state of a pull request on Bitbucket Cloud.
+type PullRequestState string
+
+const (
+	PullRequestStateOpen       PullRequestState = "OPEN"
+	PullRequestStateMerged     PullRequestState = "MERGED"
+	PullRequestStateDeclined   PullRequestState = "DECLINED"
+	PullRequestStateSuperseded PullRequestState = "SUPERSEDED"
+)
+
+// PullRequest is a Bitbucket Cloud pull request, as returned by the REST API
+// 2.0.
+type PullRequest struct {
+	ID                int64               `json:"id"`
+	Title             string              `json:"title"`
+	Description       string              `json:"description"`
+	State             PullRequestState    `json:"state"`
+	Author            Account             `json:"author"`
+	Source            PullRequestEndpoint `json:"source"`
+	Destination       PullRequestEndpoint `json:"destination"`
+	MergeCommit       *PullRequestCommit  `json:"merge_commit,omitempty"`
+	CommentCount      int64               `json:"comment_count"`
+	TaskCount         int64               `json:"task_count"`
+	CloseSourceBranch bool                `json:"close_source_branch"`
+	ClosedBy          *Account            `json:"closed_by,omitempty"`
+	Reason            string              `json:"reason"`
+	CreatedOn         time.Time           `json:"created_on"`
+	UpdatedOn         time.Time           `json:"updated_on"`
+	Reviewers         []Account           `json:"reviewers"`
+	Participants      []Participant       `json:"participants"`
+	Links             Links               `json:"links"`
+
+	// Statuses are the commit statuses of the pull request's source commit.
+	// They are not returned as part of the pull request by the API, and have
+	// to be loaded with GetPullRequestStatuses.
+	Statuses []*PullRequestStatus `json:"statuses,omitempty"`
+}
+
+// PullRequestEndpoint is the source or destination of a pull request.
+type PullRequestEndpoint struct {
+	Branch     PullRequestBranch `json:"branch"`
+	Commit     PullRequestCommit `json:"commit"`
+	Repository Repo              `json:"repository"`
+}
+
+type PullRequestBranch struct {
+	Name string `json:"name"`
+}
+
+type PullRequestCommit struct {
+	Hash string `json:"hash"`
+}
+
+// Account is a Bitbucket Cloud user or team.
+type Account struct {
+	UUID        string       `json:"uuid"`
+	AccountID   string       `json:"account_id,omitempty"`
+	Nickname    string       `json:"nickname,omitempty"`
+	DisplayName string       `json:"display_name"`
+	Links       AccountLinks `json:"links"`
+}
+
+type AccountLinks struct {
+	Avatar Link `json:"avatar"`
+	HTML   Link `json:"html"`
+}
+
+// ParticipantState is the review state of a pull request participant.
+type ParticipantState string
+
+const (
+	ParticipantStateApproved         ParticipantState = "approved"
+	ParticipantStateChangesRequested ParticipantState = "changes_requested"
+	ParticipantStateNull             ParticipantState = ""
+)
+
+// Participant is a user that has participated in a pull request, e.g. by
+// reviewing or commenting on it.
+type Participant struct {
+	User           Account          `json:"user"`
+	Role           string           `json:"role"`
+	Approved       bool             `json:"approved"`
+	State          ParticipantState `json:"state"`
+	ParticipatedOn time.Time        `json:"participated_on"`
+}
+
+// Key is a unique key identifying this participant's review state in a pull
+// request.
+func (p *Participant) Key() string {
+	return fmt.Sprintf("%s:%s", p.User.UUID, p.State)
+}
+
+// PullRequestStatusState is the state of a commit status.
+type PullRequestStatusState string
+
+const (
+	PullRequestStatusStateSuccessful PullRequestStatusState = "SUCCESSFUL"
+	PullRequestStatusStateFailed     PullRequestStatusState = "FAILED"
+	PullRequestStatusStateInProgress PullRequestStatusState = "INPROGRESS"
+	PullRequestStatusStateStopped    PullRequestStatusState = "STOPPED"
+)
+
+// PullRequestStatus is a commit status (also known as a build status)
+// attached to the source commit of a pull request. StatusKey is the key given
+// to the status by the CI system that created it.
+type PullRequestStatus struct {
+	UUID        string                 `json:"uuid"`
+	StatusKey   string                 `json:"key"`
+	RefName     string                 `json:"refname"`
+	URL         string                 `json:"url"`
+	State       PullRequestStatusState `json:"state"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Commit      PullRequestCommit      `json:"commit"`
+	CreatedOn   time.Time              `json:"created_on"`
+	UpdatedOn   time.Time              `json:"updated_on"`
+}
+
+// Key is a unique key identifying this status. Bitbucket Cloud updates
+// statuses with the same key in place, so the commit hash is part of the key to
+// keep statuses of earlier commits apart.
+func (s *PullRequestStatus) Key() string {
+	return s.Commit.Hash + ":" + s.StatusKey
+}
+
+// Comment is a comment on a pull request.
+type Comment struct {
+	ID        int64          `json:"id"`
+	Content   RenderedMarkup `json:"content"`
+	User      Account        `json:"user"`
+	Deleted   bool           `json:"deleted"`
+	CreatedOn time.Time      `json:"created_on"`
+	UpdatedOn time.Time      `json:"updated_on"`
+}
+
+type RenderedMarkup struct {
+	Raw    string `json:"raw"`
+	Markup string `json:"markup,omitempty"`
+	HTML   string `json:"html,omitempty"`
+}
+
+// PullRequestInput contains the fields that can be set when creating or
+// updating a pull request.
+type PullRequestInput struct {
+	Title        string
+	Description  string
+	SourceBranch string
+
+	// SourceRepo is the repository containing the source branch. If nil, the
+	// branch is expected to exist in the destination repository.
+	SourceRepo *Repo
+	// DestinationBranch is the branch the pull request targets. If nil, the
+	// main branch of the repository is used when creating the pull request,
+	// and the existing destination is kept when updating it.
+	DestinationBranch *string
+	// CloseSourceBranch indicates whether the source branch should be deleted
+	// once the pull request has been merged.
+	CloseSourceBranch bool
+}
+
+func (input *PullRequestInput) MarshalJSON() ([]byte, error) {
+	type branch struct {
+		Name string `json:"name"`
+	}
+	type repository struct {
+		FullName string `json:"full_name"`
+	}
+	type source struct {
+		Branch     branch      `json:"branch"`
+		Repository *repository `json:"repository,omitempty"`
+	}
+	type destination struct {
+		Branch branch `json:"branch"`
+	}
+	type request struct {
+		Title             string       `json:"title"`
+		Description       string       `json:"description,omitempty"`
+		Source            source       `json:"source"`
+		Destination       *destination `json:"destination,omitempty"`
+		CloseSourceBranch bool         `json:"close_source_branch"`
+	}
+
+	req := request{
+		Title:             input.Title,
+		Description:       input.Description,
+		Source:            source{Branch: branch{Name: input.SourceBranch}},
+		CloseSourceBranch: input.CloseSourceBranch,
+	}
+	if input.SourceRepo != nil {
+		req.Source.Repository = &repository{FullName: input.SourceRepo.FullName}
+	}
+	if input.DestinationBranch != nil {
+		req.Destination = &destination{Branch: branch{Name: *input.DestinationBranch}}
+	}
+
+	return json.Marshal(req)
+}
+
+// MergeStrategy is the strategy used to merge a pull request.
+type MergeStrategy string
+
+const (
+	MergeStrategyMergeCommit MergeStrategy = "merge_commit"
+	MergeStrategySquash      MergeStrategy = "squash"
+	MergeStrategyFastForward MergeStrategy = "fast_forward"
+)
+
+// MergePullRequestOpts are the options available when merging a pull request.
+type MergePullRequestOpts struct {
+	Message           *string        `json:"message,omitempty"`
+	CloseSourceBranch *bool          `json:"close_source_branch,omitempty"`
+	MergeStrategy     *MergeStrategy `json:"merge_strategy,omitempty"`
+}
+
+// CreatePullRequest opens a new pull request in the given repository.
+//
+// Note that Bitbucket Cloud does not return an error if an open pull request
+// for the same source and destination branches already exists: instead, the
+// existing pull request is updated with the given input and returned.
+func (c *Client) CreatePullRequest(ctx context.Context, repo *Repo, input PullRequestInput) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.sendJSON(ctx, "POST", pullRequestsPath(repo), &input, &pr); err != nil {
+		return nil, errors.Wrap(err, "creating pull request")
+	}
+	return &pr, nil
+}
+
+// GetPullRequest retrieves a single pull request.
+func (c *Client) GetPullRequest(ctx context.Context, repo *Repo, id int64) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.sendJSON(ctx, "GET", pullRequestPath(repo, id), nil, &pr); err != nil {
+		return nil, errors.Wrap(err, "getting pull request")
+	}
+	return &pr, nil
+}
+
+// UpdatePullRequest updates the title, description, source and destination of
+// an existing pull request.
+func (c *Client) UpdatePullRequest(ctx context.Context, repo *Repo, id int64, input PullRequestInput) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.sendJSON(ctx, "PUT", pullRequestPath(repo, id), &input, &pr); err != nil {
+		return nil, errors.Wrap(err, "updating pull request")
+	}
+	return &pr, nil
+}
+
+// DeclinePullRequest declines (closes without merging) a pull request.
+func (c *Client) DeclinePullRequest(ctx context.Context, repo *Repo, id int64) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.sendJSON(ctx, "POST", pullRequestPath(repo, id)+"/decline", nil, &pr); err != nil {
+		return nil, errors.Wrap(err, "declining pull request")
+	}
+	return &pr, nil
+}
+
+// MergePullRequest merges a pull request. If Bitbucket Cloud refuses to merge
+// the pull request, an error wrapping ErrNotMergeable is returned.
+func (c *Client) MergePullRequest(ctx context.Context, repo *Repo, id int64, opts MergePullRequestOpts) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.sendJSON(ctx, "POST", pullRequestPath(repo, id)+"/merge", &opts, &pr); err != nil {
+		var e *httpError
+		if errors.As(err, &e) && (e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusConflict) {
+			return nil, errors.Wrap(ErrNotMergeable, string(e.Body))
+		}
+		return nil, errors.Wrap(err, "merging pull request")
+	}
+	return &pr, nil
+}
+
+// CreatePullRequestComment adds a comment to a pull request.
+func (c *Client) CreatePullRequestComment(ctx context.Context, repo *Repo, id int64, text string) (*Comment, error) {
+	body := struct {
+		Content RenderedMarkup `json:"content"`
+	}{
+		Content: RenderedMarkup{Raw: text},
+	}
+
+	var comment Comment
+	if err := c.sendJSON(ctx, "POST", pullRequestPath(repo, id)+"/comments", &body, &comment); err != nil {
+		return nil, errors.Wrap(err, "creating pull request comment")
+	}
+	return &comment, nil
+}
+
+// GetPullRequestStatuses returns all commit statuses attached to the pull
+// request.
+func (c *Client) GetPullRequestStatuses(ctx context.Context, repo *Repo, id int64) ([]*PullRequestStatus, error) {
+	var all []*PullRequestStatus
+
+	var statuses []*PullRequestStatus
+	next, err := c.page(ctx, pullRequestPath(repo, id)+"/statuses", nil, nil, &statuses)
+	for {
+		if err != nil {
+			return nil, errors.Wrap(err, "getting pull request statuses")
+		}
+		all = append(all, statuses...)
+		if !next.HasMore() {
+			return all, nil
+		}
+
+		statuses = nil
+		next, err = c.reqPage(ctx, next.Next, &statuses)
+	}
+}
+
+// CurrentUser returns the account associated with the client's credentials.
+func (c *Client) CurrentUser(ctx context.Context) (*Account, error) {
+	var account Account
+	if err := c.sendJSON(ctx, "GET", "/2.0/user", nil, &account); err != nil {
+		return nil, errors.Wrap(err, "getting current user")
+	}
+	return &account, nil
+}
+
+func (c *Client) sendJSON(ctx context.Context, method, path string, body, result interface{}) error {
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			return errors.Wrap(err, "marshalling request body")
+		}
+	}
+
+	req, err := http.NewRequest(method, (&url.URL{Path: path}).String(), &buf)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, result)
+}
+
+func pullRequestsPath(repo *Repo) string {
+	return "/2.0/repositories/" + repo.FullName + "/pullrequests"
+}
+
+func pullRequestPath(repo *Repo, id int64) string {
+	return pullRequestsPath(repo) + "/" + strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,186 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+)
+
+var testRepo = &Repo{FullName: "sglocal/mux", UUID: "{e1e75436-05e6-4c38-8543-9c36ec26fad1}"}
+
+func newPullRequestTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cli := NewClient(u, srv.Client())
+	cli.Username = "user"
+	cli.AppPassword = "password"
+	return cli
+}
+
+func TestClient_CreatePullRequest(t *testing.T) {
+	destination := "main"
+
+	var have map[string]interface{}
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/2.0/repositories/sglocal/mux/pullrequests" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if username, password, _ := r.BasicAuth(); username != "user" || password != "password" {
+			t.Errorf("unexpected credentials: %s:%s", username, password)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&have); err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprint(w, `{"id": 42, "title": "Title", "state": "OPEN", "source": {"branch": {"name": "feature"}}}`)
+	})
+
+	pr, err := cli.CreatePullRequest(context.Background(), testRepo, PullRequestInput{
+		Title:             "Title",
+		Description:       "Description",
+		SourceBranch:      "feature",
+		SourceRepo:        testRepo,
+		DestinationBranch: &destination,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"title":       "Title",
+		"description": "Description",
+		"source": map[string]interface{}{
+			"branch":     map[string]interface{}{"name": "feature"},
+			"repository": map[string]interface{}{"full_name": "sglocal/mux"},
+		},
+		"destination": map[string]interface{}{
+			"branch": map[string]interface{}{"name": "main"},
+		},
+		"close_source_branch": false,
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("unexpected request body (-want +have):\n%s", diff)
+	}
+
+	if pr.ID != 42 || pr.State != PullRequestStateOpen || pr.Source.Branch.Name != "feature" {
+		t.Errorf("unexpected pull request: %+v", pr)
+	}
+}
+
+func TestClient_MergePullRequest(t *testing.T) {
+	t.Run("merged", func(t *testing.T) {
+		cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "POST" || r.URL.Path != "/2.0/repositories/sglocal/mux/pullrequests/42/merge" {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}
+			var opts MergePullRequestOpts
+			if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+				t.Fatal(err)
+			}
+			if opts.MergeStrategy == nil || *opts.MergeStrategy != MergeStrategySquash {
+				t.Errorf("unexpected merge strategy: %v", opts.MergeStrategy)
+			}
+			fmt.Fprint(w, `{"id": 42, "state": "MERGED"}`)
+		})
+
+		strategy := MergeStrategySquash
+		pr, err := cli.MergePullRequest(context.Background(), testRepo, 42, MergePullRequestOpts{MergeStrategy: &strategy})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pr.State != PullRequestStateMerged {
+			t.Errorf("unexpected state: %s", pr.State)
+		}
+	})
+
+	t.Run("not mergeable", func(t *testing.T) {
+		cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"type": "error", "error": {"message": "You can't merge until you resolve all merge conflicts."}}`)
+		})
+
+		_, err := cli.MergePullRequest(context.Background(), testRepo, 42, MergePullRequestOpts{})
+		if !errors.Is(err, ErrNotMergeable) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestClient_GetPullRequestStatuses(t *testing.T) {
+	var srvURL string
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/repositories/sglocal/mux/pullrequests/42/statuses" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"values": [{"key": "b", "state": "FAILED"}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"values": [{"key": "a", "state": "SUCCESSFUL"}], "next": %q}`, srvURL+r.URL.Path+"?page=2")
+	})
+	srvURL = cli.URL.String()
+
+	statuses, err := cli.GetPullRequestStatuses(context.Background(), testRepo, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*PullRequestStatus{
+		{StatusKey: "a", State: PullRequestStatusStateSuccessful},
+		{StatusKey: "b", State: PullRequestStatusStateFailed},
+	}
+	if diff := cmp.Diff(want, statuses); diff != "" {
+		t.Errorf("unexpected statuses (-want +have):\n%s", diff)
+	}
+}
+
+func TestClient_GetPullRequest_NotFound(t *testing.T) {
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := cli.GetPullRequest(context.Background(), testRepo, 42)
+	if !IsNotFound(err) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_WithAuthenticator(t *testing.T) {
+	cli := NewClient(&url.URL{Scheme: "https", Host: "api.bitbucket.org"}, nil)
+
+	authed, err := cli.WithAuthenticator(&auth.BasicAuth{Username: "alice", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authed.Username != "alice" || authed.AppPassword != "secret" {
+		t.Errorf("unexpected credentials: %s:%s", authed.Username, authed.AppPassword)
+	}
+
+	authed, err = cli.WithAuthenticator(&auth.OAuthBearerToken{Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authed.OAuthToken != "token" || authed.Username != "" {
+		t.Errorf("unexpected credentials: token=%q username=%q", authed.OAuthToken, authed.Username)
+	}
+
+	if _, err := cli.WithAuthenticator(&auth.OAuthClient{}); err == nil {
+		t.Error("expected an error for an unsupported authenticator")
+	}
+}
@@ -0,0 +1,125 @@
+package bitbucketcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const eventTypeHeader = "X-Event-Key"
+
+// WebhookEventType returns the type of the webhook event sent in the given
+// request, such as "pullrequest:approved".
+func WebhookEventType(r *http.Request) string {
+	return r.Header.Get(eventTypeHeader)
+}
+
+// ParseWebhookEvent parses the payload of a webhook event of the given type.
+// Only pull request and commit status events are supported.
+func ParseWebhookEvent(eventType string, payload []byte) (e interface{}, err error) {
+	switch eventType {
+	case "pullrequest:approved":
+		e = &PullRequestApprovedEvent{}
+	case "pullrequest:unapproved":
+		e = &PullRequestUnapprovedEvent{}
+	case "pullrequest:changes_request_created":
+		e = &PullRequestChangesRequestCreatedEvent{}
+	case "pullrequest:changes_request_removed":
+		e = &PullRequestChangesRequestRemovedEvent{}
+	case "pullrequest:comment_created", "pullrequest:comment_updated", "pullrequest:comment_deleted":
+		e = &PullRequestCommentEvent{}
+	case "pullrequest:created", "pullrequest:updated":
+		e = &PullRequestUpdatedEvent{}
+	case "pullrequest:fulfilled":
+		e = &PullRequestFulfilledEvent{}
+	case "pullrequest:rejected":
+		e = &PullRequestRejectedEvent{}
+	case "repo:commit_status_created", "repo:commit_status_updated":
+		e = &RepoCommitStatusEvent{}
+	default:
+		return nil, errors.Errorf("unknown webhook event type: %q", eventType)
+	}
+
+	return e, json.Unmarshal(payload, e)
+}
+
+// PullRequestEvent contains the fields common to all pull request webhook
+// events.
+type PullRequestEvent struct {
+	Actor       Account     `json:"actor"`
+	PullRequest PullRequest `json:"pullrequest"`
+	Repository  Repo        `json:"repository"`
+}
+
+// Approval is the approval or approval removal by a user included in
+// approval webhook events.
+type Approval struct {
+	Date time.Time `json:"date"`
+	User Account   `json:"user"`
+}
+
+type PullRequestApprovedEvent struct {
+	PullRequestEvent
+	Approval Approval `json:"approval"`
+}
+
+type PullRequestUnapprovedEvent struct {
+	PullRequestEvent
+	Approval Approval `json:"approval"`
+}
+
+func (e *PullRequestUnapprovedEvent) Key() string {
+	return fmt.Sprintf("%s:%s", e.Approval.User.UUID, e.Approval.Date.UTC().Format(time.RFC3339Nano))
+}
+
+type PullRequestChangesRequestCreatedEvent struct {
+	PullRequestEvent
+	ChangesRequest Approval `json:"changes_request"`
+}
+
+type PullRequestChangesRequestRemovedEvent struct {
+	PullRequestEvent
+	ChangesRequest Approval `json:"changes_request"`
+}
+
+func (e *PullRequestChangesRequestRemovedEvent) Key() string {
+	return fmt.Sprintf("%s:%s", e.ChangesRequest.User.UUID, e.ChangesRequest.Date.UTC().Format(time.RFC3339Nano))
+}
+
+type PullRequestCommentEvent struct {
+	PullRequestEvent
+	Comment Comment `json:"comment"`
+}
+
+type PullRequestUpdatedEvent struct {
+	PullRequestEvent
+}
+
+type PullRequestFulfilledEvent struct {
+	PullRequestEvent
+}
+
+func (e *PullRequestFulfilledEvent) Key() string {
+	return strconv.FormatInt(e.PullRequest.ID, 10)
+}
+
+type PullRequestRejectedEvent struct {
+	PullRequestEvent
+}
+
+func (e *PullRequestRejectedEvent) Key() string {
+	return strconv.FormatInt(e.PullRequest.ID, 10)
+}
+
+// RepoCommitStatusEvent is sent when a commit status is created or updated.
+// It does not reference a pull request: the status has to be matched to pull
+// requests through its commit or branch.
+type RepoCommitStatusEvent struct {
+	Actor        Account           `json:"actor"`
+	Repository   Repo              `json:"repository"`
+	CommitStatus PullRequestStatus `json:"commit_status"`
+}
@@ -0,0 +1,59 @@
+package bitbucketcloud
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWebhookEvent(t *testing.T) {
+	t.Run("approved", func(t *testing.T) {
+		payload := []byte(`{
+			"actor": {"uuid": "{actor}"},
+			"pullrequest": {"id": 42, "state": "OPEN"},
+			"repository": {"full_name": "sglocal/mux", "uuid": "{repo}"},
+			"approval": {"date": "2021-12-01T10:00:00Z", "user": {"uuid": "{reviewer}"}}
+		}`)
+
+		e, err := ParseWebhookEvent("pullrequest:approved", payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ev, ok := e.(*PullRequestApprovedEvent)
+		if !ok {
+			t.Fatalf("unexpected event type: %T", e)
+		}
+		if ev.PullRequest.ID != 42 || ev.Repository.UUID != "{repo}" || ev.Approval.User.UUID != "{reviewer}" {
+			t.Errorf("unexpected event: %+v", ev)
+		}
+		if want := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC); !ev.Approval.Date.Equal(want) {
+			t.Errorf("unexpected approval date: %s", ev.Approval.Date)
+		}
+	})
+
+	t.Run("commit status", func(t *testing.T) {
+		payload := []byte(`{
+			"repository": {"full_name": "sglocal/mux", "uuid": "{repo}"},
+			"commit_status": {"key": "ci", "state": "INPROGRESS", "refname": "feature", "commit": {"hash": "abc"}}
+		}`)
+
+		e, err := ParseWebhookEvent("repo:commit_status_updated", payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ev, ok := e.(*RepoCommitStatusEvent)
+		if !ok {
+			t.Fatalf("unexpected event type: %T", e)
+		}
+		if have, want := ev.CommitStatus.Key(), "abc:ci"; have != want {
+			t.Errorf("unexpected key: have=%q want=%q", have, want)
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		if _, err := ParseWebhookEvent("repo:push", []byte(`{}`)); err == nil {
+			t.Error("expected an error for an unknown event type")
+		}
+	})
+}
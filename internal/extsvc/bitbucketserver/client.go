@@ -505,6 +505,10 @@ type UpdatePullRequestInput struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	ToRef       Ref    `json:"toRef"`
+
+	// Reviewers, if not empty, replaces the reviewers of the pull request with
+	// the users with the given names.
+	Reviewers []string `json:"-"`
 }
 
 func (c *Client) UpdatePullRequest(ctx context.Context, in *UpdatePullRequestInput) (*PullRequest, error) {
@@ -515,11 +519,39 @@ func (c *Client) UpdatePullRequest(ctx context.Context, in *UpdatePullRequestInp
 		in.PullRequestID,
 	)
 
+	payload := struct {
+		*UpdatePullRequestInput
+		Reviewers []reviewerInput `json:"reviewers,omitempty"`
+	}{
+		UpdatePullRequestInput: in,
+		Reviewers:              newReviewerInputs(in.Reviewers),
+	}
+
 	pr := &PullRequest{}
-	_, err := c.send(ctx, "PUT", path, nil, in, pr)
+	_, err := c.send(ctx, "PUT", path, nil, payload, pr)
 	return pr, err
 }
 
+// reviewerInput is a minimal version of Reviewer, to reduce payload size sent.
+type reviewerInput struct {
+	User struct {
+		Name string `json:"name"`
+	} `json:"user"`
+}
+
+func newReviewerInputs(names []string) []reviewerInput {
+	if len(names) == 0 {
+		return nil
+	}
+	reviewers := make([]reviewerInput, 0, len(names))
+	for _, name := range names {
+		var r reviewerInput
+		r.User.Name = name
+		reviewers = append(reviewers, r)
+	}
+	return reviewers
+}
+
 // ErrAlreadyExists is returned by Client.CreatePullRequest when a Pull Request
 // for the given FromRef and ToRef already exists.
 type ErrAlreadyExists struct {
@@ -531,6 +563,8 @@ func (e ErrAlreadyExists) Error() string {
 }
 
 // CreatePullRequest creates the given PullRequest returning an error in case of failure.
+// Reviews are requested from the default reviewers of the repository, as well
+// as from the users in the Reviewers of the given PullRequest.
 func (c *Client) CreatePullRequest(ctx context.Context, pr *PullRequest) error {
 	for _, namedRef := range [...]struct {
 		name string
@@ -550,23 +584,16 @@ func (c *Client) CreatePullRequest(ctx context.Context, pr *PullRequest) error {
 		}
 	}
 
-	// Minimal version of Reviewer, to reduce payload size sent.
-	type reviewer struct {
-		User struct {
-			Name string `json:"name"`
-		} `json:"user"`
-	}
-
 	type requestBody struct {
-		Title       string     `json:"title"`
-		Description string     `json:"description"`
-		State       string     `json:"state"`
-		Open        bool       `json:"open"`
-		Closed      bool       `json:"closed"`
-		FromRef     Ref        `json:"fromRef"`
-		ToRef       Ref        `json:"toRef"`
-		Locked      bool       `json:"locked"`
-		Reviewers   []reviewer `json:"reviewers"`
+		Title       string          `json:"title"`
+		Description string          `json:"description"`
+		State       string          `json:"state"`
+		Open        bool            `json:"open"`
+		Closed      bool            `json:"closed"`
+		FromRef     Ref             `json:"fromRef"`
+		ToRef       Ref             `json:"toRef"`
+		Locked      bool            `json:"locked"`
+		Reviewers   []reviewerInput `json:"reviewers"`
 	}
 
 	defaultReviewers, err := c.FetchDefaultReviewers(ctx, pr)
@@ -577,11 +604,23 @@ func (c *Client) CreatePullRequest(ctx context.Context, pr *PullRequest) error {
 		// return errors.Wrap(err, "fetching default reviewers")
 	}
 
-	reviewers := make([]reviewer, 0, len(defaultReviewers))
-	for _, r := range defaultReviewers {
-		reviewers = append(reviewers, reviewer{User: struct {
-			Name string `json:"name"`
-		}{Name: r}})
+	names := defaultReviewers
+	seen := make(map[string]struct{}, len(defaultReviewers))
+	for _, name := range defaultReviewers {
+		seen[name] = struct{}{}
+	}
+	for _, r := range pr.Reviewers {
+		if r.User == nil {
+			continue
+		}
+		if _, ok := seen[r.User.Name]; !ok {
+			names = append(names, r.User.Name)
+			seen[r.User.Name] = struct{}{}
+		}
+	}
+	reviewers := newReviewerInputs(names)
+	if reviewers == nil {
+		reviewers = []reviewerInput{}
 	}
 
 	// Bitbucket Server doesn't support GFM taskitems. But since we might add
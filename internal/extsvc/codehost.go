@@ -24,10 +24,18 @@ var (
 	MavenURL    = &url.URL{Host: "maven"}
 	JVMPackages = NewCodeHost(MavenURL, TypeJVMPackages)
 
+	NPMURL      = &url.URL{Host: "npm"}
+	NPMPackages = NewCodeHost(NPMURL, TypeNPMPackages)
+
+	GoURL     = &url.URL{Host: "go"}
+	GoModules = NewCodeHost(GoURL, TypeGoModules)
+
 	PublicCodeHosts = []*CodeHost{
 		GitHubDotCom,
 		GitLabDotCom,
 		JVMPackages,
+		NPMPackages,
+		GoModules,
 	}
 )
 
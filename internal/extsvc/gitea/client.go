@@ -0,0 +1,315 @@
+//nolint:bodyclose // Body is closed in Client.do, but the response is still returned to provide access to the headers
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/peterhellberg/link"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	defaultRateLimit      = rate.Limit(8) // 480/min or 28,800/hr
+	defaultRateLimitBurst = 500
+
+	// DefaultPerPage is the page size used by list calls unless specified
+	// otherwise. Gitea caps page sizes at its MAX_RESPONSE_ITEMS setting, which
+	// defaults to 50.
+	DefaultPerPage = 50
+)
+
+// Client access a Gitea instance via the REST API. The API of Gogs is a subset
+// of the Gitea API, so the client works for Gogs instances as well.
+type Client struct {
+	// HTTP Client used to communicate with the API
+	httpClient httpcli.Doer
+
+	// Config is the code host connection config for this client
+	Config *schema.GiteaConnection
+
+	// URL is the base URL of Gitea.
+	URL *url.URL
+
+	// RateLimit is the self-imposed rate limiter (since Gitea does not have a concept
+	// of rate limiting in HTTP response headers).
+	RateLimit *rate.Limiter
+
+	// sudo is the username of the user to impersonate, if any. See Sudo.
+	sudo string
+}
+
+// NewClient returns an authenticated Gitea API client with the provided
+// configuration. If a nil httpClient is provided, httpcli.ExternalDoer will be
+// used.
+func NewClient(config *schema.GiteaConnection, httpClient httpcli.Doer) (*Client, error) {
+	u, err := url.Parse(config.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+
+	// Normally our registry will return a default infinite limiter when nothing has been
+	// synced from config. However, we always want to ensure there is at least some form of rate
+	// limiting for Gitea.
+	defaultLimiter := rate.NewLimiter(defaultRateLimit, defaultRateLimitBurst)
+	l := ratelimit.DefaultRegistry.GetOrSet(u.String(), defaultLimiter)
+
+	return &Client{
+		httpClient: httpClient,
+		Config:     config,
+		URL:        u,
+		RateLimit:  l,
+	}, nil
+}
+
+// Sudo returns a copy of the client that impersonates the given user. This
+// requires the client's token to belong to a site admin.
+func (c *Client) Sudo(username string) *Client {
+	sudo := *c
+	sudo.sudo = username
+	return &sudo
+}
+
+// Page is the position of a paginated list call. Pages are 1-based.
+type Page struct {
+	Page    int
+	PerPage int
+}
+
+// FirstPage returns the first page of a list call with the given page size.
+func FirstPage(perPage int) *Page {
+	if perPage <= 0 {
+		perPage = DefaultPerPage
+	}
+	return &Page{Page: 1, PerPage: perPage}
+}
+
+func (p *Page) encodeTo(qs url.Values) {
+	qs.Set("page", strconv.Itoa(p.Page))
+	qs.Set("limit", strconv.Itoa(p.PerPage))
+}
+
+// next returns the page after a page that returned n items with the given
+// response headers, or nil if it was the last page. Gitea silently caps the
+// page size at its MAX_RESPONSE_ITEMS setting, so a page with fewer items than
+// requested is not necessarily the last one. Instead, the Link header or, if
+// missing, the X-Total-Count header determine whether there is a next page.
+// Gogs sends neither, in which case only an empty page is the last one.
+func (p *Page) next(header http.Header, n int) *Page {
+	if n == 0 {
+		return nil
+	}
+	if l := header.Get("Link"); l != "" {
+		if link.Parse(l)["next"] == nil {
+			return nil
+		}
+	} else if total, err := strconv.Atoi(header.Get("X-Total-Count")); err == nil && p.Page*n >= total {
+		return nil
+	}
+	return &Page{Page: p.Page + 1, PerPage: p.PerPage}
+}
+
+// User is a Gitea user or organization.
+type User struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	FullName  string `json:"full_name,omitempty"`
+	Email     string `json:"email,omitempty"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+	IsAdmin   bool   `json:"is_admin,omitempty"`
+}
+
+// Repository is a Gitea repository.
+type Repository struct {
+	ID            int64       `json:"id"`
+	Owner         *User       `json:"owner"`
+	Name          string      `json:"name"`
+	FullName      string      `json:"full_name"`
+	Description   string      `json:"description"`
+	Private       bool        `json:"private"`
+	Fork          bool        `json:"fork"`
+	Archived      bool        `json:"archived"`
+	Mirror        bool        `json:"mirror"`
+	Parent        *Repository `json:"parent,omitempty"`
+	HTMLURL       string      `json:"html_url"`
+	CloneURL      string      `json:"clone_url"`
+	SSHURL        string      `json:"ssh_url"`
+	DefaultBranch string      `json:"default_branch"`
+}
+
+// ListAffiliatedRepos lists the repositories owned by the authenticated user,
+// the repositories of the organizations they are a member of and the
+// repositories they collaborate on. It returns the next page, or nil if there
+// are no more pages.
+func (c *Client) ListAffiliatedRepos(ctx context.Context, page *Page) ([]*Repository, *Page, error) {
+	return c.listRepos(ctx, "api/v1/user/repos", page)
+}
+
+// ListOrgRepos lists the repositories of an organization. It returns the next
+// page, or nil if there are no more pages.
+func (c *Client) ListOrgRepos(ctx context.Context, org string, page *Page) ([]*Repository, *Page, error) {
+	return c.listRepos(ctx, "api/v1/orgs/"+url.PathEscape(org)+"/repos", page)
+}
+
+func (c *Client) listRepos(ctx context.Context, path string, page *Page) ([]*Repository, *Page, error) {
+	qs := make(url.Values)
+	page.encodeTo(qs)
+
+	req, err := http.NewRequest("GET", (&url.URL{Path: path, RawQuery: qs.Encode()}).String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var repos []*Repository
+	resp, err := c.do(ctx, req, &repos)
+	if err != nil {
+		return nil, nil, err
+	}
+	return repos, page.next(resp.Header, len(repos)), nil
+}
+
+// SearchRepos searches the repositories visible to the authenticated user by
+// keyword. An empty keyword returns all visible repositories. It returns the
+// next page, or nil if there are no more pages.
+func (c *Client) SearchRepos(ctx context.Context, keyword string, page *Page) ([]*Repository, *Page, error) {
+	qs := make(url.Values)
+	page.encodeTo(qs)
+	if keyword != "" {
+		qs.Set("q", keyword)
+	}
+
+	req, err := http.NewRequest("GET", (&url.URL{Path: "api/v1/repos/search", RawQuery: qs.Encode()}).String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp struct {
+		OK   bool          `json:"ok"`
+		Data []*Repository `json:"data"`
+	}
+	res, err := c.do(ctx, req, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Data, page.next(res.Header, len(resp.Data)), nil
+}
+
+// GetRepo returns the repository with the given owner and name.
+func (c *Client) GetRepo(ctx context.Context, owner, name string) (*Repository, error) {
+	req, err := http.NewRequest("GET", "api/v1/repos/"+url.PathEscape(owner)+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var repo Repository
+	if _, err := c.do(ctx, req, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// GetUser returns the user with the given username.
+func (c *Client) GetUser(ctx context.Context, username string) (*User, error) {
+	req, err := http.NewRequest("GET", "api/v1/users/"+url.PathEscape(username), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	if _, err := c.do(ctx, req, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetAuthenticatedUser returns the user the client is authenticated as.
+func (c *Client) GetAuthenticatedUser(ctx context.Context) (*User, error) {
+	req, err := http.NewRequest("GET", "api/v1/user", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	if _, err := c.do(ctx, req, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (c *Client) do(ctx context.Context, req *http.Request, result interface{}) (*http.Response, error) {
+	req.URL = c.URL.ResolveReference(req.URL)
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+
+	if c.Config.Token != "" {
+		req.Header.Set("Authorization", "token "+c.Config.Token)
+	}
+	if c.sudo != "" {
+		req.Header.Set("Sudo", c.sudo)
+	}
+
+	startWait := time.Now()
+	if err := c.RateLimit.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	if d := time.Since(startWait); d > 200*time.Millisecond {
+		log15.Warn("Gitea self-enforced API rate limit: request delayed longer than expected due to rate limit", "delay", d)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return nil, errors.WithStack(&httpError{
+			URL:        req.URL,
+			StatusCode: resp.StatusCode,
+			Body:       bs,
+		})
+	}
+
+	return resp, json.Unmarshal(bs, result)
+}
+
+type httpError struct {
+	StatusCode int
+	URL        *url.URL
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("Gitea API HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *httpError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,146 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient(&schema.GiteaConnection{Url: srv.URL, Token: "secret"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func TestClient_ListOrgRepos(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if have, want := r.URL.Path, "/api/v1/orgs/sourcegraph/repos"; have != want {
+			t.Errorf("unexpected path: have %q, want %q", have, want)
+		}
+		if have, want := r.Header.Get("Authorization"), "token secret"; have != want {
+			t.Errorf("unexpected authorization header: have %q, want %q", have, want)
+		}
+		if have, want := r.URL.Query().Get("limit"), "3"; have != want {
+			t.Errorf("unexpected limit: have %q, want %q", have, want)
+		}
+		// The server caps pages at two items, which is less than requested, and
+		// links to the next page.
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Link", `<http://gitea/api/v1/orgs/sourcegraph/repos?limit=2&page=2>; rel="next",<http://gitea/api/v1/orgs/sourcegraph/repos?limit=2&page=2>; rel="last"`)
+			fmt.Fprint(w, `[{"id":1,"full_name":"sourcegraph/a"},{"id":2,"full_name":"sourcegraph/b"}]`)
+		case "2":
+			w.Header().Set("Link", `<http://gitea/api/v1/orgs/sourcegraph/repos?limit=2&page=1>; rel="first",<http://gitea/api/v1/orgs/sourcegraph/repos?limit=2&page=1>; rel="prev"`)
+			fmt.Fprint(w, `[{"id":3,"full_name":"sourcegraph/c","fork":true,"archived":true}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	var names []string
+	for page := FirstPage(3); page != nil; {
+		var repos []*Repository
+		var err error
+		repos, page, err = cli.ListOrgRepos(context.Background(), "sourcegraph", page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range repos {
+			names = append(names, r.FullName)
+		}
+	}
+
+	if diff := cmp.Diff([]string{"sourcegraph/a", "sourcegraph/b", "sourcegraph/c"}, names); diff != "" {
+		t.Fatalf("unexpected repos (-want +got):\n%s", diff)
+	}
+}
+
+func TestPage_next(t *testing.T) {
+	page := &Page{Page: 2, PerPage: 50}
+	header := func(kv ...string) http.Header {
+		h := make(http.Header)
+		for i := 0; i < len(kv); i += 2 {
+			h.Set(kv[i], kv[i+1])
+		}
+		return h
+	}
+
+	for _, tc := range []struct {
+		name   string
+		header http.Header
+		n      int
+		want   *Page
+	}{
+		{"empty page", header("X-Total-Count", "100"), 0, nil},
+		{"link to next page", header("Link", `<https://gitea.example.com/api/v1/user/repos?page=3>; rel="next"`), 20, &Page{Page: 3, PerPage: 50}},
+		{"no link to next page", header("Link", `<https://gitea.example.com/api/v1/user/repos?page=1>; rel="prev"`), 20, nil},
+		{"items left", header("X-Total-Count", "45"), 20, &Page{Page: 3, PerPage: 50}},
+		{"no items left", header("X-Total-Count", "40"), 20, nil},
+		{"no headers", header(), 20, &Page{Page: 3, PerPage: 50}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, page.next(tc.header, tc.n)); diff != "" {
+				t.Fatalf("unexpected next page (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClient_SearchRepos(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if have, want := r.URL.Query().Get("q"), "sourcegraph"; have != want {
+			t.Errorf("unexpected keyword: have %q, want %q", have, want)
+		}
+		w.Header().Set("X-Total-Count", "1")
+		fmt.Fprint(w, `{"ok":true,"data":[{"id":1,"full_name":"sourcegraph/a"}]}`)
+	})
+
+	repos, next, err := cli.SearchRepos(context.Background(), "sourcegraph", FirstPage(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != nil {
+		t.Errorf("expected no next page, got %+v", next)
+	}
+	if len(repos) != 1 || repos[0].ID != 1 {
+		t.Fatalf("unexpected repos: %+v", repos)
+	}
+}
+
+func TestClient_Sudo(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if have, want := r.Header.Get("Sudo"), "alice"; have != want {
+			t.Errorf("unexpected sudo header: have %q, want %q", have, want)
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	if _, _, err := cli.Sudo("alice").ListAffiliatedRepos(context.Background(), FirstPage(0)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_GetRepo_NotFound(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+	})
+
+	_, err := cli.GetRepo(context.Background(), "sourcegraph", "missing")
+	if !errcode.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
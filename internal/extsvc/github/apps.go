@@ -0,0 +1,267 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// GitHubAppAuthenticator authenticates requests as a GitHub App with JSON Web
+// Tokens signed by a private key of the app. Only the endpoints of the GitHub
+// App API accept these tokens, most notably the endpoint to create installation
+// access tokens.
+//
+// API docs: https://docs.github.com/en/developers/apps/building-github-apps/authenticating-with-github-apps#authenticating-as-a-github-app
+type GitHubAppAuthenticator struct {
+	appID  int64
+	key    *rsa.PrivateKey
+	rawKey []byte
+}
+
+var _ auth.Authenticator = &GitHubAppAuthenticator{}
+
+// NewGitHubAppAuthenticator returns a GitHubAppAuthenticator for the GitHub App
+// with the given ID. privateKey must be a PEM-encoded RSA private key of the
+// app.
+func NewGitHubAppAuthenticator(appID int64, privateKey []byte) (*GitHubAppAuthenticator, error) {
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return nil, errors.New("failed to decode PEM block of the private key")
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		parsed, pkcs8Err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if pkcs8Err != nil {
+			return nil, errors.Wrap(err, "parse private key")
+		}
+		var ok bool
+		if key, ok = parsed.(*rsa.PrivateKey); !ok {
+			return nil, errors.Errorf("unexpected private key type %T, want an RSA key", parsed)
+		}
+	}
+
+	return &GitHubAppAuthenticator{
+		appID:  appID,
+		key:    key,
+		rawKey: privateKey,
+	}, nil
+}
+
+// Authenticate sets a freshly signed JSON Web Token as the bearer token of the
+// request.
+func (a *GitHubAppAuthenticator) Authenticate(r *http.Request) error {
+	token, err := a.signJWT(time.Now())
+	if err != nil {
+		return err
+	}
+	r.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (a *GitHubAppAuthenticator) Hash() string {
+	shaSum := sha256.Sum256(append([]byte(strconv.FormatInt(a.appID, 10)+":"), a.rawKey...))
+	return hex.EncodeToString(shaSum[:])
+}
+
+// signJWT returns a JSON Web Token signed with RS256 that identifies the app.
+// GitHub rejects tokens that are valid for more than 10 minutes, and the token
+// is backdated by a minute to allow for clock drift.
+func (a *GitHubAppAuthenticator) signJWT(now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": strconv.FormatInt(a.appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, a.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", errors.Wrap(err, "sign JWT")
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// InstallationAccessToken is a token that authenticates requests as an
+// installation of a GitHub App.
+type InstallationAccessToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CreateAppInstallationAccessToken creates an access token for the installation
+// with the given ID. The client must be authenticated with a
+// GitHubAppAuthenticator of the app the installation belongs to.
+//
+// API docs: https://docs.github.com/en/rest/reference/apps#create-an-installation-access-token-for-an-app
+func (c *V3Client) CreateAppInstallationAccessToken(ctx context.Context, installationID int64) (*InstallationAccessToken, error) {
+	var token InstallationAccessToken
+	if _, err := c.post(ctx, fmt.Sprintf("app/installations/%d/access_tokens", installationID), struct{}{}, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// installationTokenRefreshWindow is how long before their expiry installation
+// access tokens are replaced, so that long-running requests do not fail midway.
+const installationTokenRefreshWindow = 5 * time.Minute
+
+// InstallationAuthenticator authenticates requests as an installation of a
+// GitHub App. Installation access tokens expire after an hour, so new tokens are
+// created with the app's client whenever the current one is about to expire.
+//
+// Its Hash is derived from the app and installation rather than from the current
+// token, so that clients using the same installation share the repository cache
+// and the API rate limit monitor across token refreshes.
+type InstallationAuthenticator struct {
+	installationID int64
+	appClient      *V3Client
+
+	mu    sync.Mutex
+	token *InstallationAccessToken
+}
+
+var _ auth.Authenticator = &InstallationAuthenticator{}
+
+// NewInstallationAuthenticator returns an InstallationAuthenticator for the
+// installation with the given ID. appClient must be authenticated with a
+// GitHubAppAuthenticator of the app the installation belongs to.
+func NewInstallationAuthenticator(appClient *V3Client, installationID int64) *InstallationAuthenticator {
+	return &InstallationAuthenticator{
+		installationID: installationID,
+		appClient:      appClient,
+	}
+}
+
+func (a *InstallationAuthenticator) Authenticate(r *http.Request) error {
+	token, err := a.Token(r.Context())
+	if err != nil {
+		return err
+	}
+	r.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (a *InstallationAuthenticator) Hash() string {
+	shaSum := sha256.Sum256([]byte(fmt.Sprintf("installation:%d:%s", a.installationID, a.appClient.auth.Hash())))
+	return hex.EncodeToString(shaSum[:])
+}
+
+// Token returns a valid installation access token, creating a new one if
+// there is none yet or the current one is about to expire.
+func (a *InstallationAuthenticator) Token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != nil && time.Until(a.token.ExpiresAt) > installationTokenRefreshWindow {
+		return a.token.Token, nil
+	}
+
+	token, err := a.appClient.CreateAppInstallationAccessToken(ctx, a.installationID)
+	if err != nil {
+		return "", errors.Wrap(err, "create installation access token")
+	}
+	a.token = token
+	return token.Token, nil
+}
+
+// connectionAuthenticator is an InstallationAuthenticator shared by the clients
+// of an external service, together with the key of the configuration it was
+// created from.
+type connectionAuthenticator struct {
+	key string
+	*InstallationAuthenticator
+}
+
+var installationAuthenticators = struct {
+	sync.Mutex
+	m map[int64]connectionAuthenticator
+}{m: make(map[int64]connectionAuthenticator)}
+
+// ConnectionAPIURL returns the API URL of the GitHub instance of the
+// connection. Clients of the same external service must use it, so that
+// NewConnectionAuthenticator hands them the same authenticator.
+func ConnectionAPIURL(c *schema.GitHubConnection) (*url.URL, error) {
+	baseURL, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing GitHub URL %q", c.Url)
+	}
+	apiURL, _ := APIRoot(extsvc.NormalizeBaseURL(baseURL))
+	return apiURL, nil
+}
+
+// NewConnectionAuthenticator returns the authenticator configured in the GitHub
+// connection of the external service with the given ID. If the connection
+// authenticates as a GitHub App installation, the returned
+// InstallationAuthenticator is shared with all other clients of the external
+// service, so that they reuse its access tokens. It is replaced once the
+// configuration of the external service changes. Connections that are not
+// stored yet, such as the ones being validated, have an externalServiceID of 0
+// and never share an authenticator.
+func NewConnectionAuthenticator(externalServiceID int64, apiURL *url.URL, c *schema.GitHubConnection, cli httpcli.Doer) (auth.Authenticator, error) {
+	if c.GithubAppInstallation == nil {
+		return &auth.OAuthBearerToken{Token: c.Token}, nil
+	}
+
+	appAuth, err := NewGitHubAppAuthenticator(int64(c.GithubAppInstallation.AppID), []byte(c.GithubAppInstallation.PrivateKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "GitHub App authentication")
+	}
+
+	newAuthenticator := func() *InstallationAuthenticator {
+		return NewInstallationAuthenticator(NewV3Client(apiURL, appAuth, cli), int64(c.GithubAppInstallation.InstallationID))
+	}
+	if externalServiceID == 0 {
+		return newAuthenticator(), nil
+	}
+
+	key := fmt.Sprintf("%s:%d:%s", apiURL, c.GithubAppInstallation.InstallationID, appAuth.Hash())
+
+	installationAuthenticators.Lock()
+	defer installationAuthenticators.Unlock()
+
+	a, ok := installationAuthenticators.m[externalServiceID]
+	if !ok || a.key != key {
+		a = connectionAuthenticator{key: key, InstallationAuthenticator: newAuthenticator()}
+		installationAuthenticators.m[externalServiceID] = a
+	}
+	return a.InstallationAuthenticator, nil
+}
+
+// EvictConnectionAuthenticator removes the authenticator shared by the clients
+// of the external service with the given ID. It must be called when the
+// external service is updated or deleted.
+func EvictConnectionAuthenticator(externalServiceID int64) {
+	installationAuthenticators.Lock()
+	delete(installationAuthenticators.m, externalServiceID)
+	installationAuthenticators.Unlock()
+}
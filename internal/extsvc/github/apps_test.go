@@ -0,0 +1,226 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func newTestGitHubAppAuthenticator(t *testing.T) (*GitHubAppAuthenticator, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	a, err := NewGitHubAppAuthenticator(1234, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a, key
+}
+
+func TestGitHubAppAuthenticator(t *testing.T) {
+	a, key := newTestGitHubAppAuthenticator(t)
+
+	now := time.Now()
+	token, err := a.signJWT(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("unexpected JWT %q", token)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		t.Fatalf("invalid signature: %s", err)
+	}
+
+	rawClaims, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var claims struct {
+		IssuedAt  int64  `json:"iat"`
+		ExpiresAt int64  `json:"exp"`
+		Issuer    string `json:"iss"`
+	}
+	if err := json.Unmarshal(rawClaims, &claims); err != nil {
+		t.Fatal(err)
+	}
+	if claims.Issuer != "1234" {
+		t.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+	if claims.IssuedAt > now.Unix() || claims.ExpiresAt-claims.IssuedAt > int64((10*time.Minute).Seconds()) {
+		t.Errorf("unexpected validity: iat=%d exp=%d", claims.IssuedAt, claims.ExpiresAt)
+	}
+
+	if _, err := NewGitHubAppAuthenticator(1234, []byte("not a key")); err == nil {
+		t.Error("expected an error for an invalid private key")
+	}
+}
+
+func TestInstallationAuthenticator(t *testing.T) {
+	appAuth, _ := newTestGitHubAppAuthenticator(t)
+
+	var minted int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/app/installations/42/access_tokens":
+			if r.Method != "POST" {
+				t.Errorf("unexpected method %s", r.Method)
+			}
+			if have := r.Header.Get("Authorization"); strings.Count(have, ".") != 2 {
+				t.Errorf("expected a JWT, have %q", have)
+			}
+			minted++
+			// The first token expires within the refresh window, so a new one
+			// is created for the next request.
+			expiresAt := time.Now().Add(time.Minute)
+			if minted > 1 {
+				expiresAt = time.Now().Add(time.Hour)
+			}
+			fmt.Fprintf(w, `{"token": "token-%d", "expires_at": %q}`, minted, expiresAt.Format(time.RFC3339))
+		case "/installation/repositories":
+			want := fmt.Sprintf("Bearer token-%d", minted)
+			if have := r.Header.Get("Authorization"); have != want {
+				t.Errorf("unexpected authorization header: want %q, have %q", want, have)
+			}
+			fmt.Fprintf(w, `{"total_count": 101, "repositories": [{"full_name": "sourcegraph/repo-%s"}]}`, r.URL.Query().Get("page"))
+		default:
+			t.Errorf("unexpected request path: %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	apiURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewInstallationAuthenticator(NewV3Client(apiURL, appAuth, nil), 42)
+	client := NewV3Client(apiURL, a, nil)
+
+	for page, wantNext := range map[int]bool{1: true, 2: false} {
+		repos, hasNext, _, err := client.ListInstallationRepositories(context.Background(), page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hasNext != wantNext {
+			t.Errorf("page %d: unexpected hasNext %v", page, hasNext)
+		}
+		if want := fmt.Sprintf("sourcegraph/repo-%d", page); len(repos) != 1 || repos[0].NameWithOwner != want {
+			t.Errorf("page %d: unexpected repos %+v", page, repos)
+		}
+	}
+
+	if _, err := a.Token(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if minted != 2 {
+		t.Errorf("unexpected number of minted tokens: %d", minted)
+	}
+
+	// The hash must not change when the token is refreshed, so that the rate
+	// limit monitor is tracked per installation.
+	if have, want := a.Hash(), NewInstallationAuthenticator(NewV3Client(apiURL, appAuth, nil), 42).Hash(); have != want {
+		t.Errorf("hash changed across tokens: %q != %q", have, want)
+	}
+	if a.Hash() == NewInstallationAuthenticator(NewV3Client(apiURL, appAuth, nil), 43).Hash() {
+		t.Error("installations have the same hash")
+	}
+}
+
+func TestNewConnectionAuthenticator(t *testing.T) {
+	_, key := newTestGitHubAppAuthenticator(t)
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	apiURL, _ := url.Parse("https://api.github.com")
+
+	newConnection := func(installationID int) *schema.GitHubConnection {
+		return &schema.GitHubConnection{
+			GithubAppInstallation: &schema.GitHubAppInstallation{AppID: 1234, InstallationID: installationID, PrivateKey: privateKey},
+		}
+	}
+	get := func(externalServiceID int64, c *schema.GitHubConnection) auth.Authenticator {
+		t.Helper()
+		a, err := NewConnectionAuthenticator(externalServiceID, apiURL, c, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}
+	t.Cleanup(func() {
+		EvictConnectionAuthenticator(1)
+		EvictConnectionAuthenticator(2)
+	})
+
+	a := get(1, newConnection(42))
+	if get(1, newConnection(42)) != a {
+		t.Error("expected the authenticator to be shared by clients of the same external service")
+	}
+	if get(2, newConnection(42)) == a {
+		t.Error("expected external services to not share authenticators")
+	}
+	if get(0, newConnection(42)) == get(0, newConnection(42)) {
+		t.Error("expected unstored connections to not share authenticators")
+	}
+
+	// The authenticator is replaced once the configuration changes.
+	updated := get(1, newConnection(43))
+	if updated == a {
+		t.Error("expected a new authenticator for the updated configuration")
+	}
+
+	EvictConnectionAuthenticator(1)
+	if get(1, newConnection(43)) == updated {
+		t.Error("expected a new authenticator after eviction")
+	}
+}
+
+func TestConnectionAPIURL(t *testing.T) {
+	for rawURL, want := range map[string]string{
+		"https://github.com":       "https://api.github.com/",
+		"https://GitHub.com/":      "https://api.github.com/",
+		"https://ghe.example.com":  "https://ghe.example.com/api/v3",
+		"https://ghe.example.com/": "https://ghe.example.com/api/v3",
+		"https://example.com/ghe/": "https://example.com/ghe/api",
+		"https://example.com/ghe":  "https://example.com/ghe/api",
+	} {
+		apiURL, err := ConnectionAPIURL(&schema.GitHubConnection{Url: rawURL})
+		if err != nil {
+			t.Fatalf("%s: %s", rawURL, err)
+		}
+		if apiURL.String() != want {
+			t.Errorf("%s: want %s, have %s", rawURL, want, apiURL)
+		}
+	}
+
+	if _, err := ConnectionAPIURL(&schema.GitHubConnection{Url: "://"}); err == nil {
+		t.Error("expected an error for an invalid URL")
+	}
+}
@@ -1254,6 +1254,7 @@ fragment timelineItems on PullRequestTimelineItems {
     requestedTeam: requestedReviewer {
       ... on Team {
         name
+        slug
         url
         avatarUrl
       }
@@ -1270,6 +1271,7 @@ fragment timelineItems on PullRequestTimelineItems {
     requestedTeam: requestedReviewer {
       ... on Team {
         name
+        slug
         url
         avatarUrl
       }
@@ -1663,12 +1665,30 @@ type Repository struct {
 	StargazerCount int `json:",omitempty"`
 	ForkCount      int `json:",omitempty"`
 
+	// RepositoryTopics are the topics the repository is tagged with, which
+	// can be used to filter repositories in search.
+	RepositoryTopics *RepositoryTopics `json:",omitempty"`
+
 	// This is available for GitHub Enterprise Cloud and GitHub Enterprise Server 3.3.0+ and is used
 	// to identify if a repository is public or private or internal.
 	// https://developer.github.com/changes/2019-12-03-internal-visibility-changes/#repository-visibility-fields
 	Visibility Visibility `json:",omitempty"`
 }
 
+// RepositoryTopics is the connection of topics of a repository, as returned by
+// the GraphQL API.
+type RepositoryTopics struct {
+	Nodes []RepositoryTopic
+}
+
+type RepositoryTopic struct {
+	Topic Topic
+}
+
+type Topic struct {
+	Name string
+}
+
 func ownerNameCacheKey(owner, name string) string       { return "0:" + owner + "/" + name }
 func nameWithOwnerCacheKey(nameWithOwner string) string { return "0:" + nameWithOwner }
 func nodeIDCacheKey(id string) string                   { return "1:" + id }
@@ -1739,6 +1759,7 @@ type restRepository struct {
 	Stars       int                       `json:"stargazers_count"`
 	Forks       int                       `json:"forks_count"`
 	Visibility  string                    `json:"visibility"`
+	Topics      []string                  `json:"topics"`
 }
 
 // getRepositoryFromAPI attempts to fetch a repository from the GitHub API without use of the redis cache.
@@ -1780,6 +1801,13 @@ func convertRestRepo(restRepo restRepository) *Repository {
 		repo.Visibility = Visibility(restRepo.Visibility)
 	}
 
+	if len(restRepo.Topics) > 0 {
+		repo.RepositoryTopics = &RepositoryTopics{}
+		for _, name := range restRepo.Topics {
+			repo.RepositoryTopics.Nodes = append(repo.RepositoryTopics.Nodes, RepositoryTopic{Topic: Topic{Name: name}})
+		}
+	}
+
 	return &repo
 }
 
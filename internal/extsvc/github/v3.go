@@ -1,6 +1,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -161,7 +162,29 @@ func (c *V3Client) get(ctx context.Context, requestURI string, result interface{
 		req.Header.Add("Accept", "application/vnd.github.nebula-preview+json")
 	}
 
-	err = c.rateLimit.Wait(ctx)
+	return c.request(ctx, req, result)
+}
+
+func (c *V3Client) post(ctx context.Context, requestURI string, payload, result interface{}) (http.Header, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling payload")
+	}
+
+	req, err := http.NewRequest("POST", requestURI, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	// Enable the GitHub App API. See
+	// https://developer.github.com/v3/apps/installations/#list-repositories
+	req.Header.Add("Accept", "application/vnd.github.machine-man-preview+json")
+
+	return c.request(ctx, req, result)
+}
+
+func (c *V3Client) request(ctx context.Context, req *http.Request, result interface{}) (http.Header, error) {
+	err := c.rateLimit.Wait(ctx)
 	if err != nil {
 		// We don't want to return a misleading rate limit exceeded error if the error is coming
 		// from the context.
@@ -640,21 +663,75 @@ func (c *V3Client) ListTopicsOnRepository(ctx context.Context, ownerAndName stri
 	return result.Names, nil
 }
 
+// AddIssueLabels adds the given labels to the issue or pull request with the
+// given number. Labels that don't exist in the repository yet are created.
+//
+// API docs: https://docs.github.com/en/rest/reference/issues#add-labels-to-an-issue
+func (c *V3Client) AddIssueLabels(ctx context.Context, owner, repo string, number int64, labels []string) error {
+	payload := struct {
+		Labels []string `json:"labels"`
+	}{Labels: labels}
+	var result json.RawMessage
+	_, err := c.post(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d/labels", owner, repo, number), payload, &result)
+	return err
+}
+
+// AddIssueAssignees adds the given users as assignees of the issue or pull
+// request with the given number. Users that cannot be assigned are ignored by
+// GitHub.
+//
+// API docs: https://docs.github.com/en/rest/reference/issues#add-assignees-to-an-issue
+func (c *V3Client) AddIssueAssignees(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	payload := struct {
+		Assignees []string `json:"assignees"`
+	}{Assignees: assignees}
+	var result json.RawMessage
+	_, err := c.post(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d/assignees", owner, repo, number), payload, &result)
+	return err
+}
+
+// RequestPullRequestReviewers requests reviews from the given users and teams
+// on the pull request with the given number. Teams are identified by their
+// slug.
+//
+// API docs: https://docs.github.com/en/rest/reference/pulls#request-reviewers-for-a-pull-request
+func (c *V3Client) RequestPullRequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	payload := struct {
+		Reviewers     []string `json:"reviewers,omitempty"`
+		TeamReviewers []string `json:"team_reviewers,omitempty"`
+	}{Reviewers: reviewers, TeamReviewers: teamReviewers}
+	var result json.RawMessage
+	_, err := c.post(ctx, fmt.Sprintf("/repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number), payload, &result)
+	return err
+}
+
 // ListInstallationRepositories lists repositories on which the authenticated
-// GitHub App has been installed.
-func (c *V3Client) ListInstallationRepositories(ctx context.Context) ([]*Repository, error) {
+// GitHub App has been installed. page is the page of results to return, and is
+// 1-indexed (so the first call should be for page 1).
+//
+// API docs: https://docs.github.com/en/rest/reference/apps#list-repositories-accessible-to-the-app-installation
+func (c *V3Client) ListInstallationRepositories(ctx context.Context, page int) (
+	repos []*Repository,
+	hasNextPage bool,
+	rateLimitCost int,
+	err error,
+) {
 	type response struct {
+		TotalCount   int              `json:"total_count"`
 		Repositories []restRepository `json:"repositories"`
 	}
 	var resp response
-	if err := c.requestGet(ctx, "installation/repositories", &resp); err != nil {
-		return nil, err
+	path := fmt.Sprintf("installation/repositories?page=%d&per_page=100", page)
+	if err := c.requestGet(ctx, path, &resp); err != nil {
+		return nil, false, 1, err
 	}
-	repos := make([]*Repository, 0, len(resp.Repositories))
+	repos = make([]*Repository, 0, len(resp.Repositories))
 	for _, restRepo := range resp.Repositories {
 		repos = append(repos, convertRestRepo(restRepo))
 	}
-	return repos, nil
+	c.addRepositoriesToCache(repos)
+
+	return repos, page*100 < resp.TotalCount, 1, nil
 }
 
 // listRepositories is a generic method that unmarshals the given
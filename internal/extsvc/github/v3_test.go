@@ -2,13 +2,17 @@ package github
 
 import (
 	"context"
+	"io"
+	"net/http"
 	"net/url"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/httptestutil"
 	"github.com/sourcegraph/sourcegraph/internal/rcache"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
@@ -499,6 +503,46 @@ func TestListMembers(t *testing.T) {
 	}
 }
 
+func TestV3Client_PullRequestParticipants(t *testing.T) {
+	type request struct {
+		Method, Path, Body string
+	}
+	var requests []request
+	doer := httpcli.DoerFunc(func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request{Method: req.Method, Path: req.URL.Path, Body: string(body)})
+		return &http.Response{
+			Request:    req,
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`[]`)),
+		}, nil
+	})
+	c := newTestClient(t, doer)
+	ctx := context.Background()
+
+	if err := c.AddIssueLabels(ctx, "o", "r", 7, []string{"automation", "security"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AddIssueAssignees(ctx, "o", "r", 7, []string{"alice"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RequestPullRequestReviewers(ctx, "o", "r", 7, []string{"bob"}, []string{"platform"}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []request{
+		{Method: "POST", Path: "/repos/o/r/issues/7/labels", Body: `{"labels":["automation","security"]}`},
+		{Method: "POST", Path: "/repos/o/r/issues/7/assignees", Body: `{"assignees":["alice"]}`},
+		{Method: "POST", Path: "/repos/o/r/pulls/7/requested_reviewers", Body: `{"reviewers":["bob"],"team_reviewers":["platform"]}`},
+	}
+	if diff := cmp.Diff(want, requests); diff != "" {
+		t.Fatalf("unexpected requests (-want +got):\n%s", diff)
+	}
+}
+
 func TestV3Client_WithAuthenticator(t *testing.T) {
 	uri, err := url.Parse("https://github.com")
 	if err != nil {
@@ -42,6 +42,8 @@ type MergeRequest struct {
 	WebURL         string            `json:"web_url"`
 	WorkInProgress bool              `json:"work_in_progress"`
 	Author         User              `json:"author"`
+	Assignees      []User            `json:"assignees"`
+	Reviewers      []User            `json:"reviewers"`
 
 	DiffRefs DiffRefs `json:"diff_refs"`
 
@@ -89,6 +91,11 @@ type CreateMergeRequestOpts struct {
 	TargetBranch string `json:"target_branch"`
 	Title        string `json:"title"`
 	Description  string `json:"description,omitempty"`
+	// Labels is a comma-separated list of label names.
+	Labels      string  `json:"labels,omitempty"`
+	AssigneeIDs []int32 `json:"assignee_ids,omitempty"`
+	// ReviewerIDs requires GitLab 13.8 or later.
+	ReviewerIDs []int32 `json:"reviewer_ids,omitempty"`
 	// TODO: other fields at
 	// https://docs.gitlab.com/ee/api/merge_requests.html#create-mr as needed.
 }
@@ -199,6 +206,13 @@ type UpdateMergeRequestOpts struct {
 	Title        string                       `json:"title"`
 	Description  string                       `json:"description,omitempty"`
 	StateEvent   UpdateMergeRequestStateEvent `json:"state_event,omitempty"`
+	// AddLabels is a comma-separated list of label names to add to the ones
+	// already set on the merge request.
+	AddLabels string `json:"add_labels,omitempty"`
+	// AssigneeIDs and ReviewerIDs replace the assignees and reviewers of the
+	// merge request, unless they are empty.
+	AssigneeIDs []int32 `json:"assignee_ids,omitempty"`
+	ReviewerIDs []int32 `json:"reviewer_ids,omitempty"`
 }
 
 type UpdateMergeRequestStateEvent string
@@ -30,6 +30,7 @@ type Project struct {
 	Archived          bool           `json:"archived"`
 	StarCount         int            `json:"star_count"`
 	ForksCount        int            `json:"forks_count"`
+	Topics            []string       `json:"topics,omitempty"` // Topics of the project, available on GitLab 14.5 and later
 }
 
 type ProjectCommon struct {
@@ -0,0 +1,192 @@
+// Package goproxy implements a client of the GOPROXY protocol, which is served
+// by proxy.golang.org, Athens and directories laid out like a module cache.
+//
+// See https://go.dev/ref/mod#goproxy-protocol.
+package goproxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/mod/module"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// DefaultProxy is the proxy used when the connection doesn't configure any.
+const DefaultProxy = "https://proxy.golang.org"
+
+var (
+	observationContext *observation.Context
+	operations         *Operations
+
+	// HTTPClient is the client used to talk to module proxies.
+	HTTPClient = httpcli.ExternalDoer
+)
+
+func init() {
+	observationContext = &observation.Context{
+		Logger:     log15.Root(),
+		Tracer:     &trace.Tracer{Tracer: opentracing.GlobalTracer()},
+		Registerer: prometheus.DefaultRegisterer,
+	}
+	operations = NewOperations(observationContext)
+}
+
+// Exists reports whether the given version of the module is served by one of
+// the configured proxies.
+func Exists(ctx context.Context, config *schema.GoModulesConnection, dependency reposource.GoDependency) (exists bool, err error) {
+	ctx, endObservation := operations.exists.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.String("dependency", dependency.PackageManagerSyntax()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	rc, err := get(ctx, config, dependency, "info")
+	if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+
+	var info struct{ Version string }
+	if err := json.NewDecoder(rc).Decode(&info); err != nil {
+		return false, errors.Wrapf(err, "failed to decode info of %s", dependency.PackageManagerSyntax())
+	}
+	return true, nil
+}
+
+// FetchZip downloads the zip archive of the given version of the module from
+// the first proxy that serves it. The caller is responsible for closing the
+// returned reader. All files in the archive are prefixed with
+// "<module path>@<version>/".
+func FetchZip(ctx context.Context, config *schema.GoModulesConnection, dependency reposource.GoDependency) (_ io.ReadCloser, err error) {
+	ctx, endObservation := operations.fetchZip.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.String("dependency", dependency.PackageManagerSyntax()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return get(ctx, config, dependency, "zip")
+}
+
+// get fetches the file with the given extension of the module version from the
+// configured proxies in order. Like the go command, the next proxy is only
+// tried if the previous one doesn't have the module version.
+func get(ctx context.Context, config *schema.GoModulesConnection, dependency reposource.GoDependency, ext string) (io.ReadCloser, error) {
+	escapedPath, err := module.EscapePath(dependency.Path)
+	if err != nil {
+		return nil, err
+	}
+	escapedVersion, err := module.EscapeVersion(dependency.Version)
+	if err != nil {
+		return nil, err
+	}
+	path := escapedPath + "/@v/" + escapedVersion + "." + ext
+
+	for _, proxy := range proxyURLs(config) {
+		var rc io.ReadCloser
+		rc, err = getFromProxy(ctx, proxy, path)
+		if err == nil {
+			return rc, nil
+		}
+		if !IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+func getFromProxy(ctx context.Context, proxy, path string) (io.ReadCloser, error) {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid module proxy URL %q", proxy)
+	}
+
+	if u.Scheme == "file" {
+		f, err := os.Open(filepath.Join(filepath.FromSlash(u.Path), filepath.FromSlash(path)))
+		if os.IsNotExist(err) {
+			return nil, &notFoundError{URL: proxy + "/" + path}
+		}
+		return f, err
+	}
+
+	rawURL := proxy + "/" + path
+	req, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := limiter(proxy).Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		// The GOPROXY protocol uses both 404 and 410 to signal that a module
+		// version is unavailable.
+		if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+			return nil, &notFoundError{URL: rawURL, Body: body}
+		}
+		return nil, errors.Errorf("module proxy HTTP error: code=%d url=%q body=%q", resp.StatusCode, rawURL, body)
+	}
+	return resp.Body, nil
+}
+
+func proxyURLs(config *schema.GoModulesConnection) []string {
+	if len(config.Urls) == 0 {
+		return []string{DefaultProxy}
+	}
+	urls := make([]string, 0, len(config.Urls))
+	for _, u := range config.Urls {
+		urls = append(urls, strings.TrimSuffix(u, "/"))
+	}
+	return urls
+}
+
+// limiter returns the rate limiter of the proxy. The limit is synced from the
+// rate limit configuration of the external service.
+func limiter(proxy string) *rate.Limiter {
+	// 57600/hr is the default limit we enforce, with a burst of 100.
+	return ratelimit.DefaultRegistry.GetOrSet(proxy, rate.NewLimiter(rate.Limit(57600.0/3600.0), 100))
+}
+
+type notFoundError struct {
+	URL  string
+	Body []byte
+}
+
+func (e *notFoundError) Error() string {
+	return fmt.Sprintf("module version not found: url=%q body=%q", e.URL, e.Body)
+}
+
+func (e *notFoundError) NotFound() bool {
+	return true
+}
+
+// IsNotFound reports whether err signals that none of the proxies has the
+// requested module version.
+func IsNotFound(err error) bool {
+	var e *notFoundError
+	return errors.As(err, &e)
+}
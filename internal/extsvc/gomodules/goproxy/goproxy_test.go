@@ -0,0 +1,103 @@
+package goproxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func newTestProxies(t *testing.T) *schema.GoModulesConnection {
+	t.Helper()
+
+	// The file-based proxy only has golang.org/x/mod, everything else falls
+	// through to the HTTP proxy.
+	dir := t.TempDir()
+	versions := filepath.Join(dir, "golang.org", "x", "mod", "@v")
+	if err := os.MkdirAll(versions, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, contents := range map[string]string{
+		"v0.5.1.info": `{"Version":"v0.5.1"}`,
+		"v0.5.1.zip":  "file zip",
+	} {
+		if err := os.WriteFile(filepath.Join(versions, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		// Upper case letters are escaped as "!" followed by the lower case letter.
+		case "/github.com/!burnt!sushi/toml/@v/v0.4.1.info":
+			fmt.Fprint(w, `{"Version":"v0.4.1"}`)
+		case "/github.com/!burnt!sushi/toml/@v/v0.4.1.zip":
+			fmt.Fprint(w, "http zip")
+		case "/github.com/gone/gone/@v/v1.0.0.info":
+			w.WriteHeader(http.StatusGone)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, "not found")
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return &schema.GoModulesConnection{Urls: []string{"file://" + filepath.ToSlash(dir), srv.URL + "/"}}
+}
+
+func parseDependency(t *testing.T, dependency string) reposource.GoDependency {
+	t.Helper()
+	d, err := reposource.ParseGoDependency(dependency)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestExists(t *testing.T) {
+	config := newTestProxies(t)
+	ctx := context.Background()
+
+	for _, dependency := range []string{"golang.org/x/mod@v0.5.1", "github.com/BurntSushi/toml@v0.4.1"} {
+		exists, err := Exists(ctx, config, parseDependency(t, dependency))
+		if err != nil || !exists {
+			t.Errorf("expected %s to exist, got exists=%v err=%v", dependency, exists, err)
+		}
+	}
+
+	for _, dependency := range []string{"golang.org/x/mod@v0.0.1", "github.com/gone/gone@v1.0.0"} {
+		exists, err := Exists(ctx, config, parseDependency(t, dependency))
+		if exists || !IsNotFound(err) {
+			t.Errorf("expected not found error for %s, got exists=%v err=%v", dependency, exists, err)
+		}
+	}
+}
+
+func TestFetchZip(t *testing.T) {
+	config := newTestProxies(t)
+
+	for dependency, want := range map[string]string{
+		"golang.org/x/mod@v0.5.1":           "file zip",
+		"github.com/BurntSushi/toml@v0.4.1": "http zip",
+	} {
+		zip, err := FetchZip(context.Background(), config, parseDependency(t, dependency))
+		if err != nil {
+			t.Fatal(err)
+		}
+		contents, err := io.ReadAll(zip)
+		zip.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have := string(contents); have != want {
+			t.Errorf("unexpected zip contents of %s: have %q, want %q", dependency, have, want)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package goproxy
+
+import (
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type Operations struct {
+	fetchZip *observation.Operation
+	exists   *observation.Operation
+}
+
+func NewOperations(observationContext *observation.Context) *Operations {
+	metrics := metrics.NewREDMetrics(
+		observationContext.Registerer,
+		"codeintel_goproxy",
+		metrics.WithLabels("op"),
+		metrics.WithCountHelp("Total number of method invocations."),
+	)
+
+	op := func(name string) *observation.Operation {
+		return observationContext.Operation(observation.Op{
+			Name:              fmt.Sprintf("codeintel.goproxy.%s", name),
+			MetricLabelValues: []string{name},
+			Metrics:           metrics,
+			ErrorFilter: func(err error) observation.ErrorFilterBehaviour {
+				if IsNotFound(err) {
+					return observation.EmitForMetrics | observation.EmitForTraces
+				}
+				return observation.EmitForDefault
+			},
+		})
+	}
+
+	return &Operations{
+		fetchZip: op("FetchZip"),
+		exists:   op("Exists"),
+	}
+}
@@ -0,0 +1,175 @@
+package npm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// DefaultRegistry is the registry used when the connection doesn't configure
+// one.
+const DefaultRegistry = "https://registry.npmjs.org"
+
+var (
+	observationContext *observation.Context
+	operations         *Operations
+
+	// HTTPClient is the client used to talk to npm registries.
+	HTTPClient = httpcli.ExternalDoer
+)
+
+func init() {
+	observationContext = &observation.Context{
+		Logger:     log15.Root(),
+		Tracer:     &trace.Tracer{Tracer: opentracing.GlobalTracer()},
+		Registerer: prometheus.DefaultRegisterer,
+	}
+	operations = NewOperations(observationContext)
+}
+
+// Exists reports whether the given version of the package is published in the
+// registry.
+func Exists(ctx context.Context, config *schema.NPMPackagesConnection, dependency reposource.NPMDependency) (exists bool, err error) {
+	ctx, endObservation := operations.exists.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.String("dependency", dependency.PackageManagerSyntax()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	_, err = getVersionInfo(ctx, config, dependency)
+	return err == nil, err
+}
+
+// FetchTarball downloads the tarball of the given version of the package. The
+// caller is responsible for closing the returned reader, which yields the
+// gzip-compressed tar archive as published to the registry.
+func FetchTarball(ctx context.Context, config *schema.NPMPackagesConnection, dependency reposource.NPMDependency) (_ io.ReadCloser, err error) {
+	ctx, endObservation := operations.fetchTarball.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.String("dependency", dependency.PackageManagerSyntax()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	info, err := getVersionInfo(ctx, config, dependency)
+	if err != nil {
+		return nil, err
+	}
+	if info.Dist.Tarball == "" {
+		return nil, errors.Errorf("no tarball for dependency %s", dependency.PackageManagerSyntax())
+	}
+
+	resp, err := do(ctx, config, info.Dist.Tarball)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// versionInfo is the subset of the metadata of a package version returned by
+// the registry that we care about.
+type versionInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Dist    struct {
+		Tarball string `json:"tarball"`
+	} `json:"dist"`
+}
+
+func getVersionInfo(ctx context.Context, config *schema.NPMPackagesConnection, dependency reposource.NPMDependency) (*versionInfo, error) {
+	resp, err := do(ctx, config, registryURL(config)+"/"+dependency.PackageSyntax()+"/"+url.PathEscape(dependency.Version))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var info versionInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode metadata of %s", dependency.PackageManagerSyntax())
+	}
+	return &info, nil
+}
+
+// do sends a GET request to the given URL and returns the response if it was
+// successful. The caller must close the body of the returned response.
+func do(ctx context.Context, config *schema.NPMPackagesConnection, rawURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Only send credentials to the configured registry, tarballs may be hosted
+	// elsewhere.
+	if config.Credentials != "" && sameHost(req.URL, registryURL(config)) {
+		req.Header.Set("Authorization", "Bearer "+config.Credentials)
+	}
+
+	if err := limiter(config).Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, &httpError{URL: rawURL, StatusCode: resp.StatusCode, Body: body}
+	}
+	return resp, nil
+}
+
+func registryURL(config *schema.NPMPackagesConnection) string {
+	if config.Registry == "" {
+		return DefaultRegistry
+	}
+	return strings.TrimSuffix(config.Registry, "/")
+}
+
+func sameHost(u *url.URL, registry string) bool {
+	r, err := url.Parse(registry)
+	return err == nil && r.Host == u.Host
+}
+
+// limiter returns the rate limiter of the registry. The limit is synced from
+// the rate limit configuration of the external service.
+func limiter(config *schema.NPMPackagesConnection) *rate.Limiter {
+	// 3000/hr is the default limit we enforce, with a burst of 100.
+	return ratelimit.DefaultRegistry.GetOrSet(registryURL(config), rate.NewLimiter(rate.Limit(3000.0/3600.0), 100))
+}
+
+type httpError struct {
+	URL        string
+	StatusCode int
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("npm registry HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// IsNotFound reports whether err is an HTTP 404 error of the npm registry,
+// which is returned for unknown packages and versions.
+func IsNotFound(err error) bool {
+	var e *httpError
+	return errors.As(err, &e) && e.NotFound()
+}
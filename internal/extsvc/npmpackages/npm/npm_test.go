@@ -0,0 +1,78 @@
+package npm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func newTestRegistry(t *testing.T) *schema.NPMPackagesConnection {
+	t.Helper()
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if have, want := r.Header.Get("Authorization"), "Bearer secret"; have != want {
+			t.Errorf("unexpected authorization header: have %q, want %q", have, want)
+		}
+		switch r.URL.Path {
+		case "/@types/node/16.11.0":
+			fmt.Fprintf(w, `{"name":"@types/node","version":"16.11.0","dist":{"tarball":"%s/@types/node/-/node-16.11.0.tgz"}}`, srv.URL)
+		case "/@types/node/-/node-16.11.0.tgz":
+			fmt.Fprint(w, "tarball")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"Not found"}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return &schema.NPMPackagesConnection{Registry: srv.URL + "/", Credentials: "secret"}
+}
+
+func parseDependency(t *testing.T, dependency string) reposource.NPMDependency {
+	t.Helper()
+	d, err := reposource.ParseNPMDependency(dependency)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestExists(t *testing.T) {
+	config := newTestRegistry(t)
+	ctx := context.Background()
+
+	exists, err := Exists(ctx, config, parseDependency(t, "@types/node@16.11.0"))
+	if err != nil || !exists {
+		t.Fatalf("expected dependency to exist, got exists=%v err=%v", exists, err)
+	}
+
+	exists, err = Exists(ctx, config, parseDependency(t, "@types/node@0.0.1"))
+	if exists || !IsNotFound(err) {
+		t.Fatalf("expected not found error, got exists=%v err=%v", exists, err)
+	}
+}
+
+func TestFetchTarball(t *testing.T) {
+	config := newTestRegistry(t)
+
+	tarball, err := FetchTarball(context.Background(), config, parseDependency(t, "@types/node@16.11.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tarball.Close()
+
+	contents, err := io.ReadAll(tarball)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(contents), "tarball"; have != want {
+		t.Fatalf("unexpected tarball contents: have %q, want %q", have, want)
+	}
+}
@@ -0,0 +1,41 @@
+package npm
+
+import (
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type Operations struct {
+	fetchTarball *observation.Operation
+	exists       *observation.Operation
+}
+
+func NewOperations(observationContext *observation.Context) *Operations {
+	metrics := metrics.NewREDMetrics(
+		observationContext.Registerer,
+		"codeintel_npm",
+		metrics.WithLabels("op"),
+		metrics.WithCountHelp("Total number of method invocations."),
+	)
+
+	op := func(name string) *observation.Operation {
+		return observationContext.Operation(observation.Op{
+			Name:              fmt.Sprintf("codeintel.npm.%s", name),
+			MetricLabelValues: []string{name},
+			Metrics:           metrics,
+			ErrorFilter: func(err error) observation.ErrorFilterBehaviour {
+				if IsNotFound(err) {
+					return observation.EmitForMetrics | observation.EmitForTraces
+				}
+				return observation.EmitForDefault
+			},
+		})
+	}
+
+	return &Operations{
+		fetchTarball: op("FetchTarball"),
+		exists:       op("Exists"),
+	}
+}
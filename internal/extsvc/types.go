@@ -83,7 +83,11 @@ const (
 	KindPerforce        = "PERFORCE"
 	KindPhabricator     = "PHABRICATOR"
 	KindJVMPackages     = "JVMPACKAGES"
+	KindNPMPackages     = "NPMPACKAGES"
+	KindGoModules       = "GOMODULES"
 	KindPagure          = "PAGURE"
+	KindGitea           = "GITEA"
+	KindAzureDevOps     = "AZUREDEVOPS"
 	KindOther           = "OTHER"
 )
 
@@ -123,9 +127,24 @@ const (
 	// TypeJVMPackages is the (api.ExternalRepoSpec).ServiceType value for Maven packages (Java/JVM ecosystem libraries).
 	TypeJVMPackages = "jvmPackages"
 
+	// TypeNPMPackages is the (api.ExternalRepoSpec).ServiceType value for npm packages (JavaScript/TypeScript ecosystem libraries).
+	TypeNPMPackages = "npmPackages"
+
+	// TypeGoModules is the (api.ExternalRepoSpec).ServiceType value for Go modules.
+	TypeGoModules = "goModules"
+
 	// TypePagure is the (api.ExternalRepoSpec).ServiceType value for Pagure projects.
 	TypePagure = "pagure"
 
+	// TypeGitea is the (api.ExternalRepoSpec).ServiceType value for Gitea repositories. The ServiceID
+	// value is the base URL to the Gitea instance.
+	TypeGitea = "gitea"
+
+	// TypeAzureDevOps is the (api.ExternalRepoSpec).ServiceType value for Azure DevOps repositories. The
+	// ServiceID value is the base URL to Azure DevOps Services (https://dev.azure.com) or to the Azure
+	// DevOps Server instance.
+	TypeAzureDevOps = "azuredevops"
+
 	// TypeOther is the (api.ExternalRepoSpec).ServiceType value for other projects.
 	TypeOther = "other"
 
@@ -154,8 +173,16 @@ func KindToType(kind string) string {
 		return TypePerforce
 	case KindJVMPackages:
 		return TypeJVMPackages
+	case KindNPMPackages:
+		return TypeNPMPackages
+	case KindGoModules:
+		return TypeGoModules
 	case KindPagure:
 		return TypePagure
+	case KindGitea:
+		return TypeGitea
+	case KindAzureDevOps:
+		return TypeAzureDevOps
 	case KindOther:
 		return TypeOther
 	default:
@@ -185,8 +212,16 @@ func TypeToKind(t string) string {
 		return KindPhabricator
 	case TypeJVMPackages:
 		return KindJVMPackages
+	case TypeNPMPackages:
+		return KindNPMPackages
+	case TypeGoModules:
+		return KindGoModules
 	case TypePagure:
 		return KindPagure
+	case TypeGitea:
+		return KindGitea
+	case TypeAzureDevOps:
+		return KindAzureDevOps
 	case TypeOther:
 		return KindOther
 	default:
@@ -199,6 +234,8 @@ var (
 	bbsLower = strings.ToLower(TypeBitbucketServer)
 	bbcLower = strings.ToLower(TypeBitbucketCloud)
 	jvmLower = strings.ToLower(TypeJVMPackages)
+	npmLower = strings.ToLower(TypeNPMPackages)
+	goLower  = strings.ToLower(TypeGoModules)
 )
 
 // ParseServiceType will return a ServiceType constant after doing a case insensitive match on s.
@@ -223,8 +260,16 @@ func ParseServiceType(s string) (string, bool) {
 		return TypePhabricator, true
 	case jvmLower:
 		return TypeJVMPackages, true
+	case npmLower:
+		return TypeNPMPackages, true
+	case goLower:
+		return TypeGoModules, true
 	case TypePagure:
 		return TypePagure, true
+	case TypeGitea:
+		return TypeGitea, true
+	case TypeAzureDevOps:
+		return TypeAzureDevOps, true
 	case TypeOther:
 		return TypeOther, true
 	default:
@@ -254,8 +299,16 @@ func ParseServiceKind(s string) (string, bool) {
 		return KindPhabricator, true
 	case KindJVMPackages:
 		return KindJVMPackages, true
+	case KindNPMPackages:
+		return KindNPMPackages, true
+	case KindGoModules:
+		return KindGoModules, true
 	case KindPagure:
 		return KindPagure, true
+	case KindGitea:
+		return KindGitea, true
+	case KindAzureDevOps:
+		return KindAzureDevOps, true
 	case KindOther:
 		return KindOther, true
 	default:
@@ -297,8 +350,16 @@ func ParseConfig(kind, config string) (cfg interface{}, _ error) {
 		cfg = &schema.PhabricatorConnection{}
 	case KindJVMPackages:
 		cfg = &schema.JVMPackagesConnection{}
+	case KindNPMPackages:
+		cfg = &schema.NPMPackagesConnection{}
+	case KindGoModules:
+		cfg = &schema.GoModulesConnection{}
 	case KindPagure:
 		cfg = &schema.PagureConnection{}
+	case KindGitea:
+		cfg = &schema.GiteaConnection{}
+	case KindAzureDevOps:
+		cfg = &schema.AzureDevOpsConnection{}
 	case KindOther:
 		cfg = &schema.OtherExternalServiceConnection{}
 	default:
@@ -316,6 +377,8 @@ func WebhookURL(kind string, externalServiceID int64, externalURL string) string
 		path = "github-webhooks"
 	case KindBitbucketServer:
 		path = "bitbucket-server-webhooks"
+	case KindBitbucketCloud:
+		path = "bitbucket-cloud-webhooks"
 	case KindGitLab:
 		path = "gitlab-webhooks"
 	default:
@@ -342,6 +405,10 @@ func ExtractToken(config string, kind string) (string, error) {
 		return c.Token, nil
 	case *schema.PagureConnection:
 		return c.Token, nil
+	case *schema.GiteaConnection:
+		return c.Token, nil
+	case *schema.AzureDevOpsConnection:
+		return c.Token, nil
 	default:
 		return "", errors.Errorf("unable to extract token for service kind %q", kind)
 	}
@@ -426,6 +493,28 @@ func GetLimitFromConfig(kind string, config interface{}) (rlc RateLimitConfig, e
 			rlc.IsDefault = false
 		}
 		rlc.BaseURL = "maven"
+	case *schema.NPMPackagesConnection:
+		// 3000/hr is the default limit we enforce
+		rlc.Limit = rate.Limit(3000.0 / 3600.0)
+		if c != nil && c.RateLimit != nil {
+			rlc.Limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+			rlc.IsDefault = false
+		}
+		rlc.BaseURL = c.Registry
+		if rlc.BaseURL == "" {
+			rlc.BaseURL = "https://registry.npmjs.org"
+		}
+	case *schema.GoModulesConnection:
+		// 57600/hr is the default limit we enforce
+		rlc.Limit = rate.Limit(57600.0 / 3600.0)
+		if c != nil && c.RateLimit != nil {
+			rlc.Limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+			rlc.IsDefault = false
+		}
+		rlc.BaseURL = "https://proxy.golang.org"
+		if len(c.Urls) > 0 {
+			rlc.BaseURL = c.Urls[0]
+		}
 	case *schema.PagureConnection:
 		// 8/s is the default limit we enforce
 		rlc.Limit = rate.Limit(8)
@@ -434,6 +523,22 @@ func GetLimitFromConfig(kind string, config interface{}) (rlc RateLimitConfig, e
 			rlc.IsDefault = false
 		}
 		rlc.BaseURL = c.Url
+	case *schema.GiteaConnection:
+		// 8/s is the default limit we enforce
+		rlc.Limit = rate.Limit(8)
+		if c != nil && c.RateLimit != nil {
+			rlc.Limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+			rlc.IsDefault = false
+		}
+		rlc.BaseURL = c.Url
+	case *schema.AzureDevOpsConnection:
+		// 5/s is the default limit we enforce
+		rlc.Limit = rate.Limit(5)
+		if c != nil && c.RateLimit != nil {
+			rlc.Limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+			rlc.IsDefault = false
+		}
+		rlc.BaseURL = c.Url
 	default:
 		return rlc, ErrRateLimitUnsupported{codehostKind: kind}
 	}
@@ -531,8 +636,16 @@ func UniqueCodeHostIdentifier(kind, config string) (string, error) {
 		return c.P4Port, nil
 	case *schema.JVMPackagesConnection:
 		return KindJVMPackages, nil
+	case *schema.NPMPackagesConnection:
+		return KindNPMPackages, nil
+	case *schema.GoModulesConnection:
+		return KindGoModules, nil
 	case *schema.PagureConnection:
 		rawURL = c.Url
+	case *schema.GiteaConnection:
+		rawURL = c.Url
+	case *schema.AzureDevOpsConnection:
+		rawURL = c.Url
 	default:
 		return "", errors.Errorf("unknown external service kind: %s", kind)
 	}
@@ -323,6 +323,7 @@ func (c *Client) Search(ctx context.Context, args *protocol.SearchRequest, onMat
 	span.SetTag("repo", string(args.Repo))
 	span.SetTag("query", args.Query.String())
 	span.SetTag("diff", args.IncludeDiff)
+	span.SetTag("diffFiles", args.IncludeDiffFiles)
 	span.SetTag("limit", args.Limit)
 	defer func() {
 		if err != nil {
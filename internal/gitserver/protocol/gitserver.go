@@ -16,7 +16,10 @@ type SearchRequest struct {
 	Revisions   []RevisionSpecifier
 	Query       Node
 	IncludeDiff bool
-	Limit       int
+	// IncludeDiffFiles requests the matching files and hunks of the diff
+	// in structured form. It only has an effect if IncludeDiff is set.
+	IncludeDiffFiles bool
+	Limit            int
 }
 
 type RevisionSpecifier struct {
@@ -75,6 +78,8 @@ type CommitMatch struct {
 
 	Message result.MatchedString `json:",omitempty"`
 	Diff    result.MatchedString `json:",omitempty"`
+
+	DiffFiles []result.DiffFile `json:",omitempty"`
 }
 
 type Signature struct {
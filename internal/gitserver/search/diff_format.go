@@ -98,6 +98,81 @@ func FormatDiff(rawDiff []*diff.FileDiff, highlights map[int]MatchedFileDiff) (s
 	return buf.String(), ranges
 }
 
+// StructuredDiff returns the files and hunks of rawDiff that matched, in the
+// same shape as FormatDiff but as structured values. Unlike FormatDiff, the
+// output is not truncated, and every line is annotated with its line number in
+// the original and the new file.
+func StructuredDiff(rawDiff []*diff.FileDiff, highlights map[int]MatchedFileDiff) []result.DiffFile {
+	var files []result.DiffFile
+	for fileIdx, fileDiff := range rawDiff {
+		fdh, ok := highlights[fileIdx]
+		if !ok && len(highlights) > 0 {
+			continue
+		}
+
+		filteredHunks, filteredHighlights := splitHunkMatches(fileDiff.Hunks, fdh.MatchedHunks, matchContextLines, 0)
+
+		file := result.DiffFile{
+			OrigName: fileDiff.OrigName,
+			NewName:  fileDiff.NewName,
+		}
+		for hunkIdx, hunk := range filteredHunks {
+			hmh, ok := filteredHighlights[hunkIdx]
+			if !ok && len(filteredHighlights) > 0 {
+				continue
+			}
+			file.Hunks = append(file.Hunks, structuredHunk(hunk, hmh))
+		}
+		if len(file.Hunks) > 0 {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// structuredHunk converts hunk into a result.DiffHunk, computing the line
+// numbers of each of its lines from the hunk header.
+func structuredHunk(hunk *diff.Hunk, highlights MatchedHunk) result.DiffHunk {
+	res := result.DiffHunk{
+		OldStart: hunk.OrigStartLine,
+		OldLines: hunk.OrigLines,
+		NewStart: hunk.NewStartLine,
+		NewLines: hunk.NewLines,
+		Header:   hunk.Section,
+	}
+
+	oldLine, newLine := hunk.OrigStartLine, hunk.NewStartLine
+	for lineIdx, line := range bytes.Split(hunk.Body, []byte("\n")) {
+		// "\ No newline at end of file" markers are not lines of either file.
+		if len(line) == 0 || isNoNewlineMarker(line) {
+			continue
+		}
+
+		dl := result.DiffLine{
+			Content:       string(line[1:]),
+			MatchedRanges: highlights.MatchedLines[lineIdx],
+		}
+		switch added, removed := diffHunkLineStatus(line); {
+		case added:
+			dl.Kind = result.DiffLineAdded
+			dl.NewLine = newLine
+			newLine++
+		case removed:
+			dl.Kind = result.DiffLineRemoved
+			dl.OldLine = oldLine
+			oldLine++
+		default:
+			dl.Kind = result.DiffLineContext
+			dl.OldLine = oldLine
+			dl.NewLine = newLine
+			oldLine++
+			newLine++
+		}
+		res.Lines = append(res.Lines, dl)
+	}
+	return res
+}
+
 // splitHunkMatches returns a list of hunks that are a subset of the input hunks,
 // filtered down to only hunks that matched, determined by whether the hunk has highlights.
 // and non-matching changed lines are eliminated, and the hunk header (start/end
@@ -146,10 +221,10 @@ func splitHunkMatches(hunks []*diff.Hunk, hunkHighlights map[int]MatchedHunk, ma
 					}
 				}
 
-				if !lineInfo.added {
+				if !lineInfo.added && !lineInfo.marker {
 					cur.OrigLines++
 				}
-				if !lineInfo.removed {
+				if !lineInfo.removed && !lineInfo.marker {
 					cur.NewLines++
 				}
 				if len(origHighlights[i]) > 0 {
@@ -169,10 +244,10 @@ func splitHunkMatches(hunks []*diff.Hunk, hunkHighlights map[int]MatchedHunk, ma
 				extraHunkMatches = 0
 			}
 
-			if !lineInfo.added {
+			if !lineInfo.added && !lineInfo.marker {
 				origLineOffset++
 			}
-			if !lineInfo.removed {
+			if !lineInfo.removed && !lineInfo.marker {
 				newLineOffset++
 			}
 		}
@@ -195,6 +270,7 @@ type diffHunkLineInfo struct {
 	removed  bool // line starts with '-'
 	matching bool // line matches query (only computed for changed lines)
 	context  bool // include because it's context for a matching changed line
+	marker   bool // line is a "\ No newline at end of file" marker
 }
 
 func (info diffHunkLineInfo) changed() bool { return info.added || info.removed }
@@ -216,6 +292,7 @@ func computeDiffHunkInfo(lines [][]byte, lineHighlights map[int]result.Ranges, m
 	lineInfo := make([]diffHunkLineInfo, len(lines))
 	for i, line := range lines {
 		lineInfo[i].added, lineInfo[i].removed = diffHunkLineStatus(line)
+		lineInfo[i].marker = isNoNewlineMarker(line)
 		if lineInfo[i].changed() {
 			_, ok := lineHighlights[i]
 			lineInfo[i].matching = ok || len(lineHighlights) == 0
@@ -241,3 +318,9 @@ func diffHunkLineStatus(line []byte) (added, removed bool) {
 	}
 	return
 }
+
+// isNoNewlineMarker returns true if line is the "\ No newline at end of file"
+// marker that follows the last line of a file without a trailing newline.
+func isNoNewlineMarker(line []byte) bool {
+	return len(line) >= 1 && line[0] == '\\'
+}
@@ -51,3 +51,112 @@ index dbace57d5f..53357b4971 100644
 
 	})
 }
+
+func TestStructuredDiff(t *testing.T) {
+	rawDiff := `diff --git a/.mailmap b/.mailmap
+index dbace57d5f..53357b4971 100644
+--- .mailmap
++++ .mailmap
+@@ -59,4 +59,4 @@ Unknown <u@gogs.io> 无闻 <u@gogs.io>
+ Renovate Bot <bot@renovateapp.com> renovate[bot] <renovate[bot]@users.noreply.github.com>
+-Matt King <kingy895@gmail.com> Matthew King <kingy895@gmail.com>
++Matt King <kingy895@gmail.com> Matt King <kingy895@gmail.com>
+ Camden Cheek <camden@sourcegraph.com> Camden Cheek <camden@ccheek.com>
+ Unknown <u@gogs.io> 无闻 <u@gogs.io>
+`
+	parsedDiff, err := diff.NewMultiFileDiffReader(strings.NewReader(rawDiff)).ReadAllFiles()
+	require.NoError(t, err)
+
+	matchedRanges := result.Ranges{{
+		Start: result.Location{Offset: 30, Line: 0, Column: 30},
+		End:   result.Location{Offset: 34, Line: 0, Column: 34},
+	}}
+	highlights := map[int]MatchedFileDiff{
+		0: {MatchedHunks: map[int]MatchedHunk{
+			0: {MatchedLines: map[int]result.Ranges{2: matchedRanges}},
+		}},
+	}
+
+	expected := []result.DiffFile{{
+		OrigName: ".mailmap",
+		NewName:  ".mailmap",
+		Hunks: []result.DiffHunk{{
+			OldStart: 60,
+			OldLines: 2,
+			NewStart: 60,
+			NewLines: 2,
+			Header:   "Unknown <u@gogs.io> 无闻 <u@gogs.io>",
+			Lines: []result.DiffLine{{
+				Kind:    result.DiffLineRemoved,
+				OldLine: 60,
+				Content: "Matt King <kingy895@gmail.com> Matthew King <kingy895@gmail.com>",
+			}, {
+				Kind:          result.DiffLineAdded,
+				NewLine:       60,
+				Content:       "Matt King <kingy895@gmail.com> Matt King <kingy895@gmail.com>",
+				MatchedRanges: matchedRanges,
+			}, {
+				Kind:    result.DiffLineContext,
+				OldLine: 61,
+				NewLine: 61,
+				Content: "Camden Cheek <camden@sourcegraph.com> Camden Cheek <camden@ccheek.com>",
+			}},
+		}},
+	}}
+	require.Equal(t, expected, StructuredDiff(parsedDiff, highlights))
+
+	// The structured diff renders to the same preview as FormatDiff.
+	formatted, ranges := FormatDiff(parsedDiff, highlights)
+	require.Equal(t, result.MatchedString{Content: formatted, MatchedRanges: ranges}, result.FormatDiffFiles(expected))
+}
+
+func TestStructuredDiffNoNewlineMarker(t *testing.T) {
+	// The markers are kept in the hunk body when they are not in the exact form
+	// the diff parser strips.
+	parsedDiff := []*diff.FileDiff{{
+		OrigName: "main.go",
+		NewName:  "main.go",
+		Hunks: []*diff.Hunk{{
+			OrigStartLine: 1,
+			OrigLines:     3,
+			NewStartLine:  1,
+			NewLines:      3,
+			Body: []byte(" package main\n \n-func main() {}\n\\ No newline at end of file\n" +
+				"+func main() { run() }\n\\ No newline at end of file\n"),
+		}},
+	}}
+
+	highlights := map[int]MatchedFileDiff{
+		0: {MatchedHunks: map[int]MatchedHunk{
+			0: {MatchedLines: map[int]result.Ranges{2: nil, 4: nil}},
+		}},
+	}
+
+	// The markers are neither counted as lines of the hunk nor returned as lines.
+	expected := []result.DiffFile{{
+		OrigName: "main.go",
+		NewName:  "main.go",
+		Hunks: []result.DiffHunk{{
+			OldStart: 2,
+			OldLines: 2,
+			NewStart: 2,
+			NewLines: 2,
+			Lines: []result.DiffLine{{
+				Kind:    result.DiffLineContext,
+				OldLine: 2,
+				NewLine: 2,
+				Content: "",
+			}, {
+				Kind:    result.DiffLineRemoved,
+				OldLine: 3,
+				Content: "func main() {}",
+			}, {
+				Kind:    result.DiffLineAdded,
+				NewLine: 3,
+				Content: "func main() { run() }",
+			}},
+		}},
+	}}
+	require.Equal(t, expected, StructuredDiff(parsedDiff, highlights))
+}
+
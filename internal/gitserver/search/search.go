@@ -77,6 +77,9 @@ type CommitSearcher struct {
 	Query       MatchTree
 	Revisions   []protocol.RevisionSpecifier
 	IncludeDiff bool
+	// IncludeDiffFiles adds the structured matching files and hunks of the
+	// diff to each match. It only has an effect if IncludeDiff is set.
+	IncludeDiffFiles bool
 }
 
 // Search runs a search for commits matching the given predicate across the revisions passed in as revisionArgs.
@@ -216,7 +219,7 @@ func (cs *CommitSearcher) runJobs(ctx context.Context, jobs chan job) error {
 				return err
 			}
 			if mergedResult.Satisfies() {
-				cm, err := CreateCommitMatch(lc, highlights, cs.IncludeDiff)
+				cm, err := CreateCommitMatch(lc, highlights, cs.IncludeDiff, cs.IncludeDiffFiles)
 				if err != nil {
 					return err
 				}
@@ -352,7 +355,7 @@ func (c *CommitScanner) Err() error {
 	return c.err
 }
 
-func CreateCommitMatch(lc *LazyCommit, hc MatchedCommit, includeDiff bool) (*protocol.CommitMatch, error) {
+func CreateCommitMatch(lc *LazyCommit, hc MatchedCommit, includeDiff, includeDiffFiles bool) (*protocol.CommitMatch, error) {
 	authorDate, err := lc.AuthorDate()
 	if err != nil {
 		return nil, err
@@ -364,12 +367,16 @@ func CreateCommitMatch(lc *LazyCommit, hc MatchedCommit, includeDiff bool) (*pro
 	}
 
 	diff := result.MatchedString{}
+	var diffFiles []result.DiffFile
 	if includeDiff {
 		rawDiff, err := lc.Diff()
 		if err != nil {
 			return nil, err
 		}
 		diff.Content, diff.MatchedRanges = FormatDiff(rawDiff, hc.Diff)
+		if includeDiffFiles {
+			diffFiles = StructuredDiff(rawDiff, hc.Diff)
+		}
 	}
 
 	return &protocol.CommitMatch{
@@ -391,6 +398,7 @@ func CreateCommitMatch(lc *LazyCommit, hc MatchedCommit, includeDiff bool) (*pro
 			Content:       string(lc.Message),
 			MatchedRanges: hc.Message,
 		},
-		Diff: diff,
+		Diff:      diff,
+		DiffFiles: diffFiles,
 	}, nil
 }
@@ -0,0 +1,186 @@
+package repos
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// An AzureDevOpsSource yields repositories from a single Azure DevOps
+// connection configured in Sourcegraph via the external services
+// configuration.
+type AzureDevOpsSource struct {
+	svc       *types.ExternalService
+	config    *schema.AzureDevOpsConnection
+	exclude   excludeFunc
+	cli       *azuredevops.Client
+	host      string
+	serviceID string
+}
+
+// NewAzureDevOpsSource returns a new AzureDevOpsSource from the given external
+// service.
+func NewAzureDevOpsSource(svc *types.ExternalService, cf *httpcli.Factory) (*AzureDevOpsSource, error) {
+	var c schema.AzureDevOpsConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	httpCli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := azuredevops.NewClient(&c, httpCli)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := extsvc.NormalizeBaseURL(cli.URL)
+	s := &AzureDevOpsSource{
+		svc:       svc,
+		config:    &c,
+		cli:       cli,
+		host:      baseURL.Hostname(),
+		serviceID: baseURL.String(),
+	}
+
+	var eb excludeBuilder
+	for _, r := range c.Exclude {
+		eb.Exact(r.Name)
+		eb.Exact(r.Id)
+		eb.Pattern(r.Pattern)
+	}
+	if s.exclude, err = eb.Build(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ListRepos returns all Azure DevOps repositories configured with this
+// AzureDevOpsSource's config.
+func (s *AzureDevOpsSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	seen := make(map[string]bool)
+	send := func(repos []*azuredevops.Repository) {
+		for _, r := range repos {
+			if seen[r.ID] || s.excludes(r) {
+				continue
+			}
+			seen[r.ID] = true
+			results <- SourceResult{Source: s, Repo: s.makeRepo(r)}
+		}
+	}
+
+	for _, org := range s.config.Orgs {
+		if err := s.listOrg(ctx, org, send); err != nil {
+			results <- SourceResult{Source: s, Err: errors.Wrapf(err, "azuredevops.orgs: item=%q", org)}
+		}
+	}
+
+	for _, project := range s.config.Projects {
+		parts := strings.SplitN(project, "/", 2)
+		if len(parts) != 2 {
+			results <- SourceResult{Source: s, Err: errors.Errorf("invalid Azure DevOps project: %s", project)}
+			continue
+		}
+		repos, err := s.cli.ListRepositories(ctx, parts[0], parts[1])
+		if err != nil {
+			if azuredevops.IsNotFound(err) {
+				log15.Warn("skipping missing azuredevops.projects entry:", "name", project, "err", err)
+			} else {
+				results <- SourceResult{Source: s, Err: errors.Wrapf(err, "azuredevops.projects: item=%q", project)}
+			}
+			continue
+		}
+		send(repos)
+	}
+}
+
+// listOrg sends the repositories of all projects of the given organization.
+func (s *AzureDevOpsSource) listOrg(ctx context.Context, org string, send func([]*azuredevops.Repository)) error {
+	var projects []*azuredevops.Project
+	for token, first := "", true; first || token != ""; first = false {
+		var page []*azuredevops.Project
+		var err error
+		if page, token, err = s.cli.ListProjects(ctx, org, token); err != nil {
+			return err
+		}
+		projects = append(projects, page...)
+	}
+
+	for _, p := range projects {
+		repos, err := s.cli.ListRepositories(ctx, org, p.Name)
+		if err != nil {
+			return errors.Wrapf(err, "listing repositories of project %q", p.Name)
+		}
+		send(repos)
+	}
+	return nil
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *AzureDevOpsSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func (s *AzureDevOpsSource) excludes(r *azuredevops.Repository) bool {
+	return r.IsDisabled || s.exclude(r.NameWithOwner()) || s.exclude(r.ID)
+}
+
+func (s *AzureDevOpsSource) makeRepo(r *azuredevops.Repository) *types.Repo {
+	urn := s.svc.URN()
+	return &types.Repo{
+		Name: reposource.AzureDevOpsRepoName(
+			s.config.RepositoryPathPattern,
+			s.host,
+			r.NameWithOwner(),
+		),
+		URI: string(reposource.AzureDevOpsRepoName(
+			"",
+			s.host,
+			r.NameWithOwner(),
+		)),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          r.ID,
+			ServiceType: extsvc.TypeAzureDevOps,
+			ServiceID:   s.serviceID,
+		},
+		Description: r.Project.Description,
+		Fork:        r.IsFork,
+		Private:     r.Private(),
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: s.remoteURL(r),
+			},
+		},
+		Metadata: r,
+	}
+}
+
+// remoteURL returns the repository's Git remote URL
+//
+// note: this used to contain credentials but that is no longer the case
+// if you need to get an authenticated clone url use repos.CloneURL
+func (s *AzureDevOpsSource) remoteURL(r *azuredevops.Repository) string {
+	if s.config.GitURLType == "ssh" {
+		return r.SSHURL
+	}
+	return r.RemoteURL
+}
@@ -0,0 +1,97 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestAzureDevOpsSource_ListRepos(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/myorg/_apis/projects":
+			switch r.URL.Query().Get("continuationToken") {
+			case "":
+				w.Header().Set("X-MS-ContinuationToken", "2")
+				fmt.Fprint(w, `{"value":[{"id":"p1","name":"one"}]}`)
+			default:
+				fmt.Fprint(w, `{"value":[{"id":"p2","name":"two","visibility":"public"}]}`)
+			}
+		case "/myorg/one/_apis/git/repositories":
+			fmt.Fprint(w, `{"value":[
+				{"id":"r1","name":"a","project":{"id":"p1","name":"one"},"remoteUrl":"https://myorg@dev.azure.com/myorg/one/_git/a"},
+				{"id":"r2","name":"disabled","project":{"id":"p1","name":"one"},"isDisabled":true}
+			]}`)
+		case "/myorg/two/_apis/git/repositories":
+			fmt.Fprint(w, `{"value":[
+				{"id":"r3","name":"b","project":{"id":"p2","name":"two","visibility":"public"}},
+				{"id":"r4","name":"excluded","project":{"id":"p2","name":"two","visibility":"public"}}
+			]}`)
+		case "/otherorg/three/_apis/git/repositories":
+			fmt.Fprint(w, `{"value":[
+				{"id":"r1","name":"a","project":{"id":"p1","name":"one"}},
+				{"id":"r5","name":"c","project":{"id":"p3","name":"three"},"isFork":true}
+			]}`)
+		case "/otherorg/missing/_apis/git/repositories":
+			http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+	}))
+	defer srv.Close()
+
+	svc := &types.ExternalService{
+		Kind: extsvc.KindAzureDevOps,
+		Config: marshalJSON(t, &schema.AzureDevOpsConnection{
+			Url:      srv.URL,
+			Token:    "secret",
+			Orgs:     []string{"myorg"},
+			Projects: []string{"otherorg/three", "otherorg/missing"},
+			Exclude: []*schema.ExcludedAzureDevOpsRepo{
+				{Name: "myorg/two/excluded"},
+			},
+		}),
+	}
+
+	src, err := NewAzureDevOpsSource(svc, httpcli.NewFactory(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repos, err := listAll(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, r := range repos {
+		names = append(names, string(r.Name))
+	}
+	host := src.host
+	want := []string{host + "/myorg/one/a", host + "/myorg/two/b", host + "/otherorg/three/c"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Fatalf("unexpected repos (-want +got):\n%s", diff)
+	}
+
+	if have, want := repos[0].Sources[svc.URN()].CloneURL, "https://myorg@dev.azure.com/myorg/one/_git/a"; have != want {
+		t.Errorf("unexpected clone URL: have %q, want %q", have, want)
+	}
+	if !repos[0].Private || repos[1].Private {
+		t.Errorf("unexpected visibility: %v, %v", repos[0].Private, repos[1].Private)
+	}
+	if !repos[2].Fork {
+		t.Errorf("expected fork: %+v", repos[2])
+	}
+	if have, want := repos[2].ExternalRepo.ServiceType, extsvc.TypeAzureDevOps; have != want {
+		t.Errorf("unexpected service type: have %q, want %q", have, want)
+	}
+}
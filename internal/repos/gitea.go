@@ -0,0 +1,229 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitea"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A GiteaSource yields repositories from a single Gitea connection configured
+// in Sourcegraph via the external services configuration.
+type GiteaSource struct {
+	svc       *types.ExternalService
+	config    *schema.GiteaConnection
+	exclude   excludeFunc
+	cli       *gitea.Client
+	host      *url.URL
+	serviceID string
+	perPage   int
+
+	excludeForks    bool
+	excludeArchived bool
+}
+
+// NewGiteaSource returns a new GiteaSource from the given external service.
+func NewGiteaSource(svc *types.ExternalService, cf *httpcli.Factory) (*GiteaSource, error) {
+	var c schema.GiteaConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	httpCli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := gitea.NewClient(&c, httpCli)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &GiteaSource{
+		svc:     svc,
+		config:  &c,
+		cli:     cli,
+		host:    extsvc.NormalizeBaseURL(cli.URL),
+		perPage: gitea.DefaultPerPage,
+	}
+	s.serviceID = s.host.String()
+
+	var eb excludeBuilder
+	for _, r := range c.Exclude {
+		eb.Exact(r.Name)
+		if r.Id != 0 {
+			eb.Exact(strconv.Itoa(r.Id))
+		}
+		eb.Pattern(r.Pattern)
+		s.excludeForks = s.excludeForks || r.Forks
+		s.excludeArchived = s.excludeArchived || r.Archived
+	}
+	if s.exclude, err = eb.Build(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ListRepos returns all Gitea repositories configured with this GiteaSource's config.
+func (s *GiteaSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	seen := make(map[int64]bool)
+	send := func(repos []*gitea.Repository) {
+		for _, r := range repos {
+			if seen[r.ID] || s.excludes(r) {
+				continue
+			}
+			seen[r.ID] = true
+			results <- SourceResult{Source: s, Repo: s.makeRepo(r)}
+		}
+	}
+
+	for _, query := range s.config.RepositoryQuery {
+		var list func(context.Context, *gitea.Page) ([]*gitea.Repository, *gitea.Page, error)
+		switch query {
+		case "none":
+			continue
+		case "affiliated":
+			list = s.cli.ListAffiliatedRepos
+		case "all":
+			list = func(ctx context.Context, page *gitea.Page) ([]*gitea.Repository, *gitea.Page, error) {
+				return s.cli.SearchRepos(ctx, "", page)
+			}
+		default:
+			keyword := query
+			list = func(ctx context.Context, page *gitea.Page) ([]*gitea.Repository, *gitea.Page, error) {
+				return s.cli.SearchRepos(ctx, keyword, page)
+			}
+		}
+		if err := s.paginate(ctx, list, send); err != nil {
+			results <- SourceResult{Source: s, Err: errors.Wrapf(err, "gitea.repositoryQuery: item=%q", query)}
+		}
+	}
+
+	for _, org := range s.config.Orgs {
+		org := org
+		list := func(ctx context.Context, page *gitea.Page) ([]*gitea.Repository, *gitea.Page, error) {
+			return s.cli.ListOrgRepos(ctx, org, page)
+		}
+		if err := s.paginate(ctx, list, send); err != nil {
+			results <- SourceResult{Source: s, Err: errors.Wrapf(err, "gitea.orgs: item=%q", org)}
+		}
+	}
+
+	for _, nameWithOwner := range s.config.Repos {
+		parts := strings.SplitN(nameWithOwner, "/", 2)
+		if len(parts) != 2 {
+			results <- SourceResult{Source: s, Err: errors.Errorf("invalid Gitea repository: nameWithOwner=%s", nameWithOwner)}
+			continue
+		}
+		repo, err := s.cli.GetRepo(ctx, parts[0], parts[1])
+		if err != nil {
+			if errcode.IsNotFound(err) {
+				log15.Warn("skipping missing gitea.repos entry:", "name", nameWithOwner, "err", err)
+			} else {
+				results <- SourceResult{Source: s, Err: errors.Wrapf(err, "Error getting Gitea repository: nameWithOwner=%s", nameWithOwner)}
+			}
+			continue
+		}
+		send([]*gitea.Repository{repo})
+	}
+}
+
+func (s *GiteaSource) paginate(
+	ctx context.Context,
+	list func(context.Context, *gitea.Page) ([]*gitea.Repository, *gitea.Page, error),
+	send func([]*gitea.Repository),
+) error {
+	for page := gitea.FirstPage(s.perPage); page != nil; {
+		var repos []*gitea.Repository
+		var err error
+		if repos, page, err = list(ctx, page); err != nil {
+			return err
+		}
+		send(repos)
+	}
+	return nil
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *GiteaSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func (s *GiteaSource) excludes(r *gitea.Repository) bool {
+	return s.exclude(r.FullName) ||
+		s.exclude(strconv.FormatInt(r.ID, 10)) ||
+		(s.excludeForks && r.Fork) ||
+		(s.excludeArchived && r.Archived)
+}
+
+func (s *GiteaSource) makeRepo(r *gitea.Repository) *types.Repo {
+	urn := s.svc.URN()
+	return &types.Repo{
+		Name: reposource.GiteaRepoName(
+			s.config.RepositoryPathPattern,
+			s.host.Hostname(),
+			r.FullName,
+		),
+		URI: string(reposource.GiteaRepoName(
+			"",
+			s.host.Hostname(),
+			r.FullName,
+		)),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          strconv.FormatInt(r.ID, 10),
+			ServiceType: extsvc.TypeGitea,
+			ServiceID:   s.serviceID,
+		},
+		Description: r.Description,
+		Fork:        r.Fork,
+		Archived:    r.Archived,
+		Private:     r.Private,
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: s.remoteURL(r),
+			},
+		},
+		Metadata: r,
+	}
+}
+
+// remoteURL returns the repository's Git remote URL
+//
+// note: this used to contain credentials but that is no longer the case
+// if you need to get an authenticated clone url use repos.CloneURL
+func (s *GiteaSource) remoteURL(r *gitea.Repository) string {
+	if s.config.GitURLType == "ssh" {
+		return giteaSSHURL(s.host, r)
+	}
+	return r.CloneURL
+}
+
+// giteaSSHURL returns the SSH URL of the repository on the Gitea instance at
+// baseURL. Gitea omits it from the API if SSH is disabled or served on a
+// different host, in which case the usual git@host:owner/name.git is assumed.
+func giteaSSHURL(baseURL *url.URL, r *gitea.Repository) string {
+	if r.SSHURL != "" {
+		return r.SSHURL
+	}
+	return fmt.Sprintf("git@%s:%s.git", baseURL.Hostname(), r.FullName)
+}
@@ -0,0 +1,98 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestGiteaSource_ListRepos(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/user/repos":
+			switch r.URL.Query().Get("page") {
+			case "1":
+				fmt.Fprint(w, `[
+					{"id":1,"full_name":"alice/a","clone_url":"https://gitea.example.com/alice/a.git"},
+					{"id":2,"full_name":"alice/fork","fork":true}
+				]`)
+			case "2":
+				fmt.Fprint(w, `[{"id":3,"full_name":"alice/old","archived":true}]`)
+			default:
+				fmt.Fprint(w, `[]`)
+			}
+		case "/api/v1/orgs/sourcegraph/repos":
+			switch r.URL.Query().Get("page") {
+			case "1":
+				fmt.Fprint(w, `[
+					{"id":1,"full_name":"alice/a"},
+					{"id":4,"full_name":"sourcegraph/b","private":true,"description":"b"}
+				]`)
+			default:
+				fmt.Fprint(w, `[]`)
+			}
+		case "/api/v1/repos/sourcegraph/c":
+			fmt.Fprint(w, `{"id":5,"full_name":"sourcegraph/c"}`)
+		case "/api/v1/repos/sourcegraph/missing":
+			http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+	}))
+	defer srv.Close()
+
+	svc := &types.ExternalService{
+		Kind: extsvc.KindGitea,
+		Config: marshalJSON(t, &schema.GiteaConnection{
+			Url:             srv.URL,
+			Token:           "secret",
+			RepositoryQuery: []string{"affiliated"},
+			Orgs:            []string{"sourcegraph"},
+			Repos:           []string{"sourcegraph/c", "sourcegraph/missing"},
+			Exclude: []*schema.ExcludedGiteaRepo{
+				{Forks: true},
+				{Archived: true},
+			},
+		}),
+	}
+
+	src, err := NewGiteaSource(svc, httpcli.NewFactory(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src.perPage = 2
+
+	repos, err := listAll(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, r := range repos {
+		names = append(names, string(r.Name))
+	}
+	host := src.host.Hostname()
+	want := []string{host + "/alice/a", host + "/sourcegraph/b", host + "/sourcegraph/c"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Fatalf("unexpected repos (-want +got):\n%s", diff)
+	}
+
+	if have, want := repos[0].Sources[svc.URN()].CloneURL, "https://gitea.example.com/alice/a.git"; have != want {
+		t.Errorf("unexpected clone URL: have %q, want %q", have, want)
+	}
+	if !repos[1].Private || repos[1].Description != "b" {
+		t.Errorf("unexpected repo: %+v", repos[1])
+	}
+	if have, want := repos[1].ExternalRepo.ServiceType, extsvc.TypeGitea; have != want {
+		t.Errorf("unexpected service type: have %q, want %q", have, want)
+	}
+}
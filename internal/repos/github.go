@@ -127,7 +127,10 @@ func newGithubSource(svc *types.ExternalService, c *schema.GitHubConnection, cf
 	if err != nil {
 		return nil, err
 	}
-	token := &auth.OAuthBearerToken{Token: c.Token}
+	token, err := github.NewConnectionAuthenticator(svc.ID, apiURL, c, cli)
+	if err != nil {
+		return nil, err
+	}
 
 	var (
 		v3Client     = github.NewV3Client(apiURL, token, cli)
@@ -541,7 +544,15 @@ func (s *GithubSource) listPublic(ctx context.Context, results chan *githubResul
 //
 // Affiliation is present if the user: (1) owns the repo, (2) is apart of an org that
 // the repo belongs to, or (3) is a collaborator.
+//
+// Connections that authenticate as a GitHub App installation have no user, so the
+// repositories the installation has access to are returned instead.
 func (s *GithubSource) listAffiliated(ctx context.Context, results chan *githubResult) {
+	if s.config.GithubAppInstallation != nil {
+		s.listInstallation(ctx, results)
+		return
+	}
+
 	s.paginate(ctx, results, func(page int) (repos []*github.Repository, hasNext bool, cost int, err error) {
 		defer func() {
 			remaining, reset, retry, _ := s.v3Client.RateLimitMonitor().Get()
@@ -558,6 +569,25 @@ func (s *GithubSource) listAffiliated(ctx context.Context, results chan *githubR
 	})
 }
 
+// listInstallation returns the repositories the GitHub App installation of the
+// connection has access to by hitting the /installation/repositories endpoint.
+func (s *GithubSource) listInstallation(ctx context.Context, results chan *githubResult) {
+	s.paginate(ctx, results, func(page int) (repos []*github.Repository, hasNext bool, cost int, err error) {
+		defer func() {
+			remaining, reset, retry, _ := s.v3Client.RateLimitMonitor().Get()
+			log15.Debug(
+				"github sync: ListInstallationRepositories",
+				"repos", len(repos),
+				"rateLimitCost", cost,
+				"rateLimitRemaining", remaining,
+				"rateLimitReset", reset,
+				"retryAfter", retry,
+			)
+		}()
+		return s.v3Client.ListInstallationRepositories(ctx, page)
+	})
+}
+
 // listSearch handles the `repositoryQuery` config option when a keyword is not present.
 // It returns the repositories matching a GitHub's advanced repository search query
 // via the GraphQL API.
@@ -727,7 +757,7 @@ func matchOrg(q string) string {
 // listRepositoryQuery handles the `repositoryQuery` config option.
 // The supported keywords to select repositories are:
 // - `public`: public repositories (from endpoint: /repositories)
-// - `affiliated`: repositories affiliated with client token (from endpoint: /user/repos)
+// - `affiliated`: repositories affiliated with client token (from endpoint: /user/repos or /installation/repositories)
 // - `none`: disables `repositoryQuery`
 // Inputs other than these three keywords will be queried using
 // GitHub advanced repository search (endpoint: /search/repositories)
@@ -0,0 +1,182 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodules"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodules/goproxy"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A GoModulesSource creates git repositories from the zip archives of Go
+// modules served by Go module proxies.
+type GoModulesSource struct {
+	svc     *types.ExternalService
+	config  *schema.GoModulesConnection
+	dbStore GoModulesRepoStore
+}
+
+type GoModulesRepoStore interface {
+	GetGoDependencyRepos(ctx context.Context, filter dbstore.GetGoDependencyReposOpts) ([]dbstore.GoDependencyRepo, error)
+}
+
+// NewGoModulesSource returns a new GoModulesSource from the given external
+// service.
+func NewGoModulesSource(svc *types.ExternalService) (*GoModulesSource, error) {
+	var c schema.GoModulesConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, fmt.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	return &GoModulesSource{
+		svc:     svc,
+		config:  &c,
+		dbStore: nil, // set via SetDB decorator
+	}, nil
+}
+
+func (s *GoModulesSource) SetDB(db dbutil.DB) {
+	once.Do(func() {
+		observationContext = &observation.Context{
+			Logger:     log15.Root(),
+			Tracer:     &trace.Tracer{Tracer: opentracing.GlobalTracer()},
+			Registerer: prometheus.DefaultRegisterer,
+		}
+		operationMetrics = dbstore.NewREDMetrics(observationContext)
+	})
+	s.dbStore = dbstore.NewWithDB(db, observationContext, operationMetrics)
+}
+
+// ListRepos returns all Go modules accessible to all connections configured
+// in Sourcegraph via the external services configuration.
+func (s *GoModulesSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	modules, err := GoModules(*s.config)
+	if err != nil {
+		results <- SourceResult{Err: err}
+		return
+	}
+	for _, mod := range modules {
+		results <- SourceResult{
+			Source: s,
+			Repo:   s.makeRepo(mod),
+		}
+	}
+
+	var (
+		totalDBFetched  int
+		totalDBResolved int
+		lastID          int
+	)
+	for {
+		dbDeps, err := s.dbStore.GetGoDependencyRepos(ctx, dbstore.GetGoDependencyReposOpts{
+			After: lastID,
+			Limit: 100,
+		})
+		if err != nil {
+			results <- SourceResult{Err: err}
+			return
+		}
+
+		if len(dbDeps) == 0 {
+			break
+		}
+
+		totalDBFetched += len(dbDeps)
+
+		lastID = dbDeps[len(dbDeps)-1].ID
+
+		for _, dep := range dbDeps {
+			goDependency, err := reposource.ParseGoDependency(dep.Module + "@" + dep.Version)
+			if err != nil {
+				log15.Warn("error parsing Go module", "error", err, "module", dep.Module, "version", dep.Version)
+				continue
+			}
+
+			// We don't return anything that isn't resolvable here, to reduce
+			// logspam from gitserver, which doesn't check the proxies for
+			// dependencies from the database.
+			if exists, err := goproxy.Exists(ctx, s.config, goDependency); !exists {
+				log15.Warn("Go module not resolvable from module proxies", "module", goDependency.PackageManagerSyntax(), "error", err)
+				continue
+			}
+
+			totalDBResolved++
+			results <- SourceResult{
+				Source: s,
+				Repo:   s.makeRepo(goDependency.GoModule),
+			}
+		}
+	}
+
+	log15.Info("finished listing resolvable Go modules", "totalDB", totalDBFetched, "resolvedDB", totalDBResolved, "totalConfig", len(modules))
+}
+
+func (s *GoModulesSource) makeRepo(mod reposource.GoModule) *types.Repo {
+	urn := s.svc.URN()
+	return &types.Repo{
+		Name: mod.RepoName(),
+		URI:  string(mod.RepoName()),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          string(mod.RepoName()),
+			ServiceID:   extsvc.TypeGoModules,
+			ServiceType: extsvc.TypeGoModules,
+		},
+		Private: false,
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: mod.CloneURL(),
+			},
+		},
+		Metadata: &gomodules.Metadata{
+			Module: mod,
+		},
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *GoModulesSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func GoDependencies(connection schema.GoModulesConnection) (dependencies []reposource.GoDependency, err error) {
+	for _, dep := range connection.Dependencies {
+		dependency, err := reposource.ParseGoDependency(dep)
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies, nil
+}
+
+// GoModules returns the distinct modules of the dependencies configured in the
+// given connection.
+func GoModules(connection schema.GoModulesConnection) ([]reposource.GoModule, error) {
+	isAdded := make(map[reposource.GoModule]bool)
+	modules := []reposource.GoModule{}
+	dependencies, err := GoDependencies(connection)
+	if err != nil {
+		return nil, err
+	}
+	for _, dep := range dependencies {
+		if !isAdded[dep.GoModule] {
+			modules = append(modules, dep.GoModule)
+		}
+		isAdded[dep.GoModule] = true
+	}
+	return modules, nil
+}
@@ -0,0 +1,76 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type fakeGoModulesRepoStore []dbstore.GoDependencyRepo
+
+func (s fakeGoModulesRepoStore) GetGoDependencyRepos(ctx context.Context, filter dbstore.GetGoDependencyReposOpts) (repos []dbstore.GoDependencyRepo, _ error) {
+	for _, repo := range s {
+		if repo.ID > filter.After {
+			repos = append(repos, repo)
+		}
+	}
+	return repos, nil
+}
+
+func TestGoModulesSource_ListRepos(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/golang.org/x/mod/@v/v0.5.1.info", "/github.com/!burnt!sushi/toml/@v/v0.4.1.info":
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	svc := &types.ExternalService{
+		ID:   1,
+		Kind: extsvc.KindGoModules,
+		Config: fmt.Sprintf(`{
+			"urls": [%q],
+			"dependencies": ["golang.org/x/tools@v0.1.7", "golang.org/x/tools@v0.1.6", "github.com/google/go-cmp@v0.5.6"]
+		}`, srv.URL),
+	}
+	src, err := NewGoModulesSource(svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src.dbStore = fakeGoModulesRepoStore{
+		{ID: 1, Module: "golang.org/x/mod", Version: "v0.5.1"},
+		{ID: 2, Module: "golang.org/x/missing", Version: "v0.0.1"},
+		{ID: 3, Module: "github.com/BurntSushi/toml", Version: "v0.4.1"},
+		{ID: 4, Module: "github.com/golang/go", Version: "go1.17"},
+	}
+
+	repos, err := listAll(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []api.RepoName
+	for _, r := range repos {
+		names = append(names, r.Name)
+	}
+	want := []api.RepoName{"go/golang.org/x/tools", "go/github.com/google/go-cmp", "go/golang.org/x/mod", "go/github.com/BurntSushi/toml"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Fatalf("unexpected repos (-want +got):\n%s", diff)
+	}
+
+	if have, want := repos[1].Sources[svc.URN()].CloneURL, "go/github.com/google/go-cmp"; have != want {
+		t.Errorf("unexpected clone URL: have %q, want %q", have, want)
+	}
+}
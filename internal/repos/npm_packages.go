@@ -0,0 +1,183 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/npmpackages"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/npmpackages/npm"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// An NPMPackagesSource creates git repositories from the tarballs of npm
+// packages published to an npm registry.
+type NPMPackagesSource struct {
+	svc     *types.ExternalService
+	config  *schema.NPMPackagesConnection
+	dbStore NPMPackagesRepoStore
+}
+
+type NPMPackagesRepoStore interface {
+	GetNPMDependencyRepos(ctx context.Context, filter dbstore.GetNPMDependencyReposOpts) ([]dbstore.NPMDependencyRepo, error)
+}
+
+// NewNPMPackagesSource returns a new NPMPackagesSource from the given external
+// service.
+func NewNPMPackagesSource(svc *types.ExternalService) (*NPMPackagesSource, error) {
+	var c schema.NPMPackagesConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, fmt.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	return &NPMPackagesSource{
+		svc:     svc,
+		config:  &c,
+		dbStore: nil, // set via SetDB decorator
+	}, nil
+}
+
+func (s *NPMPackagesSource) SetDB(db dbutil.DB) {
+	once.Do(func() {
+		observationContext = &observation.Context{
+			Logger:     log15.Root(),
+			Tracer:     &trace.Tracer{Tracer: opentracing.GlobalTracer()},
+			Registerer: prometheus.DefaultRegisterer,
+		}
+		operationMetrics = dbstore.NewREDMetrics(observationContext)
+	})
+	s.dbStore = dbstore.NewWithDB(db, observationContext, operationMetrics)
+}
+
+// ListRepos returns all npm packages accessible to all connections configured
+// in Sourcegraph via the external services configuration.
+func (s *NPMPackagesSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	packages, err := NPMPackages(*s.config)
+	if err != nil {
+		results <- SourceResult{Err: err}
+		return
+	}
+	for _, pkg := range packages {
+		results <- SourceResult{
+			Source: s,
+			Repo:   s.makeRepo(pkg),
+		}
+	}
+
+	var (
+		totalDBFetched  int
+		totalDBResolved int
+		lastID          int
+	)
+	for {
+		dbDeps, err := s.dbStore.GetNPMDependencyRepos(ctx, dbstore.GetNPMDependencyReposOpts{
+			After: lastID,
+			Limit: 100,
+		})
+		if err != nil {
+			results <- SourceResult{Err: err}
+			return
+		}
+
+		if len(dbDeps) == 0 {
+			break
+		}
+
+		totalDBFetched += len(dbDeps)
+
+		lastID = dbDeps[len(dbDeps)-1].ID
+
+		for _, dep := range dbDeps {
+			parsedPackage, err := reposource.ParseNPMPackageFromPackageSyntax(dep.Package)
+			if err != nil {
+				log15.Warn("error parsing npm package", "error", err, "package", dep.Package)
+				continue
+			}
+			npmDependency := reposource.NPMDependency{NPMPackage: parsedPackage, Version: dep.Version}
+
+			// We don't return anything that isn't resolvable here, to reduce
+			// logspam from gitserver, which doesn't check the registry for
+			// dependencies from the database.
+			if exists, err := npm.Exists(ctx, s.config, npmDependency); !exists {
+				log15.Warn("npm package not resolvable from registry", "package", npmDependency.PackageManagerSyntax(), "error", err)
+				continue
+			}
+
+			totalDBResolved++
+			results <- SourceResult{
+				Source: s,
+				Repo:   s.makeRepo(npmDependency.NPMPackage),
+			}
+		}
+	}
+
+	log15.Info("finished listing resolvable npm packages", "totalDB", totalDBFetched, "resolvedDB", totalDBResolved, "totalConfig", len(packages))
+}
+
+func (s *NPMPackagesSource) makeRepo(pkg reposource.NPMPackage) *types.Repo {
+	urn := s.svc.URN()
+	return &types.Repo{
+		Name: pkg.RepoName(),
+		URI:  string(pkg.RepoName()),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          string(pkg.RepoName()),
+			ServiceID:   extsvc.TypeNPMPackages,
+			ServiceType: extsvc.TypeNPMPackages,
+		},
+		Private: false,
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: pkg.CloneURL(),
+			},
+		},
+		Metadata: &npmpackages.Metadata{
+			Package: pkg,
+		},
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *NPMPackagesSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func NPMDependencies(connection schema.NPMPackagesConnection) (dependencies []reposource.NPMDependency, err error) {
+	for _, dep := range connection.Dependencies {
+		dependency, err := reposource.ParseNPMDependency(dep)
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies, nil
+}
+
+// NPMPackages returns the distinct packages of the dependencies configured in
+// the given connection.
+func NPMPackages(connection schema.NPMPackagesConnection) ([]reposource.NPMPackage, error) {
+	isAdded := make(map[reposource.NPMPackage]bool)
+	packages := []reposource.NPMPackage{}
+	dependencies, err := NPMDependencies(connection)
+	if err != nil {
+		return nil, err
+	}
+	for _, dep := range dependencies {
+		if !isAdded[dep.NPMPackage] {
+			packages = append(packages, dep.NPMPackage)
+		}
+		isAdded[dep.NPMPackage] = true
+	}
+	return packages, nil
+}
@@ -0,0 +1,75 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type fakeNPMPackagesRepoStore []dbstore.NPMDependencyRepo
+
+func (s fakeNPMPackagesRepoStore) GetNPMDependencyRepos(ctx context.Context, filter dbstore.GetNPMDependencyReposOpts) (repos []dbstore.NPMDependencyRepo, _ error) {
+	for _, repo := range s {
+		if repo.ID > filter.After {
+			repos = append(repos, repo)
+		}
+	}
+	return repos, nil
+}
+
+func TestNPMPackagesSource_ListRepos(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/lodash/4.17.21", "/@types/node/16.11.0":
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	svc := &types.ExternalService{
+		ID:   1,
+		Kind: extsvc.KindNPMPackages,
+		Config: fmt.Sprintf(`{
+			"registry": %q,
+			"dependencies": ["react@17.0.2", "react@16.14.0", "@sourcegraph/prettierrc@3.0.3"]
+		}`, srv.URL),
+	}
+	src, err := NewNPMPackagesSource(svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src.dbStore = fakeNPMPackagesRepoStore{
+		{ID: 1, Package: "lodash", Version: "4.17.21"},
+		{ID: 2, Package: "left-pad", Version: "0.0.0"},
+		{ID: 3, Package: "@types/node", Version: "16.11.0"},
+	}
+
+	repos, err := listAll(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []api.RepoName
+	for _, r := range repos {
+		names = append(names, r.Name)
+	}
+	want := []api.RepoName{"npm/react", "npm/sourcegraph/prettierrc", "npm/lodash", "npm/types/node"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Fatalf("unexpected repos (-want +got):\n%s", diff)
+	}
+
+	if have, want := repos[1].Sources[svc.URN()].CloneURL, "npm/sourcegraph/prettierrc"; have != want {
+		t.Errorf("unexpected clone URL: have %q, want %q", have, want)
+	}
+}
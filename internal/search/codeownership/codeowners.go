@@ -0,0 +1,138 @@
+// Package codeownership resolves the owners of files from CODEOWNERS files and
+// uses them to filter and select search results.
+package codeownership
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// CodeownersPaths are the locations a CODEOWNERS file is looked up in, in order
+// of precedence. Only the first file found is used, like on GitHub and GitLab.
+var CodeownersPaths = []string{
+	".github/CODEOWNERS",
+	"CODEOWNERS",
+	"docs/CODEOWNERS",
+}
+
+// Ruleset is a parsed CODEOWNERS file.
+type Ruleset struct {
+	rules []rule
+}
+
+type rule struct {
+	pattern *regexp.Regexp
+	owners  []string
+}
+
+// Parse parses a CODEOWNERS file. Each non-empty line that is not a comment
+// consists of a path pattern followed by zero or more owners. Lines with an
+// invalid pattern are skipped, like code hosts do.
+func Parse(r io.Reader) (*Ruleset, error) {
+	rs := &Ruleset{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		// GitLab section headers, such as "[Documentation]".
+		if strings.HasPrefix(fields[0], "[") || strings.HasPrefix(fields[0], "^[") {
+			continue
+		}
+
+		pattern, err := compilePattern(fields[0])
+		if err != nil {
+			continue
+		}
+		rs.rules = append(rs.rules, rule{pattern: pattern, owners: fields[1:]})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+// Match returns the owners of the file at the given path. The last rule that
+// matches the path takes precedence. A nil slice is returned if no rule
+// matches, or if the matching rule lists no owners.
+func (rs *Ruleset) Match(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+	for i := len(rs.rules) - 1; i >= 0; i-- {
+		if rs.rules[i].pattern.MatchString(path) {
+			if len(rs.rules[i].owners) == 0 {
+				return nil
+			}
+			return rs.rules[i].owners
+		}
+	}
+	return nil
+}
+
+// compilePattern converts a CODEOWNERS path pattern, which follows gitignore
+// semantics, into a regular expression matching file paths relative to the
+// repository root.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	// A pattern with a slash anywhere but at the end is relative to the
+	// repository root, otherwise it matches at any depth.
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	pattern = strings.TrimPrefix(strings.TrimSuffix(pattern, "/"), "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored && !strings.HasPrefix(pattern, "**") {
+		b.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					// "**/" matches zero or more directories.
+					i++
+					b.WriteString("(?:.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	// A pattern matching a directory owns everything below it.
+	b.WriteString("(?:/.*)?$")
+	return regexp.Compile(b.String())
+}
+
+// OwnerMatches returns true if the CODEOWNERS owner matches the owner given in
+// a query. Owners are compared case insensitively, ignoring the leading "@" of
+// handles. A team name without its organization, such as "@backend", matches
+// the team in any organization, such as "@sourcegraph/backend".
+func OwnerMatches(owner, query string) bool {
+	owner = strings.ToLower(strings.TrimPrefix(owner, "@"))
+	query = strings.ToLower(strings.TrimPrefix(query, "@"))
+	if owner == query {
+		return true
+	}
+	if !strings.Contains(query, "/") {
+		if i := strings.Index(owner, "/"); i >= 0 {
+			return owner[i+1:] == query
+		}
+	}
+	return false
+}
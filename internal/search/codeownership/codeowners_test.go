@@ -0,0 +1,66 @@
+package codeownership
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRuleset_Match(t *testing.T) {
+	rs, err := Parse(strings.NewReader(`
+# Default owners.
+*                   @sourcegraph/everyone
+
+*.go                @sourcegraph/backend
+/docs/              docs@sourcegraph.com
+cmd/**/main.go      @alice @bob
+internal/search/    @sourcegraph/search # Search owns this.
+**/testdata         @carol
+client/generated/
+
+[Section]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, want := range map[string][]string{
+		"README.md":                      {"@sourcegraph/everyone"},
+		"main.go":                        {"@sourcegraph/backend"},
+		"internal/repos/sources.go":      {"@sourcegraph/backend"},
+		"docs/index.md":                  {"docs@sourcegraph.com"},
+		"internal/docs/index.md":         {"@sourcegraph/everyone"},
+		"cmd/main.go":                    {"@alice", "@bob"},
+		"cmd/frontend/main.go":           {"@alice", "@bob"},
+		"cmd/frontend/graphql/main.go":   {"@alice", "@bob"},
+		"internal/search/query/types.go": {"@sourcegraph/search"},
+		"internal/search":                {"@sourcegraph/search"},
+		"internal/searcher/main.go":      {"@sourcegraph/backend"},
+		"a/testdata/b/c.json":            {"@carol"},
+		"client/generated/schema.ts":     nil,
+	} {
+		if diff := cmp.Diff(want, rs.Match(path)); diff != "" {
+			t.Errorf("unexpected owners for %q (-want +got):\n%s", path, diff)
+		}
+	}
+}
+
+func TestOwnerMatches(t *testing.T) {
+	for _, tc := range []struct {
+		owner, query string
+		want         bool
+	}{
+		{"@sourcegraph/search", "@sourcegraph/search", true},
+		{"@sourcegraph/search", "sourcegraph/Search", true},
+		{"@sourcegraph/search", "@search", true},
+		{"@sourcegraph/search", "@other/search", false},
+		{"@alice", "alice", true},
+		{"@alice", "@alicia", false},
+		{"alice@example.com", "ALICE@example.com", true},
+	} {
+		if got := OwnerMatches(tc.owner, tc.query); got != tc.want {
+			t.Errorf("OwnerMatches(%q, %q) = %t, want %t", tc.owner, tc.query, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package codeownership
+
+import (
+	"context"
+	"sync"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+// FilterMatches returns the file matches owned by any of the given owners.
+// Matches of other types are dropped, since only files have owners.
+func FilterMatches(ctx context.Context, resolver *Resolver, matches []result.Match, owners []string) ([]result.Match, error) {
+	filtered := matches[:0]
+	for _, m := range matches {
+		fm, ok := m.(*result.FileMatch)
+		if !ok {
+			continue
+		}
+		fileOwners, err := resolver.Owners(ctx, fm.Repo.Name, fm.CommitID, fm.Path)
+		if err != nil {
+			return nil, err
+		}
+		if containsAnyOwner(fileOwners, owners) {
+			filtered = append(filtered, fm)
+		}
+	}
+	return filtered, nil
+}
+
+func containsAnyOwner(fileOwners, owners []string) bool {
+	for _, fileOwner := range fileOwners {
+		for _, owner := range owners {
+			if OwnerMatches(fileOwner, owner) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SelectOwners converts file matches to the owners of the matched files.
+// Owners of several files are only returned once. Matches of other types are
+// dropped.
+func SelectOwners(ctx context.Context, resolver *Resolver, matches []result.Match) ([]result.Match, error) {
+	dedup := result.NewDeduper()
+	for _, m := range matches {
+		fm, ok := m.(*result.FileMatch)
+		if !ok {
+			continue
+		}
+		fileOwners, err := resolver.Owners(ctx, fm.Repo.Name, fm.CommitID, fm.Path)
+		if err != nil {
+			return nil, err
+		}
+		for _, owner := range fileOwners {
+			dedup.Add(&result.OwnerMatch{Handle: owner, Repo: fm.Repo})
+		}
+	}
+	return dedup.Results(), nil
+}
+
+// IsSelectOwners returns true if the select path is select:file.owners.
+func IsSelectOwners(sp filter.SelectPath) bool {
+	return sp.Root() == filter.File && len(sp) > 1 && sp[1] == "owners"
+}
+
+// WithSelectOwners returns a child Stream of parent that converts the file
+// matches of each event to the owners of the matched files, deduplicating
+// owners across events. Errors resolving owners are logged and the affected
+// matches dropped, since a stream cannot fail.
+func WithSelectOwners(ctx context.Context, parent streaming.Sender, resolver *Resolver) streaming.Sender {
+	var mu sync.Mutex
+	dedup := result.NewDeduper()
+
+	return streaming.StreamFunc(func(e streaming.SearchEvent) {
+		selected := make([]result.Match, 0, len(e.Results))
+		for _, m := range e.Results {
+			owners, err := SelectOwners(ctx, resolver, []result.Match{m})
+			if err != nil {
+				log15.Warn("codeownership: failed to resolve owners", "repo", m.RepoName().Name, "error", err)
+				continue
+			}
+
+			mu.Lock()
+			for _, owner := range owners {
+				if dedup.Seen(owner) {
+					continue
+				}
+				dedup.Add(owner)
+				selected = append(selected, owner)
+			}
+			mu.Unlock()
+		}
+		e.Results = selected
+		parent.Send(e)
+	})
+}
@@ -0,0 +1,81 @@
+package codeownership
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+func mockCodeowners(t *testing.T, files map[string]string) {
+	git.Mocks.ReadFile = func(commit api.CommitID, name string) ([]byte, error) {
+		if content, ok := files[name]; ok {
+			return []byte(content), nil
+		}
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	t.Cleanup(func() { git.Mocks.ReadFile = nil })
+}
+
+func fileMatch(repo, path string) *result.FileMatch {
+	return &result.FileMatch{
+		File: result.File{
+			Repo:     types.MinimalRepo{Name: api.RepoName(repo)},
+			CommitID: "deadbeef",
+			Path:     path,
+		},
+	}
+}
+
+func TestFilterMatches(t *testing.T) {
+	mockCodeowners(t, map[string]string{
+		// Shadowed by .github/CODEOWNERS.
+		"CODEOWNERS":         "* @nobody",
+		".github/CODEOWNERS": "*.go @sourcegraph/backend\n/client/ @sourcegraph/frontend\n",
+	})
+
+	matches := []result.Match{
+		fileMatch("a", "main.go"),
+		fileMatch("a", "client/index.ts"),
+		fileMatch("a", "README.md"),
+		&result.RepoMatch{Name: "a"},
+	}
+
+	filtered, err := FilterMatches(context.Background(), NewResolver(), matches, []string{"@backend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered) != 1 || filtered[0].(*result.FileMatch).Path != "main.go" {
+		t.Fatalf("unexpected matches: %v", filtered)
+	}
+}
+
+func TestSelectOwners(t *testing.T) {
+	mockCodeowners(t, map[string]string{
+		"docs/CODEOWNERS": "*.go @sourcegraph/backend\n*.md @alice @sourcegraph/backend\n",
+	})
+
+	matches := []result.Match{
+		fileMatch("a", "main.go"),
+		fileMatch("a", "README.md"),
+		fileMatch("b", "LICENSE"),
+	}
+
+	owners, err := SelectOwners(context.Background(), NewResolver(), matches)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, m := range owners {
+		got = append(got, m.(*result.OwnerMatch).Handle)
+	}
+	if diff := cmp.Diff([]string{"@sourcegraph/backend", "@alice"}, got); diff != "" {
+		t.Fatalf("unexpected owners (-want +got):\n%s", diff)
+	}
+}
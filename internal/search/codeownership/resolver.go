@@ -0,0 +1,82 @@
+package codeownership
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+// maxCodeownersSize is the maximum number of bytes read from a CODEOWNERS
+// file. GitHub ignores CODEOWNERS files larger than 3MB.
+const maxCodeownersSize = 3 << 20
+
+// Resolver fetches the CODEOWNERS file of repository revisions from gitserver.
+// Rulesets are cached for the lifetime of the resolver, which is expected to be
+// a single search.
+type Resolver struct {
+	mu    sync.Mutex
+	cache map[resolverKey]*Ruleset
+}
+
+type resolverKey struct {
+	repo   api.RepoName
+	commit api.CommitID
+}
+
+func NewResolver() *Resolver {
+	return &Resolver{cache: make(map[resolverKey]*Ruleset)}
+}
+
+// Ruleset returns the CODEOWNERS ruleset of the given repository commit. An
+// empty ruleset is returned if the commit has no CODEOWNERS file. If commit is
+// empty, the CODEOWNERS file of HEAD is used.
+func (r *Resolver) Ruleset(ctx context.Context, repo api.RepoName, commit api.CommitID) (*Ruleset, error) {
+	if commit == "" {
+		commit = "HEAD"
+	}
+	key := resolverKey{repo: repo, commit: commit}
+
+	r.mu.Lock()
+	rs, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok {
+		return rs, nil
+	}
+
+	rs, err := fetchRuleset(ctx, repo, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cache[key] = rs
+	r.mu.Unlock()
+	return rs, nil
+}
+
+// Owners returns the owners of the file at path in the given repository commit.
+func (r *Resolver) Owners(ctx context.Context, repo api.RepoName, commit api.CommitID, path string) ([]string, error) {
+	rs, err := r.Ruleset(ctx, repo, commit)
+	if err != nil {
+		return nil, err
+	}
+	return rs.Match(path), nil
+}
+
+func fetchRuleset(ctx context.Context, repo api.RepoName, commit api.CommitID) (*Ruleset, error) {
+	for _, path := range CodeownersPaths {
+		content, err := git.ReadFile(ctx, repo, commit, path, maxCodeownersSize)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		return Parse(bytes.NewReader(content))
+	}
+	return &Ruleset{}, nil
+}
@@ -9,6 +9,9 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
@@ -18,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	gitprotocol "github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	searchrepos "github.com/sourcegraph/sourcegraph/internal/search/repos"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
@@ -31,6 +35,11 @@ type CommitSearch struct {
 	Diff          bool
 	HasTimeFilter bool
 	Limit         int
+	Select        filter.SelectPath
+
+	// SubRepoPerms is used to remove the files of a diff the actor may not
+	// read before the diff is formatted.
+	SubRepoPerms authz.SubRepoPermissionChecker
 
 	Db database.DB
 }
@@ -61,6 +70,9 @@ func (j *CommitSearch) Run(ctx context.Context, stream streaming.Sender, repos s
 		return err
 	}
 
+	diffGranularity := selectDiffGranularity(j.Select)
+	checkSubRepoPerms := j.Diff && j.SubRepoPerms != nil && j.SubRepoPerms.Enabled()
+
 	g, ctx := errgroup.WithContext(ctx)
 	for _, repoRev := range repoRevs {
 		repoRev := repoRev // we close over repoRev in onMatches
@@ -71,16 +83,36 @@ func (j *CommitSearch) Run(ctx context.Context, stream streaming.Sender, repos s
 		}
 
 		args := &protocol.SearchRequest{
-			Repo:        repoRev.Repo.Name,
-			Revisions:   searchRevsToGitserverRevs(repoRev.Revs),
-			Query:       j.Query,
-			IncludeDiff: j.Diff,
-			Limit:       j.Limit,
+			Repo:             repoRev.Repo.Name,
+			Revisions:        searchRevsToGitserverRevs(repoRev.Revs),
+			Query:            j.Query,
+			IncludeDiff:      j.Diff,
+			IncludeDiffFiles: j.Diff && (diffGranularity != "" || checkSubRepoPerms),
+			Limit:            j.Limit,
 		}
 
+		// permsErr is the first error encountered while checking sub-repo
+		// permissions. Matches that cannot be checked are dropped.
+		var permsErr error
 		onMatches := func(in []protocol.CommitMatch) {
 			res := make([]result.Match, 0, len(in))
 			for _, protocolMatch := range in {
+				if checkSubRepoPerms {
+					// 🚨 SECURITY: We filter the structured files of the diff
+					// rather than the formatted diff, since file names may
+					// contain any character.
+					ok, err := filterDiffFiles(ctx, j.SubRepoPerms, repoRev.Repo.Name, &protocolMatch)
+					if err != nil && permsErr == nil {
+						permsErr = err
+					}
+					if err != nil || !ok {
+						continue
+					}
+				}
+				if j.Diff && diffGranularity != "" {
+					res = append(res, protocolMatchToDiffFileMatches(repoRev.Repo, protocolMatch, diffGranularity == "hunk")...)
+					continue
+				}
 				res = append(res, protocolMatchToCommitMatch(repoRev.Repo, j.Diff, protocolMatch))
 			}
 			stream.Send(streaming.SearchEvent{
@@ -95,7 +127,10 @@ func (j *CommitSearch) Run(ctx context.Context, stream streaming.Sender, repos s
 					IsLimitHit: limitHit,
 				},
 			})
-			return err
+			if err != nil {
+				return err
+			}
+			return permsErr
 		})
 	}
 
@@ -346,6 +381,83 @@ func protocolMatchToCommitMatch(repo types.MinimalRepo, diff bool, in protocol.C
 	}
 }
 
+// selectDiffGranularity returns "file" or "hunk" if the select path asks for
+// diff results to be split into one match per file or hunk, and the empty
+// string otherwise.
+func selectDiffGranularity(sp filter.SelectPath) string {
+	if len(sp) == 3 && sp.Root() == filter.Commit && sp[1] == "diff" {
+		switch sp[2] {
+		case "file", "hunk":
+			return sp[2]
+		}
+	}
+	return ""
+}
+
+// protocolMatchToDiffFileMatches splits a diff match into one match per
+// modified file, or per hunk if perHunk is set. Each match carries the
+// structured file it is scoped to, and a diff preview of only that file.
+func protocolMatchToDiffFileMatches(repo types.MinimalRepo, in protocol.CommitMatch, perHunk bool) []result.Match {
+	var pieces []result.DiffFile
+	for _, file := range in.DiffFiles {
+		if !perHunk {
+			pieces = append(pieces, file)
+			continue
+		}
+		for _, hunk := range file.Hunks {
+			pieces = append(pieces, result.DiffFile{
+				OrigName: file.OrigName,
+				NewName:  file.NewName,
+				Hunks:    []result.DiffHunk{hunk},
+			})
+		}
+	}
+
+	res := make([]result.Match, 0, len(pieces))
+	for i := range pieces {
+		piece := pieces[i]
+		in.Diff = result.FormatDiffFiles([]result.DiffFile{piece})
+		cm := protocolMatchToCommitMatch(repo, true, in)
+		cm.DiffFile = &piece
+		cm.IsDiffHunk = perHunk
+		res = append(res, cm)
+	}
+	return res
+}
+
+// filterDiffFiles removes the files the actor in ctx may not read from the
+// diff of match and formats the diff from the remaining files. It returns
+// false if no files remain.
+func filterDiffFiles(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName, match *protocol.CommitMatch) (bool, error) {
+	a := actor.FromContext(ctx)
+	files := make([]result.DiffFile, 0, len(match.DiffFiles))
+	for _, file := range match.DiffFiles {
+		ok := true
+		for _, name := range []string{file.OrigName, file.NewName} {
+			if name == "" || name == "/dev/null" {
+				continue
+			}
+			var err error
+			ok, err = authz.FilterActorPath(ctx, checker, a, repo, name)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				break
+			}
+		}
+		if ok {
+			files = append(files, file)
+		}
+	}
+	if len(files) == 0 {
+		return false, nil
+	}
+	match.DiffFiles = files
+	match.Diff = result.FormatDiffFiles(files)
+	return true, nil
+}
+
 func searchRangesToHighlights(s string, ranges []result.Range) []result.HighlightedRange {
 	res := make([]result.HighlightedRange, 0, len(ranges))
 	for _, r := range ranges {
@@ -1,13 +1,20 @@
 package commit
 
 import (
+	"context"
 	"strconv"
+	"strings"
 	"testing"
 	"testing/quick"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
 func TestCommitSearchResult_Limit(t *testing.T) {
@@ -111,3 +118,112 @@ func Test_searchRangeToHighlights(t *testing.T) {
 		})
 	}
 }
+
+func TestProtocolMatchToDiffFileMatches(t *testing.T) {
+	hunk := func(start int32, content string) result.DiffHunk {
+		return result.DiffHunk{
+			OldStart: start,
+			OldLines: 1,
+			NewStart: start,
+			NewLines: 1,
+			Lines: []result.DiffLine{
+				{Kind: result.DiffLineRemoved, OldLine: start, Content: "old"},
+				{Kind: result.DiffLineAdded, NewLine: start, Content: content},
+			},
+		}
+	}
+
+	in := protocol.CommitMatch{
+		Oid: "abc",
+		DiffFiles: []result.DiffFile{
+			{OrigName: "a.go", NewName: "a.go", Hunks: []result.DiffHunk{hunk(1, "one"), hunk(10, "two")}},
+			{OrigName: "b.go", NewName: "/dev/null", Hunks: []result.DiffHunk{hunk(3, "three")}},
+		},
+	}
+	repo := types.MinimalRepo{Name: "repo"}
+
+	keys := func(matches []result.Match) []result.Key {
+		var res []result.Key
+		for _, m := range matches {
+			res = append(res, m.Key())
+		}
+		return res
+	}
+
+	t.Run("file", func(t *testing.T) {
+		matches := protocolMatchToDiffFileMatches(repo, in, false)
+		require.Equal(t, []result.Key{
+			{Repo: "repo", Commit: "abc", Path: "a.go", TypeRank: 2},
+			{Repo: "repo", Commit: "abc", Path: "b.go", TypeRank: 2},
+		}, keys(matches))
+
+		cm := matches[1].(*result.CommitMatch)
+		require.Equal(t, "b.go /dev/null\n@@ -3,1 +3,1 @@ \n-old\n+three\n", cm.DiffPreview.Value)
+
+		sp, _ := filter.SelectPathFromString("commit.diff.file")
+		require.Equal(t, cm, cm.Select(sp))
+	})
+
+	t.Run("hunk", func(t *testing.T) {
+		matches := protocolMatchToDiffFileMatches(repo, in, true)
+		require.Equal(t, []result.Key{
+			{Repo: "repo", Commit: "abc", Path: "a.go", HunkStart: 1, TypeRank: 2},
+			{Repo: "repo", Commit: "abc", Path: "a.go", HunkStart: 10, TypeRank: 2},
+			{Repo: "repo", Commit: "abc", Path: "b.go", HunkStart: 3, TypeRank: 2},
+		}, keys(matches))
+
+		sp, _ := filter.SelectPathFromString("commit.diff.hunk")
+		for _, m := range matches {
+			require.Equal(t, m, m.Select(sp))
+		}
+	})
+}
+
+func TestFilterDiffFiles(t *testing.T) {
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.PermissionsFunc.SetDefaultHook(func(ctx context.Context, userID int32, content authz.RepoContent) (authz.Perms, error) {
+		if strings.HasPrefix(content.Path, "secret dir/") {
+			return authz.None, nil
+		}
+		return authz.Read, nil
+	})
+
+	file := func(origName, newName string) result.DiffFile {
+		return result.DiffFile{
+			OrigName: origName,
+			NewName:  newName,
+			Hunks: []result.DiffHunk{{
+				OldStart: 1, OldLines: 1, NewStart: 1, NewLines: 1,
+				Lines: []result.DiffLine{{Kind: result.DiffLineAdded, NewLine: 1, Content: "new"}},
+			}},
+		}
+	}
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+
+	// The restricted path contains a space, which makes the formatted diff
+	// header ambiguous.
+	match := protocol.CommitMatch{
+		DiffFiles: []result.DiffFile{
+			file("README.md", "README.md"),
+			file("/dev/null", "secret dir/token.txt"),
+		},
+	}
+	ok, err := filterDiffFiles(ctx, checker, "repo", &match)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []result.DiffFile{file("README.md", "README.md")}, match.DiffFiles)
+	require.Equal(t, "README.md README.md\n@@ -1,1 +1,1 @@ \n+new\n", match.Diff.Content)
+
+	match = protocol.CommitMatch{
+		DiffFiles: []result.DiffFile{file("secret dir/token.txt", "/dev/null")},
+	}
+	ok, err = filterDiffFiles(ctx, checker, "repo", &match)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Anonymous users cannot be checked.
+	_, err = filterDiffFiles(context.Background(), checker, "repo", &match)
+	require.Error(t, err)
+}
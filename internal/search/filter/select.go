@@ -37,11 +37,14 @@ var validSelectors = object{
 		"diff": object{
 			"added":   nil,
 			"removed": nil,
+			"file":    nil,
+			"hunk":    nil,
 		},
 	},
 	Content: nil,
 	File: {
 		"directory": nil,
+		"owners":    nil,
 		"path":      nil,
 	},
 	Repository: nil,
@@ -31,10 +31,13 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"contains.file":         func() Predicate { return &RepoContainsFilePredicate{} },
 		"contains.content":      func() Predicate { return &RepoContainsContentPredicate{} },
 		"contains.commit.after": func() Predicate { return &RepoContainsCommitAfterPredicate{} },
+		"has.topic":             func() Predicate { return &RepoHasTopicPredicate{} },
+		"has.description":       func() Predicate { return &RepoHasDescriptionPredicate{} },
 	},
 	FieldFile: {
 		"contains.content": func() Predicate { return &FileContainsContentPredicate{} },
 		"contains":         func() Predicate { return &FileContainsContentPredicate{} },
+		"has.owner":        func() Predicate { return &FileHasOwnerPredicate{} },
 	},
 }
 
@@ -261,6 +264,111 @@ func (f *RepoContainsCommitAfterPredicate) Plan(parent Basic) (Plan, error) {
 	return ToPlan(Dnf(nodes))
 }
 
+/* repo:has.topic(topic) */
+
+type RepoHasTopicPredicate struct {
+	Topic string
+}
+
+func (f *RepoHasTopicPredicate) ParseParams(params string) error {
+	if params == "" {
+		return errors.Errorf("has.topic argument should not be empty")
+	}
+	f.Topic = params
+	return nil
+}
+
+func (f *RepoHasTopicPredicate) Field() string { return FieldRepo }
+func (f *RepoHasTopicPredicate) Name() string  { return "has.topic" }
+func (f *RepoHasTopicPredicate) Plan(parent Basic) (Plan, error) {
+	nodes := make([]Node, 0, 3)
+	nodes = append(nodes, Parameter{
+		Field: FieldCount,
+		Value: "99999",
+	}, Parameter{
+		Field: FieldRepoHasTopic,
+		Value: f.Topic,
+	})
+
+	nodes = append(nodes, nonPredicateRepos(parent)...)
+	return ToPlan(Dnf(nodes))
+}
+
+/* repo:has.description(pattern) */
+
+type RepoHasDescriptionPredicate struct {
+	Pattern string
+}
+
+func (f *RepoHasDescriptionPredicate) ParseParams(params string) error {
+	if _, err := regexp.Compile(params); err != nil {
+		return errors.Errorf("has.description argument: %w", err)
+	}
+	if params == "" {
+		return errors.Errorf("has.description argument should not be empty")
+	}
+	if syntax := unsupportedDescriptionSyntax(params); syntax != "" {
+		return errors.Errorf("has.description argument: %s is not supported in description patterns", syntax)
+	}
+	f.Pattern = params
+	return nil
+}
+
+// unsupportedDescriptionSyntax returns the first RE2 construct in pattern that
+// the database, which matches descriptions with PostgreSQL regular
+// expressions, interprets differently or not at all. It returns an empty
+// string if pattern means the same in both dialects. Lookarounds and
+// backreferences, which only PostgreSQL supports, are already rejected by RE2.
+func unsupportedDescriptionSyntax(pattern string) string {
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			switch e := pattern[i]; e {
+			case 'b', 'B', 'z', 'Q', 'E', 'p', 'P', 'C':
+				return `\` + string(e)
+			case 'x':
+				if i+1 < len(pattern) && pattern[i+1] == '{' {
+					return `\x{...}`
+				}
+			}
+		case inClass:
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			// A closing bracket right after the opening one is a literal.
+			if strings.HasPrefix(pattern[i+1:], "^") {
+				i++
+			}
+			if strings.HasPrefix(pattern[i+1:], "]") {
+				i++
+			}
+		case c == '(' && strings.HasPrefix(pattern[i+1:], "?") && !strings.HasPrefix(pattern[i+1:], "?:"):
+			return "(?"
+		}
+	}
+	return ""
+}
+
+func (f *RepoHasDescriptionPredicate) Field() string { return FieldRepo }
+func (f *RepoHasDescriptionPredicate) Name() string  { return "has.description" }
+func (f *RepoHasDescriptionPredicate) Plan(parent Basic) (Plan, error) {
+	nodes := make([]Node, 0, 3)
+	nodes = append(nodes, Parameter{
+		Field: FieldCount,
+		Value: "99999",
+	}, Parameter{
+		Field: FieldRepoHasDescription,
+		Value: f.Pattern,
+	})
+
+	nodes = append(nodes, nonPredicateRepos(parent)...)
+	return ToPlan(Dnf(nodes))
+}
+
 type FileContainsContentPredicate struct {
 	Pattern string
 }
@@ -296,6 +404,85 @@ func (f *FileContainsContentPredicate) Plan(parent Basic) (Plan, error) {
 	return ToPlan(Dnf(nodes))
 }
 
+/* file:has.owner(owner) */
+
+type FileHasOwnerPredicate struct {
+	Owner string
+}
+
+func (f *FileHasOwnerPredicate) ParseParams(params string) error {
+	if params == "" {
+		return errors.Errorf("file:has.owner argument should not be empty")
+	}
+	if strings.ContainsAny(params, " \t") {
+		return errors.Errorf("file:has.owner argument should be a single user, team or email address")
+	}
+	f.Owner = params
+	return nil
+}
+
+func (f FileHasOwnerPredicate) Field() string { return FieldFile }
+func (f FileHasOwnerPredicate) Name() string  { return "has.owner" }
+
+func (f *FileHasOwnerPredicate) Plan(parent Basic) (Plan, error) {
+	nodes := make([]Node, 0, 4)
+	nodes = append(nodes, Parameter{
+		Field: FieldCount,
+		Value: "99999",
+	}, Parameter{
+		Field: FieldFileHasOwner,
+		Value: f.Owner,
+	})
+
+	nodes = append(nodes, nonPredicateRepos(parent)...)
+	nodes = append(nodes, nonPredicateFiles(parent)...)
+
+	// Narrow down the files to resolve owners for with the pattern of the
+	// parent query. Without a pattern, every file in scope is a candidate.
+	if parent.Pattern != nil && !hasParameter(parent, FieldType) {
+		nodes = append(nodes, parent.Pattern)
+	} else {
+		nodes = append(nodes, Parameter{
+			Field: FieldType,
+			Value: "path",
+		}, Pattern{
+			Value:      ".",
+			Annotation: Annotation{Labels: Regexp},
+		})
+	}
+	return ToPlan(Dnf(nodes))
+}
+
+// nonPredicateFiles returns the file and language nodes in a query that
+// aren't predicates.
+func nonPredicateFiles(q Basic) []Node {
+	var res []Node
+	VisitParameter(q.ToParseTree(), func(field, value string, negated bool, ann Annotation) {
+		if ann.Labels.IsSet(IsPredicate) {
+			return
+		}
+		switch field {
+		case FieldFile, FieldLang:
+			res = append(res, Parameter{
+				Field:      field,
+				Value:      value,
+				Negated:    negated,
+				Annotation: ann,
+			})
+		}
+	})
+	return res
+}
+
+func hasParameter(q Basic, field string) bool {
+	for _, p := range q.Parameters {
+		if p.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
 // nonPredicateRepos returns the repo nodes in a query that aren't predicates,
 // respecting parameters that determine repo results.
 func nonPredicateRepos(q Basic) []Node {
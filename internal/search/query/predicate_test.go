@@ -80,3 +80,97 @@ func TestParseAsPredicate(t *testing.T) {
 	}
 
 }
+
+func TestRepoHasPredicates(t *testing.T) {
+	t.Run("has.topic", func(t *testing.T) {
+		p := &RepoHasTopicPredicate{}
+		if err := p.ParseParams(`payments`); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if p.Topic != "payments" {
+			t.Fatalf("expected topic payments, got %q", p.Topic)
+		}
+		if err := (&RepoHasTopicPredicate{}).ParseParams(``); err == nil {
+			t.Fatal("expected error for empty topic but got none")
+		}
+	})
+
+	t.Run("has.description", func(t *testing.T) {
+		p := &RepoHasDescriptionPredicate{}
+		if err := p.ParseParams(`payment (api|service)`); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if p.Pattern != "payment (api|service)" {
+			t.Fatalf("expected pattern, got %q", p.Pattern)
+		}
+		if err := p.ParseParams(`^(?:payment|billing)[\]\\(?]\s\d+\.$`); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		for _, params := range []string{``, `([)`, `pay(?=ment)`, `\bpayment\b`, `(?i)payment`, `(?P<kind>api)`, `\pL`, `payment\z`, `\Q.\E`, `\x{41}`} {
+			if err := (&RepoHasDescriptionPredicate{}).ParseParams(params); err == nil {
+				t.Fatalf("expected error for %q but got none", params)
+			}
+		}
+	})
+
+	t.Run("Plan", func(t *testing.T) {
+		plan, err := Pipeline(InitLiteral(`repo:^github\.com/sourcegraph repo:has.topic(payments) fork:yes test`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		predicatePlan, err := (&RepoHasTopicPredicate{Topic: "payments"}).Plan(plan[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `count:99999 repohastopic:payments repo:^github\.com/sourcegraph fork:yes`
+		if got := StringHuman(predicatePlan[0].ToParseTree()); got != want {
+			t.Fatalf("unexpected plan:\nwant: %s\ngot:  %s", want, got)
+		}
+	})
+}
+
+func TestFileHasOwnerPredicate(t *testing.T) {
+	t.Run("ParseParams", func(t *testing.T) {
+		p := &FileHasOwnerPredicate{}
+		if err := p.ParseParams(`@sourcegraph/search`); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if p.Owner != "@sourcegraph/search" {
+			t.Fatalf("expected owner @sourcegraph/search, got %q", p.Owner)
+		}
+		for _, params := range []string{``, `@alice @bob`} {
+			if err := (&FileHasOwnerPredicate{}).ParseParams(params); err == nil {
+				t.Fatalf("expected error for %q but got none", params)
+			}
+		}
+	})
+
+	t.Run("Plan", func(t *testing.T) {
+		cases := []struct {
+			query string
+			want  string
+		}{
+			{
+				query: `repo:^github\.com/sourcegraph file:has.owner(@search) lang:go test`,
+				want:  `count:99999 filehasowner:@search repo:^github\.com/sourcegraph lang:go test`,
+			},
+			{
+				query: `repo:^github\.com/sourcegraph file:has.owner(@search) file:\.go$`,
+				want:  `count:99999 filehasowner:@search repo:^github\.com/sourcegraph file:\.go$ type:path .`,
+			},
+		}
+		for _, tc := range cases {
+			plan, err := Pipeline(InitRegexp(tc.query))
+			if err != nil {
+				t.Fatal(err)
+			}
+			predicatePlan, err := (&FileHasOwnerPredicate{Owner: "@search"}).Plan(plan[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := StringHuman(predicatePlan[0].ToParseTree()); got != tc.want {
+				t.Errorf("unexpected plan for %q:\nwant: %s\ngot:  %s", tc.query, tc.want, got)
+			}
+		}
+	})
+}
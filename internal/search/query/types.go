@@ -396,9 +396,12 @@ func (q Q) valueToTypedValue(field, value string, label labels) []*Value {
 		FieldContent:
 		return []*Value{{String: &value}}
 
-	case FieldRepoHasFile:
+	case FieldRepoHasFile, FieldRepoHasDescription:
 		return []*Value{{Regexp: parseRegexpOrPanic(field, value)}}
 
+	case FieldRepoHasTopic, FieldFileHasOwner:
+		return []*Value{{String: &value}}
+
 	case
 		FieldRepoHasCommitAfter,
 		FieldBefore, "until",
@@ -322,6 +322,13 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	case
 		FieldRepoHasCommitAfter:
 		return satisfies(isSingular, isNotNegated)
+	case
+		FieldRepoHasTopic,
+		FieldFileHasOwner:
+		return satisfies(isNotNegated)
+	case
+		FieldRepoHasDescription:
+		return satisfies(isNotNegated, isValidRegexp)
 	case
 		FieldBefore,
 		FieldAfter:
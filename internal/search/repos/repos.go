@@ -149,6 +149,8 @@ func (r *Resolver) Resolve(ctx context.Context, op search.RepoOptions) (Resolved
 		OnlyArchived:           op.OnlyArchived,
 		NoPrivate:              op.Visibility == query.Public,
 		OnlyPrivate:            op.Visibility == query.Private,
+		Topics:                 op.HasTopics,
+		DescriptionPatterns:    op.HasDescriptions,
 		SearchContextID:        searchContext.ID,
 		UserID:                 searchContext.NamespaceUserID,
 		OrgID:                  searchContext.NamespaceOrgID,
@@ -431,6 +433,8 @@ func (r *Resolver) Excluded(ctx context.Context, op search.RepoOptions) (ex Excl
 		OnlyArchived:           op.OnlyArchived,
 		NoPrivate:              op.Visibility == query.Public,
 		OnlyPrivate:            op.Visibility == query.Private,
+		Topics:                 op.HasTopics,
+		DescriptionPatterns:    op.HasDescriptions,
 		SearchContextID:        searchContext.ID,
 		UserID:                 searchContext.NamespaceUserID,
 		OrgID:                  searchContext.NamespaceOrgID,
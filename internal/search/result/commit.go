@@ -21,6 +21,14 @@ type CommitMatch struct {
 	MessagePreview *HighlightedString
 	DiffPreview    *HighlightedString
 	Body           HighlightedString
+
+	// DiffFile is set when the match is scoped to a single file of the
+	// commit diff (select:commit.diff.file) or to a single hunk of it
+	// (select:commit.diff.hunk), in which case it holds exactly one hunk.
+	DiffFile *DiffFile
+	// IsDiffHunk is set if the match is scoped to the single hunk of
+	// DiffFile rather than to the whole file.
+	IsDiffHunk bool
 }
 
 // ResultCount for CommitSearchResult returns the number of highlights if there
@@ -68,6 +76,10 @@ func (r *CommitMatch) Select(path filter.SelectPath) Match {
 				return r
 			}
 			if len(fields) == 2 {
+				switch fields[1] {
+				case "file", "hunk":
+					return selectCommitDiffFile(r, fields[1])
+				}
 				return selectCommitDiffKind(r, fields[1])
 			}
 			return nil
@@ -94,11 +106,18 @@ func (r *CommitMatch) Key() Key {
 	if r.DiffPreview != nil {
 		typeRank = rankDiffMatch
 	}
-	return Key{
+	key := Key{
 		TypeRank: typeRank,
 		Repo:     r.Repo.Name,
 		Commit:   r.Commit.ID,
 	}
+	if r.DiffFile != nil {
+		key.Path = r.DiffFile.Path()
+		if r.IsDiffHunk && len(r.DiffFile.Hunks) == 1 {
+			key.HunkStart = r.DiffFile.Hunks[0].NewStart
+		}
+	}
+	return key
 }
 
 func (r *CommitMatch) Label() string {
@@ -204,4 +223,19 @@ func selectCommitDiffKind(c *CommitMatch, field string) Match {
 	return nil // No matching lines.
 }
 
+// selectCommitDiffFile returns a commit match `c` if it is scoped to a single
+// file (field `file`) or a single hunk (field `hunk`) of a commit diff. Commit
+// search splits diff results this way when one of these select paths is
+// specified, so matches without structured diff information are removed from
+// the result set (returns nil).
+func selectCommitDiffFile(c *CommitMatch, field string) Match {
+	if c.DiffFile == nil {
+		return nil
+	}
+	if field == "hunk" && !c.IsDiffHunk {
+		return nil
+	}
+	return c
+}
+
 func (r *CommitMatch) searchResultMarker() {}
@@ -0,0 +1,102 @@
+package result
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiffFile is a structured representation of the matching hunks of a single
+// file modified by a commit.
+type DiffFile struct {
+	OrigName string     `json:"origName"`
+	NewName  string     `json:"newName"`
+	Hunks    []DiffHunk `json:"hunks"`
+}
+
+// Path returns the path of the file after the commit was applied, or the
+// original path if the file was deleted.
+func (f *DiffFile) Path() string {
+	if f.NewName == "" || f.NewName == "/dev/null" {
+		return f.OrigName
+	}
+	return f.NewName
+}
+
+// DiffHunk is a single hunk of a DiffFile. Start lines are 1-based, matching
+// the unified diff hunk header.
+type DiffHunk struct {
+	OldStart int32      `json:"oldStart"`
+	OldLines int32      `json:"oldLines"`
+	NewStart int32      `json:"newStart"`
+	NewLines int32      `json:"newLines"`
+	Header   string     `json:"header"`
+	Lines    []DiffLine `json:"lines"`
+}
+
+type DiffLineKind string
+
+const (
+	DiffLineContext DiffLineKind = "context"
+	DiffLineAdded   DiffLineKind = "added"
+	DiffLineRemoved DiffLineKind = "removed"
+)
+
+func (k DiffLineKind) prefix() byte {
+	switch k {
+	case DiffLineAdded:
+		return '+'
+	case DiffLineRemoved:
+		return '-'
+	default:
+		return ' '
+	}
+}
+
+// DiffLine is a single line of a DiffHunk. OldLine is zero for added lines and
+// NewLine is zero for removed lines.
+type DiffLine struct {
+	Kind    DiffLineKind `json:"kind"`
+	OldLine int32        `json:"oldLine,omitempty"`
+	NewLine int32        `json:"newLine,omitempty"`
+	Content string       `json:"content"`
+
+	// MatchedRanges are the ranges of Content that matched the query. Lines
+	// and columns are relative to Content.
+	MatchedRanges Ranges `json:"matchedRanges,omitempty"`
+}
+
+// FormatDiffFiles renders files in the same format gitserver uses for diff
+// previews: a line with the original and new file name, followed by the
+// hunks of the file.
+func FormatDiffFiles(files []DiffFile) MatchedString {
+	var buf strings.Builder
+	var loc Location
+	var ranges Ranges
+
+	for _, file := range files {
+		fmt.Fprintf(&buf, "%s %s\n", file.OrigName, file.NewName)
+		loc.Offset = buf.Len()
+		loc.Line++
+
+		for _, hunk := range file.Hunks {
+			fmt.Fprintf(&buf, "@@ -%d,%d +%d,%d @@ %s\n", hunk.OldStart, hunk.OldLines, hunk.NewStart, hunk.NewLines, hunk.Header)
+			loc.Offset = buf.Len()
+			loc.Line++
+
+			for _, line := range hunk.Lines {
+				buf.WriteByte(line.Kind.prefix())
+				loc.Offset = buf.Len()
+				loc.Column = 1
+				ranges = append(ranges, line.MatchedRanges.Add(loc)...)
+
+				buf.WriteString(line.Content)
+				buf.WriteByte('\n')
+				loc.Offset = buf.Len()
+				loc.Line++
+				loc.Column = 0
+			}
+		}
+	}
+
+	return MatchedString{Content: buf.String(), MatchedRanges: ranges}
+}
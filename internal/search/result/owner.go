@@ -0,0 +1,48 @@
+package result
+
+import (
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// OwnerMatch is a user or team owning a file matched by a search, as listed in
+// a CODEOWNERS file. It is produced by select:file.owners.
+type OwnerMatch struct {
+	// Handle is the owner as written in the CODEOWNERS file, such as
+	// "@sourcegraph/search" or "alice@example.com".
+	Handle string
+
+	// Repo is the repository of the first file found to be owned by Handle.
+	Repo types.MinimalRepo
+}
+
+func (o *OwnerMatch) RepoName() types.MinimalRepo {
+	return o.Repo
+}
+
+func (o *OwnerMatch) Limit(limit int) int {
+	// Always represents one result and limit > 0 so we just return limit - 1.
+	return limit - 1
+}
+
+func (o *OwnerMatch) ResultCount() int {
+	return 1
+}
+
+func (o *OwnerMatch) Select(path filter.SelectPath) Match {
+	if path.Root() == filter.File && len(path) > 1 && path[1] == "owners" {
+		return o
+	}
+	return nil
+}
+
+// Key does not include the repository, so that an owner of files in several
+// repositories is only returned once.
+func (o *OwnerMatch) Key() Key {
+	return Key{
+		TypeRank: rankOwnerMatch,
+		Owner:    o.Handle,
+	}
+}
+
+func (o *OwnerMatch) searchResultMarker() {}
@@ -9,6 +9,7 @@ import (
 	"github.com/inconshreveable/log15"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	searchrepos "github.com/sourcegraph/sourcegraph/internal/search/repos"
@@ -20,17 +21,24 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 )
 
-func NewAggregator(db dbutil.DB, stream streaming.Sender) *Aggregator {
+// NewAggregator returns an Aggregator that sends events to stream, or
+// aggregates them if stream is nil. The actor in ctx is used to check
+// sub-repo permissions of the results.
+func NewAggregator(ctx context.Context, db dbutil.DB, stream streaming.Sender, subRepoPerms authz.SubRepoPermissionChecker) *Aggregator {
 	return &Aggregator{
+		ctx:          ctx,
 		db:           db,
 		parentStream: stream,
+		subRepoPerms: subRepoPerms,
 		errors:       &multierror.Error{},
 	}
 }
 
 type Aggregator struct {
+	ctx          context.Context
 	parentStream streaming.Sender
 	db           dbutil.DB
+	subRepoPerms authz.SubRepoPermissionChecker
 
 	mu         sync.Mutex
 	results    []result.Match
@@ -53,6 +61,18 @@ func (a *Aggregator) Get() ([]result.Match, streaming.Stats, int, *multierror.Er
 //
 // It currently also applies sub-repo permissions filtering (see inline docs).
 func (a *Aggregator) Send(event streaming.SearchEvent) {
+	// 🚨 SECURITY: Results in paths the actor may not read are removed before
+	// they are sent or aggregated. If the permissions cannot be checked, we
+	// drop all results of the event rather than risk leaking them.
+	if a.subRepoPerms.Enabled() {
+		var err error
+		event.Results, err = filterSubRepoPermissions(a.ctx, a.subRepoPerms, event.Results)
+		if err != nil {
+			a.Error(errors.Wrap(err, "applying sub-repo permissions to search results"))
+			event.Results = nil
+		}
+	}
+
 	if a.parentStream != nil {
 		a.parentStream.Send(event)
 	}
@@ -0,0 +1,65 @@
+package run
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// filterSubRepoPermissions returns the matches the actor in ctx is allowed to
+// see. File matches are removed if the actor may not read the file, and diff
+// matches scoped to a single file are removed if the actor may not read that
+// file.
+func filterSubRepoPermissions(ctx context.Context, checker authz.SubRepoPermissionChecker, matches []result.Match) ([]result.Match, error) {
+	if !checker.Enabled() || len(matches) == 0 {
+		return matches, nil
+	}
+
+	a := actor.FromContext(ctx)
+	filtered := make([]result.Match, 0, len(matches))
+	for _, m := range matches {
+		ok := true
+		for _, p := range matchPaths(m) {
+			var err error
+			ok, err = authz.FilterActorPath(ctx, checker, a, m.RepoName().Name, p)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+		}
+		if ok {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered, nil
+}
+
+// matchPaths returns the paths of the files whose content is exposed by the
+// match. The files of diff previews are already filtered by the commit search
+// job before the preview is formatted, so only diff matches scoped to a single
+// file are checked here.
+func matchPaths(m result.Match) []string {
+	switch m := m.(type) {
+	case *result.FileMatch:
+		return []string{m.Path}
+	case *result.CommitMatch:
+		if m.DiffFile != nil {
+			return diffFilePaths(m.DiffFile.OrigName, m.DiffFile.NewName)
+		}
+	}
+	return nil
+}
+
+func diffFilePaths(origName, newName string) []string {
+	var paths []string
+	for _, name := range []string{origName, newName} {
+		if name != "" && name != "/dev/null" {
+			paths = append(paths, name)
+		}
+	}
+	return paths
+}
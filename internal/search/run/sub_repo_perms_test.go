@@ -0,0 +1,61 @@
+package run
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestFilterSubRepoPermissions(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.PermissionsFunc.SetDefaultHook(func(ctx context.Context, userID int32, content authz.RepoContent) (authz.Perms, error) {
+		if strings.HasPrefix(content.Path, "secrets/") {
+			return authz.None, nil
+		}
+		return authz.Read, nil
+	})
+
+	matches := []result.Match{
+		&result.RepoMatch{Name: repo.Name, ID: repo.ID},
+		&result.FileMatch{File: result.File{Repo: repo, Path: "README.md"}},
+		&result.FileMatch{File: result.File{Repo: repo, Path: "secrets/token.txt"}},
+		&result.CommitMatch{Repo: repo, MessagePreview: &result.HighlightedString{Value: "update secrets"}},
+		&result.CommitMatch{Repo: repo, DiffFile: &result.DiffFile{OrigName: "README.md", NewName: "README.md"}},
+		&result.CommitMatch{Repo: repo, DiffFile: &result.DiffFile{OrigName: "secrets/token.txt", NewName: "/dev/null"}},
+		&result.CommitMatch{Repo: repo, DiffFile: &result.DiffFile{OrigName: "/dev/null", NewName: "secrets/my token.txt"}},
+	}
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	have, err := filterSubRepoPermissions(ctx, checker, matches)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []result.Match{matches[0], matches[1], matches[3], matches[4]}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatalf("unexpected matches (-want +have):\n%s", diff)
+	}
+
+	// Anonymous users cannot be checked, so no results are returned.
+	if _, err := filterSubRepoPermissions(context.Background(), checker, matches); err == nil {
+		t.Fatal("expected an error for an anonymous user")
+	}
+
+	checker.EnabledFunc.SetDefaultReturn(false)
+	have, err = filterSubRepoPermissions(context.Background(), checker, matches)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(have) != len(matches) {
+		t.Fatalf("expected all matches when sub-repo permissions are disabled, have %d", len(have))
+	}
+}
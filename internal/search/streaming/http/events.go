@@ -142,10 +142,53 @@ type EventCommitMatch struct {
 	Content         string     `json:"content"`
 	// [line, character, length]
 	Ranges [][3]int32 `json:"ranges"`
+
+	// DiffFile is the file or hunk of the diff the match is scoped to. Only
+	// set for select:commit.diff.file and select:commit.diff.hunk queries.
+	DiffFile *EventDiffFile `json:"diffFile,omitempty"`
 }
 
 func (e *EventCommitMatch) eventMatch() {}
 
+// EventDiffFile is a file modified by a commit, restricted to the hunks that
+// matched.
+type EventDiffFile struct {
+	OrigName string          `json:"origName"`
+	NewName  string          `json:"newName"`
+	Hunks    []EventDiffHunk `json:"hunks"`
+}
+
+type EventDiffHunk struct {
+	OldStart int32           `json:"oldStart"`
+	OldLines int32           `json:"oldLines"`
+	NewStart int32           `json:"newStart"`
+	NewLines int32           `json:"newLines"`
+	Header   string          `json:"header"`
+	Lines    []EventDiffLine `json:"lines"`
+}
+
+// EventDiffLine is a line of a diff hunk. Kind is one of "context", "added"
+// or "removed". OldLineNumber is omitted for added lines and NewLineNumber is
+// omitted for removed lines.
+type EventDiffLine struct {
+	Kind             string     `json:"kind"`
+	OldLineNumber    int32      `json:"oldLineNumber,omitempty"`
+	NewLineNumber    int32      `json:"newLineNumber,omitempty"`
+	Line             string     `json:"line"`
+	OffsetAndLengths [][2]int32 `json:"offsetAndLengths,omitempty"`
+}
+
+// EventOwnerMatch is an owner of files matched by a select:file.owners query.
+type EventOwnerMatch struct {
+	// Type is always OwnerMatchType. Included here for marshalling.
+	Type MatchType `json:"type"`
+
+	// Handle is the owner as written in the CODEOWNERS file.
+	Handle string `json:"handle"`
+}
+
+func (e *EventOwnerMatch) eventMatch() {}
+
 // EventFilter is a suggestion for a search filter. Currently has a 1-1
 // correspondance with the SearchFilter graphql type.
 type EventFilter struct {
@@ -184,6 +227,7 @@ const (
 	SymbolMatchType
 	CommitMatchType
 	PathMatchType
+	OwnerMatchType
 )
 
 func (t MatchType) MarshalJSON() ([]byte, error) {
@@ -198,6 +242,8 @@ func (t MatchType) MarshalJSON() ([]byte, error) {
 		return []byte(`"commit"`), nil
 	case PathMatchType:
 		return []byte(`"path"`), nil
+	case OwnerMatchType:
+		return []byte(`"owner"`), nil
 	default:
 		return nil, errors.Errorf("unknown MatchType: %d", t)
 	}
@@ -214,6 +260,8 @@ func (t *MatchType) UnmarshalJSON(b []byte) error {
 		*t = CommitMatchType
 	} else if bytes.Equal(b, []byte(`"path"`)) {
 		*t = PathMatchType
+	} else if bytes.Equal(b, []byte(`"owner"`)) {
+		*t = OwnerMatchType
 	} else {
 		return errors.Errorf("unknown MatchType: %s", b)
 	}
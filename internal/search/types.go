@@ -258,6 +258,8 @@ type RepoOptions struct {
 	NoArchived               bool
 	OnlyArchived             bool
 	CommitAfter              string
+	HasTopics                []string
+	HasDescriptions          []string
 	Visibility               query.RepoVisibility
 	Limit                    int
 	Cursors                  []*types.Cursor
@@ -283,6 +285,12 @@ func (op *RepoOptions) String() string {
 	if op.CommitAfter != "" {
 		_, _ = fmt.Fprintf(&b, " CommitAfter=%q", op.CommitAfter)
 	}
+	if len(op.HasTopics) > 0 {
+		_, _ = fmt.Fprintf(&b, " HasTopics=%q", op.HasTopics)
+	}
+	if len(op.HasDescriptions) > 0 {
+		_, _ = fmt.Fprintf(&b, " HasDescriptions=%q", op.HasDescriptions)
+	}
 
 	if op.CaseSensitiveRepoFilters {
 		b.WriteString(" CaseSensitiveRepoFilters")
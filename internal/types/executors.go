@@ -17,3 +17,14 @@ type Executor struct {
 	FirstSeenAt     time.Time
 	LastSeenAt      time.Time
 }
+
+// ExecutorArtifact describes a file uploaded by an executor as an output of a job.
+type ExecutorArtifact struct {
+	ID        int
+	QueueName string
+	JobID     int
+	Name      string
+	ObjectKey string
+	Size      int64
+	CreatedAt time.Time
+}
@@ -75,7 +75,15 @@ func redactField(buf string, paths ...[]string) (string, error) {
 func redactionInfo(cfg interface{}) ([]jsonStringField, error) {
 	switch cfg := cfg.(type) {
 	case *schema.GitHubConnection:
-		return []jsonStringField{{[]string{"token"}, &cfg.Token}}, nil
+		// GitHub can have a token OR a GitHub App installation
+		fields := []jsonStringField{}
+		if cfg.Token != "" {
+			fields = append(fields, jsonStringField{[]string{"token"}, &cfg.Token})
+		}
+		if cfg.GithubAppInstallation != nil {
+			fields = append(fields, jsonStringField{[]string{"githubAppInstallation", "privateKey"}, &cfg.GithubAppInstallation.PrivateKey})
+		}
+		return fields, nil
 	case *schema.GitLabConnection:
 		return []jsonStringField{{[]string{"token"}, &cfg.Token}}, nil
 	case *schema.BitbucketServerConnection:
@@ -103,11 +111,19 @@ func redactionInfo(cfg interface{}) ([]jsonStringField, error) {
 		return []jsonStringField{}, nil
 	case *schema.JVMPackagesConnection:
 		return []jsonStringField{{[]string{"maven", "credentials"}, &cfg.Maven.Credentials}}, nil
+	case *schema.NPMPackagesConnection:
+		return []jsonStringField{{[]string{"credentials"}, &cfg.Credentials}}, nil
+	case *schema.GoModulesConnection:
+		return []jsonStringField{}, nil
 	case *schema.PagureConnection:
 		if cfg.Token != "" {
 			return []jsonStringField{{[]string{"token"}, &cfg.Token}}, nil
 		}
 		return []jsonStringField{}, nil
+	case *schema.GiteaConnection:
+		return []jsonStringField{{[]string{"token"}, &cfg.Token}}, nil
+	case *schema.AzureDevOpsConnection:
+		return []jsonStringField{{[]string{"token"}, &cfg.Token}}, nil
 	case *schema.OtherExternalServiceConnection:
 		return []jsonStringField{{[]string{"url"}, &cfg.Url}}, nil
 	default:
@@ -26,6 +26,14 @@ func TestRoundTripRedactExternalServiceConfig(t *testing.T) {
 		Token: someSecret,
 		Url:   "https://github.com",
 	}
+	githubAppInstallationConfig := schema.GitHubConnection{
+		Url: "https://github.com",
+		GithubAppInstallation: &schema.GitHubAppInstallation{
+			AppID:          1,
+			InstallationID: 2,
+			PrivateKey:     someSecret,
+		},
+	}
 	gitlabConfig := schema.GitLabConnection{
 		Token: someSecret,
 		Url:   "https://gitlab.com",
@@ -67,6 +75,10 @@ func TestRoundTripRedactExternalServiceConfig(t *testing.T) {
 			Dependencies: []string{"placeholder"},
 		},
 	}
+	npmPackagesConfig := schema.NPMPackagesConnection{
+		Credentials:  "top secret credentials",
+		Dependencies: []string{"placeholder"},
+	}
 	pagureConfig := schema.PagureConnection{
 		Url: "https://src.fedoraproject.org",
 	}
@@ -84,6 +96,12 @@ func TestRoundTripRedactExternalServiceConfig(t *testing.T) {
 			config:    &githubConfig,
 			editField: func(cfg interface{}) *string { return &cfg.(*schema.GitHubConnection).Url },
 		},
+		// GitHub can have a token OR a GitHub App installation
+		{
+			kind:      extsvc.KindGitHub,
+			config:    &githubAppInstallationConfig,
+			editField: func(cfg interface{}) *string { return &cfg.(*schema.GitHubConnection).Url },
+		},
 		{
 			kind:      extsvc.KindGitLab,
 			config:    &gitlabConfig,
@@ -130,6 +148,11 @@ func TestRoundTripRedactExternalServiceConfig(t *testing.T) {
 			config:    &jvmPackagesConfig,
 			editField: func(cfg interface{}) *string { return &cfg.(*schema.JVMPackagesConnection).Maven.Dependencies[0] },
 		},
+		{
+			kind:      extsvc.KindNPMPackages,
+			config:    &npmPackagesConfig,
+			editField: func(cfg interface{}) *string { return &cfg.(*schema.NPMPackagesConnection).Dependencies[0] },
+		},
 		{
 			// Unlike the other test cases, this test covers skipping redaction of missing optional fields.
 			kind:      extsvc.KindPagure,
@@ -46,6 +46,10 @@ type ChangesetTemplate struct {
 	Branch    string                       `json:"branch,omitempty" yaml:"branch"`
 	Commit    ExpandedGitCommitDescription `json:"commit,omitempty" yaml:"commit"`
 	Published *overridable.BoolOrString    `json:"published" yaml:"published"`
+	Draft     bool                         `json:"draft,omitempty" yaml:"draft"`
+	Labels    []string                     `json:"labels,omitempty" yaml:"labels"`
+	Reviewers []string                     `json:"reviewers,omitempty" yaml:"reviewers"`
+	Assignees []string                     `json:"assignees,omitempty" yaml:"assignees"`
 }
 
 type GitCommitAuthor struct {
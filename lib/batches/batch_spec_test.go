@@ -30,6 +30,71 @@ changesetTemplate:
 		}
 	})
 
+	t.Run("labels, reviewers and assignees", func(t *testing.T) {
+		const spec = `
+name: hello-world
+description: Add Hello World to READMEs
+on:
+  - repositoriesMatchingQuery: file:README.md
+steps:
+  - run: echo Hello World | tee -a $(find -name README.md)
+    container: alpine:3
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+  published: true
+  draft: true
+  labels: [automation]
+  reviewers: [alice, sourcegraph/security]
+  assignees: [bob]
+`
+
+		have, err := ParseBatchSpec([]byte(spec), ParseBatchSpecOptions{})
+		if err != nil {
+			t.Fatalf("parsing valid spec returned error: %s", err)
+		}
+		tmpl := have.ChangesetTemplate
+		if !tmpl.Draft {
+			t.Error("draft flag not set")
+		}
+		if want := fmt.Sprint([]string{"automation"}); fmt.Sprint(tmpl.Labels) != want {
+			t.Errorf("wrong labels. want=%s, have=%v", want, tmpl.Labels)
+		}
+		if want := fmt.Sprint([]string{"alice", "sourcegraph/security"}); fmt.Sprint(tmpl.Reviewers) != want {
+			t.Errorf("wrong reviewers. want=%s, have=%v", want, tmpl.Reviewers)
+		}
+		if want := fmt.Sprint([]string{"bob"}); fmt.Sprint(tmpl.Assignees) != want {
+			t.Errorf("wrong assignees. want=%s, have=%v", want, tmpl.Assignees)
+		}
+	})
+
+	t.Run("invalid labels", func(t *testing.T) {
+		const spec = `
+name: hello-world
+description: Add Hello World to READMEs
+on:
+  - repositoriesMatchingQuery: file:README.md
+steps:
+  - run: echo Hello World | tee -a $(find -name README.md)
+    container: alpine:3
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+  published: false
+  labels: automation
+`
+
+		if _, err := ParseBatchSpec([]byte(spec), ParseBatchSpecOptions{}); err == nil {
+			t.Fatal("no error returned")
+		}
+	})
+
 	t.Run("missing changesetTemplate", func(t *testing.T) {
 		const spec = `
 name: hello-world
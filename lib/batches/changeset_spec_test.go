@@ -39,6 +39,28 @@ func TestParseChangesetSpec(t *testing.T) {
 				}]
 			}`,
 		},
+		{
+			name: "valid GitBranchChangesetDescription with labels, reviewers and assignees",
+			rawSpec: `{
+				"baseRepository": "graphql-id",
+				"baseRef": "refs/heads/master",
+				"baseRev": "d34db33f",
+				"headRef": "refs/heads/my-branch",
+				"headRepository": "graphql-id",
+				"title": "my title",
+				"body": "my body",
+				"published": "draft",
+				"commits": [{
+				  "message": "commit message",
+				  "diff": "the diff",
+				  "authorName": "Mary McButtons",
+				  "authorEmail": "mary@example.com"
+				}],
+				"labels": ["automation"],
+				"reviewers": ["mary", "org/team"],
+				"assignees": ["mary"]
+			}`,
+		},
 		{
 			name: "missing fields in GitBranchChangesetDescription",
 			rawSpec: `{
@@ -115,6 +115,10 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, features ChangesetSpecFeatur
 		} else if !features.AllowOptionalPublished {
 			return nil, errOptionalPublishedUnsupported
 		}
+		// The draft flag turns changesets that would be published into drafts.
+		if input.Template.Draft && published == true {
+			published = "draft"
+		}
 
 		return &ChangesetSpec{
 			BaseRepository: input.Repository.ID,
@@ -134,6 +138,9 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, features ChangesetSpecFeatur
 				},
 			},
 			Published: PublishedValue{Val: published},
+			Labels:    input.Template.Labels,
+			Reviewers: input.Template.Reviewers,
+			Assignees: input.Template.Assignees,
 		}, nil
 	}
 
@@ -172,6 +172,50 @@ func TestCreateChangesetSpecs(t *testing.T) {
 			},
 			wantErr: "",
 		},
+		{
+			name: "labels, reviewers and assignees",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Published = parsePublishedFieldString(t, "false")
+				input.Template.Labels = []string{"automation"}
+				input.Template.Reviewers = []string{"alice", "sourcegraph/security"}
+				input.Template.Assignees = []string{"bob"}
+			}),
+			features: featuresAllEnabled,
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.Labels = []string{"automation"}
+					s.Reviewers = []string{"alice", "sourcegraph/security"}
+					s.Assignees = []string{"bob"}
+				}),
+			},
+			wantErr: "",
+		},
+		{
+			name: "draft",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Published = parsePublishedFieldString(t, "true")
+				input.Template.Draft = true
+			}),
+			features: featuresAllEnabled,
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.Published = PublishedValue{Val: "draft"}
+				}),
+			},
+			wantErr: "",
+		},
+		{
+			name: "draft on an unpublished changeset",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Published = parsePublishedFieldString(t, "false")
+				input.Template.Draft = true
+			}),
+			features: featuresAllEnabled,
+			want: []*ChangesetSpec{
+				defaultChangesetSpec,
+			},
+			wantErr: "",
+		},
 		{
 			name: "publish in UI on an unsupported version",
 			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
@@ -153,7 +153,7 @@ const BatchSpecJSON = `{
                     },
                     {
                       "type": "object",
-                      "description": "An environment variable to set in the step environment: the value will be passed through from the environment src is running within.",
+                      "description": "An environment variable to set in the step environment: the key is used as the environment variable name and the value as the value.",
                       "additionalProperties": {
                         "type": "string"
                       },
@@ -339,6 +339,40 @@ const BatchSpecJSON = `{
               }
             }
           ]
+        },
+        "draft": {
+          "type": "boolean",
+          "description": "Whether published changesets are created as drafts on the code host. This is equivalent to setting published to draft for every changeset that would otherwise be published. Code hosts that don't support drafts ignore this flag."
+        },
+        "labels": {
+          "type": ["array", "null"],
+          "description": "The labels to add to each changeset. Labels that don't exist yet are created on GitHub and GitLab. Not supported by Bitbucket Server.",
+          "uniqueItems": true,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          },
+          "examples": [["automation", "security"]]
+        },
+        "reviewers": {
+          "type": ["array", "null"],
+          "description": "The users to request reviews from on each changeset, identified by their username on the code host. On GitHub, teams can be requested as org/team-slug.",
+          "uniqueItems": true,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          },
+          "examples": [["alice", "sourcegraph/security"]]
+        },
+        "assignees": {
+          "type": ["array", "null"],
+          "description": "The users to assign each changeset to, identified by their username on the code host. Not supported by Bitbucket Server.",
+          "uniqueItems": true,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          },
+          "examples": [["alice"]]
         }
       }
     }
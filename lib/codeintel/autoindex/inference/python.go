@@ -0,0 +1,107 @@
+package inference
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func PythonPatterns() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		pathPattern(rawPattern("setup.py")),
+		pathPattern(rawPattern("pyproject.toml")),
+		pathPattern(rawPattern("requirements.txt")),
+		pathPattern(rawPattern("poetry.lock")),
+	}
+}
+
+const lsifPyImage = "sourcegraph/lsif-py:latest"
+
+// InferPythonIndexJobs emits one index job per Python project root. A project
+// root is any directory that contains a setup.py, pyproject.toml or
+// requirements.txt file. Dependencies are installed with poetry when the
+// project uses it, and with pip otherwise.
+func InferPythonIndexJobs(gitclient GitClient, paths []string) (indexes []config.IndexJob) {
+	seen := map[string]struct{}{}
+
+	for _, path := range paths {
+		if !isPythonProjectPath(path) {
+			continue
+		}
+
+		root := dirWithoutDot(path)
+		if _, ok := seen[root]; ok {
+			continue
+		}
+		seen[root] = struct{}{}
+
+		var dockerSteps []config.DockerStep
+		if commands := pythonInstallCommands(gitclient, root, paths); len(commands) > 0 {
+			dockerSteps = append(dockerSteps, config.DockerStep{
+				Root:     root,
+				Image:    lsifPyImage,
+				Commands: commands,
+			})
+		}
+
+		indexes = append(indexes, config.IndexJob{
+			Steps:       dockerSteps,
+			Root:        root,
+			Indexer:     lsifPyImage,
+			IndexerArgs: []string{"lsif-py", ".", "--file", "dump.lsif"},
+			Outfile:     "dump.lsif",
+		})
+	}
+
+	return indexes
+}
+
+// pythonInstallCommands returns the commands that install the dependencies of
+// the Python project rooted at the given directory.
+func pythonInstallCommands(gitclient GitClient, root string, paths []string) []string {
+	if isPoetryProject(gitclient, root, paths) {
+		return []string{"poetry install --no-interaction"}
+	}
+
+	var commands []string
+	if contains(paths, filepath.Join(root, "requirements.txt")) {
+		commands = append(commands, "pip install -r requirements.txt")
+	}
+	if contains(paths, filepath.Join(root, "setup.py")) || contains(paths, filepath.Join(root, "pyproject.toml")) {
+		commands = append(commands, "pip install .")
+	}
+	return commands
+}
+
+// isPoetryProject returns true if the Python project rooted at the given
+// directory is managed by poetry, which is signalled either by a lock file or
+// by a [tool.poetry] section in pyproject.toml.
+func isPoetryProject(gitclient GitClient, root string, paths []string) bool {
+	if contains(paths, filepath.Join(root, "poetry.lock")) {
+		return true
+	}
+
+	pyprojectPath := filepath.Join(root, "pyproject.toml")
+	if !contains(paths, pyprojectPath) {
+		return false
+	}
+
+	b, err := gitclient.RawContents(context.TODO(), pyprojectPath)
+	if err != nil {
+		return false
+	}
+	return bytes.Contains(b, []byte("[tool.poetry]"))
+}
+
+var pythonSegmentBlockList = append([]string{"venv", ".venv", "site-packages", "node_modules"}, segmentBlockList...)
+
+func isPythonProjectPath(path string) bool {
+	switch filepath.Base(path) {
+	case "setup.py", "pyproject.toml", "requirements.txt":
+		return containsNoSegments(path, pythonSegmentBlockList...)
+	}
+	return false
+}
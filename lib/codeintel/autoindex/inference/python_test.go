@@ -0,0 +1,124 @@
+package inference
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestPythonPatterns(t *testing.T) {
+	testLangPatterns(t, PythonPatterns(), []PathTestCase{
+		{"setup.py", true},
+		{"pyproject.toml", true},
+		{"requirements.txt", true},
+		{"poetry.lock", true},
+		{"subdir/setup.py", true},
+		{"subdir/requirements.txt", true},
+		{"setup.py/subdir", false},
+		{"main.py", false},
+		{"requirements-dev.txt", false},
+	})
+}
+
+func TestInferPythonIndexJobsPip(t *testing.T) {
+	paths := []string{
+		"requirements.txt",
+		"setup.py",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "",
+					Image:    lsifPyImage,
+					Commands: []string{"pip install -r requirements.txt", "pip install ."},
+				},
+			},
+			Root:        "",
+			Indexer:     lsifPyImage,
+			IndexerArgs: []string{"lsif-py", ".", "--file", "dump.lsif"},
+			Outfile:     "dump.lsif",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferPythonIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
+
+func TestInferPythonIndexJobsPoetry(t *testing.T) {
+	paths := []string{
+		"services/api/pyproject.toml",
+		"services/worker/pyproject.toml",
+		"services/worker/poetry.lock",
+		"tools/pyproject.toml",
+	}
+
+	mockGit := NewMockGitClient()
+	mockGit.RawContentsFunc.SetDefaultHook(func(_ context.Context, path string) ([]byte, error) {
+		if path == "services/api/pyproject.toml" {
+			return []byte("[tool.poetry]\nname = \"api\"\n"), nil
+		}
+		return []byte("[build-system]\nrequires = [\"setuptools\"]\n"), nil
+	})
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "services/api",
+					Image:    lsifPyImage,
+					Commands: []string{"poetry install --no-interaction"},
+				},
+			},
+			Root:        "services/api",
+			Indexer:     lsifPyImage,
+			IndexerArgs: []string{"lsif-py", ".", "--file", "dump.lsif"},
+			Outfile:     "dump.lsif",
+		},
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "services/worker",
+					Image:    lsifPyImage,
+					Commands: []string{"poetry install --no-interaction"},
+				},
+			},
+			Root:        "services/worker",
+			Indexer:     lsifPyImage,
+			IndexerArgs: []string{"lsif-py", ".", "--file", "dump.lsif"},
+			Outfile:     "dump.lsif",
+		},
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "tools",
+					Image:    lsifPyImage,
+					Commands: []string{"pip install ."},
+				},
+			},
+			Root:        "tools",
+			Indexer:     lsifPyImage,
+			IndexerArgs: []string{"lsif-py", ".", "--file", "dump.lsif"},
+			Outfile:     "dump.lsif",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferPythonIndexJobs(mockGit, paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
+
+func TestInferPythonIndexJobsBlockedSegments(t *testing.T) {
+	paths := []string{
+		"venv/lib/python3.9/site-packages/six/setup.py",
+		"tests/fixtures/requirements.txt",
+		"examples/demo/setup.py",
+	}
+
+	if indexJobs := InferPythonIndexJobs(NewMockGitClient(), paths); len(indexJobs) != 0 {
+		t.Errorf("unexpected index jobs: %+v", indexJobs)
+	}
+}
@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS insight_series_alerts
+(
+    id                SERIAL PRIMARY KEY,
+    series_id         TEXT             NOT NULL,
+    user_id           INT              NOT NULL,
+    condition         TEXT             NOT NULL,
+    threshold         DOUBLE PRECISION NOT NULL,
+    intervals         INT              NOT NULL DEFAULT 1,
+    triggered         BOOL             NOT NULL DEFAULT FALSE,
+    last_value        DOUBLE PRECISION,
+    last_triggered_at TIMESTAMP,
+    created_at        TIMESTAMP        NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS insight_series_alerts_series_id_idx ON insight_series_alerts (series_id);
+
+COMMENT ON TABLE insight_series_alerts IS 'Alert rules on the aggregated value of an insight series. Users are notified by email when a rule starts matching.';
+COMMENT ON COLUMN insight_series_alerts.series_id IS 'The series_id of the insight_series the rule is evaluated against.';
+COMMENT ON COLUMN insight_series_alerts.user_id IS 'The user that created the rule and is notified. This is a reference to the users table in the primary database.';
+COMMENT ON COLUMN insight_series_alerts.condition IS 'One of ABOVE, BELOW, INCREASE or DECREASE.';
+COMMENT ON COLUMN insight_series_alerts.intervals IS 'The number of data points INCREASE and DECREASE rules compare the latest value against.';
+COMMENT ON COLUMN insight_series_alerts.triggered IS 'Whether the rule matched when it was last evaluated. Users are only notified when this changes from false to true.';
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Bar charts and tables are presented as line charts of the same series.
+UPDATE insight_view SET presentation_type = 'LINE' WHERE presentation_type IN ('BAR', 'TABLE');
+
+ALTER TYPE presentation_type_enum RENAME TO presentation_type_enum_old;
+CREATE TYPE presentation_type_enum AS ENUM ('LINE', 'PIE');
+
+ALTER TABLE insight_view ALTER COLUMN presentation_type DROP DEFAULT;
+ALTER TABLE insight_view
+    ALTER COLUMN presentation_type TYPE presentation_type_enum USING presentation_type::TEXT::presentation_type_enum;
+ALTER TABLE insight_view ALTER COLUMN presentation_type SET DEFAULT 'LINE';
+
+DROP TYPE presentation_type_enum_old;
+
+COMMENT ON COLUMN insight_view.presentation_type IS 'The basic presentation type for the insight view. (e.g Line, Pie, etc.)';
+
+COMMIT;
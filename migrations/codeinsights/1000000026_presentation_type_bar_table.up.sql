@@ -0,0 +1,17 @@
+BEGIN;
+
+-- Enum values cannot be added inside a transaction block on all supported Postgres versions, so the type is
+-- replaced instead.
+ALTER TYPE presentation_type_enum RENAME TO presentation_type_enum_old;
+CREATE TYPE presentation_type_enum AS ENUM ('LINE', 'PIE', 'BAR', 'TABLE');
+
+ALTER TABLE insight_view ALTER COLUMN presentation_type DROP DEFAULT;
+ALTER TABLE insight_view
+    ALTER COLUMN presentation_type TYPE presentation_type_enum USING presentation_type::TEXT::presentation_type_enum;
+ALTER TABLE insight_view ALTER COLUMN presentation_type SET DEFAULT 'LINE';
+
+DROP TYPE presentation_type_enum_old;
+
+COMMENT ON COLUMN insight_view.presentation_type IS 'The basic presentation type for the insight view. (e.g Line, Pie, Bar, Table, etc.)';
+
+COMMIT;
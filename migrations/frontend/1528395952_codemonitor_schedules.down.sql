@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE cm_trigger_jobs
+	DROP COLUMN IF EXISTS search_results,
+	DROP COLUMN IF EXISTS num_added,
+	DROP COLUMN IF EXISTS num_removed,
+	DROP COLUMN IF EXISTS added_results,
+	DROP COLUMN IF EXISTS removed_results;
+
+ALTER TABLE cm_queries
+	DROP COLUMN IF EXISTS schedule;
+
+COMMIT;
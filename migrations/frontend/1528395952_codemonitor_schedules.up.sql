@@ -0,0 +1,20 @@
+BEGIN;
+
+ALTER TABLE cm_queries
+	ADD COLUMN IF NOT EXISTS schedule text;
+
+ALTER TABLE cm_trigger_jobs
+	ADD COLUMN IF NOT EXISTS search_results text[],
+	ADD COLUMN IF NOT EXISTS num_added integer,
+	ADD COLUMN IF NOT EXISTS num_removed integer,
+	ADD COLUMN IF NOT EXISTS added_results text[],
+	ADD COLUMN IF NOT EXISTS removed_results text[];
+
+COMMENT ON COLUMN cm_queries.schedule IS 'A cron schedule. If set, the query runs on this schedule and sends a digest of added and removed results instead of only reporting new commits';
+COMMENT ON COLUMN cm_trigger_jobs.search_results IS 'The keys of all results of a scheduled query run, used to compute the digest of the next run. NULL for unscheduled queries';
+COMMENT ON COLUMN cm_trigger_jobs.num_added IS 'The number of results of a scheduled query run that were not returned by the previous run';
+COMMENT ON COLUMN cm_trigger_jobs.num_removed IS 'The number of results of the previous run of a scheduled query that were not returned anymore';
+COMMENT ON COLUMN cm_trigger_jobs.added_results IS 'The keys of the results of a scheduled query run that were not returned by the previous run. Sent in the digest of the run';
+COMMENT ON COLUMN cm_trigger_jobs.removed_results IS 'The keys of the results of the previous run of a scheduled query that were not returned anymore. Sent in the digest of the run';
+
+COMMIT;
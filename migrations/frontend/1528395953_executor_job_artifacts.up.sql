@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS executor_job_artifacts (
+    id SERIAL PRIMARY KEY,
+    queue_name TEXT NOT NULL,
+    job_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    object_key TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (queue_name, job_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS executor_job_artifacts_created_at ON executor_job_artifacts(created_at);
+
+COMMENT ON TABLE executor_job_artifacts IS 'Tracks the files uploaded by executors as outputs of the jobs they processed.';
+COMMENT ON COLUMN executor_job_artifacts.queue_name IS 'The name of the queue the job was dequeued from.';
+COMMENT ON COLUMN executor_job_artifacts.job_id IS 'The identifier of the job record within the queue.';
+COMMENT ON COLUMN executor_job_artifacts.name IS 'The name of the artifact, unique per job.';
+COMMENT ON COLUMN executor_job_artifacts.object_key IS 'The key of the artifact in the upload store.';
+COMMENT ON COLUMN executor_job_artifacts.size IS 'The size of the artifact in bytes.';
+COMMENT ON COLUMN executor_job_artifacts.created_at IS 'The time the artifact was last uploaded. Artifacts are deleted once they are older than the configured TTL.';
+
+COMMIT;